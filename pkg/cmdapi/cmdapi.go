@@ -284,17 +284,26 @@ const (
 	flagLatest         = "latest"
 	flagLockTimeout    = "lock-timeout"
 	flagLog            = "log"
+	flagName           = "name"
+	flagOut            = "out"
 	flagPlan           = "plan"
 	flagRevisionSchema = "revisions-schema"
+	flagRun            = "run"
 	flagSchema         = "schema"
 	flagSchemaShort    = "s"
+	flagTag            = "tag"
 	flagTo             = "to"
+	flagToVersion      = "to-version"
 	flagTxMode         = "tx-mode"
 	flagExecOrder      = "exec-order"
 	flagURL            = "url"
 	flagURLShort       = "u"
 	flagVar            = "var"
 	flagQualifier      = "qualifier"
+
+	// Multi-environment execution flags.
+	flagConcurrency     = "concurrency"
+	flagContinueOnError = "continue-on-error"
 )
 
 func addGlobalFlags(set *pflag.FlagSet) {
@@ -7,20 +7,30 @@
 package cmdapi
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"text/template"
 	"time"
 
+	"github.com/veiloq/atlas/pkg/cloudapi"
 	"github.com/veiloq/atlas/pkg/cmdext"
 	"github.com/veiloq/atlas/pkg/cmdlog"
 	"github.com/veiloq/atlas/pkg/cmdstate"
 	cmdmigrate "github.com/veiloq/atlas/pkg/migrate"
 	"github.com/veiloq/atlas/pkg/migratelint"
+	"github.com/veiloq/atlas/pkg/registry"
 	"github.com/veiloq/atlas/schemahcl"
 	"github.com/veiloq/atlas/sql/migrate"
 	"github.com/veiloq/atlas/sql/schema"
@@ -38,29 +48,29 @@ func init() {
 		schemaDiffCmd(),
 		schemaFmtCmd(),
 		schemaInspectCmd(),
-		unsupportedCommand("schema", "test"),
-		unsupportedCommand("schema", "plan"),
-		unsupportedCommand("schema", "push"),
+		schemaTestCmd(),
+		schemaPlanCmd(),
+		schemaPushCmd(),
 	)
 	Root.AddCommand(schemaCmd)
 	migrateCmd := migrateCmd()
 	migrateCmd.AddCommand(
 		migrateApplyCmd(),
+		migrateCheckpointCmd(),
 		migrateDiffCmd(),
+		migrateDownCmd(),
+		migrateEditCmd(),
 		migrateHashCmd(),
 		migrateImportCmd(),
 		migrateLintCmd(),
 		migrateNewCmd(),
+		migratePushCmd(),
+		migrateRebaseCmd(),
+		migrateRmCmd(),
 		migrateSetCmd(),
 		migrateStatusCmd(),
+		migrateTestCmd(),
 		migrateValidateCmd(),
-		unsupportedCommand("migrate", "checkpoint"),
-		unsupportedCommand("migrate", "down"),
-		unsupportedCommand("migrate", "rebase"),
-		unsupportedCommand("migrate", "rm"),
-		unsupportedCommand("migrate", "edit"),
-		unsupportedCommand("migrate", "push"),
-		unsupportedCommand("migrate", "test"),
 	)
 	Root.AddCommand(migrateCmd)
 }
@@ -316,8 +326,6 @@ func schemaApplyRunE(cmd *cobra.Command, _ []string, flags *schemaApplyFlags) er
 	switch {
 	case flags.edit:
 		return AbortErrorf(unsupportedMessage("schema", "apply --edit"))
-	case flags.planURL != "":
-		return AbortErrorf(unsupportedMessage("schema", "apply --plan"))
 	case GlobalFlags.SelectedEnv == "":
 		env, err := selectEnv(cmd)
 		if err != nil {
@@ -329,8 +337,8 @@ func schemaApplyRunE(cmd *cobra.Command, _ []string, flags *schemaApplyFlags) er
 		if err != nil {
 			return err
 		}
-		if len(envs) != 1 {
-			return fmt.Errorf("multi-environment %q is not supported", GlobalFlags.SelectedEnv)
+		if len(envs) > 1 {
+			return schemaApplyEnvsRun(cmd, flags, envs)
 		}
 		if err := setSchemaEnvFlags(cmd, envs[0]); err != nil {
 			return err
@@ -339,6 +347,140 @@ func schemaApplyRunE(cmd *cobra.Command, _ []string, flags *schemaApplyFlags) er
 	}
 }
 
+// schemaApplyEnvsRun executes 'schema apply' on each of the given environments (e.g.,
+// a database per tenant), running at most --concurrency of them at the same time. In
+// case of a failure, targets that were not started yet are skipped, unless the
+// --continue-on-error flag was set. Targets that were already started are not stopped.
+func schemaApplyEnvsRun(cmd *cobra.Command, flags *schemaApplyFlags, envs []*Env) error {
+	switch {
+	case flags.concurrency < 1:
+		return fmt.Errorf("--concurrency must be a positive number, got %d", flags.concurrency)
+	case flags.concurrency > 1 && !flags.dryRun && !flags.autoApprove:
+		return errors.New("--concurrency requires --dry-run or --auto-approve, as changes cannot be approved concurrently")
+	}
+	// Flags are resolved upfront, as they are bound to the same command.
+	var (
+		custom bool
+		all    = make([]schemaApplyFlags, len(envs))
+		reset  = resetFromEnv(cmd)
+	)
+	for i, e := range envs {
+		if err := setSchemaEnvFlags(cmd, e); err != nil {
+			return err
+		}
+		all[i] = *flags
+		custom = custom || flags.logFormat != ""
+		reset()
+	}
+	// Returning at this stage should
+	// not trigger the help message.
+	cmd.SilenceUsage = true
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Bool
+		out    = cmd.OutOrStdout()
+		sem    = make(chan struct{}, flags.concurrency)
+		outs   = make([]bytes.Buffer, len(envs))
+		errs   = make([]error, len(envs))
+		set    = &cmdlog.SchemaApplySet{Start: time.Now(), Targets: make([]*cmdlog.SchemaApplyTarget, len(envs))}
+	)
+	// newline reports if a newline separator should be printed after the output
+	// of the given target. It happens in case a custom logging was configured,
+	// to ensure there is a newline separator between the different environments.
+	newline := func(i int) bool {
+		b := outs[i].Bytes()
+		return custom && len(b) > 0 && b[len(b)-1] != '\n'
+	}
+	for i, e := range envs {
+		t := &cmdlog.SchemaApplyTarget{URL: redactedURL(all[i].url)}
+		set.Targets[i] = t
+		sem <- struct{}{}
+		if failed.Load() && !flags.continueOnError {
+			t.Skipped = true
+			<-sem
+			continue
+		}
+		// Concurrent targets run on a command that shares the context and
+		// the input of the original one, but buffers its output. The output
+		// is printed in the order of the targets, after all of them are done.
+		c := cmd
+		if flags.concurrency > 1 {
+			c = &cobra.Command{}
+			c.SetContext(cmd.Context())
+			c.SetIn(cmd.InOrStdin())
+			c.SetErr(cmd.ErrOrStderr())
+			c.SetOut(&outs[i])
+		} else {
+			c.SetOut(io.MultiWriter(out, &outs[i]))
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			t.Start = time.Now()
+			errs[i] = schemaApplyRun(c, all[i], e)
+			t.End = time.Now()
+			if errs[i] != nil {
+				t.Error = errs[i].Error()
+				failed.Store(true)
+			}
+		}()
+		// Sequential execution allows prompting the user for
+		// approval, and therefore, its output is not buffered.
+		if flags.concurrency == 1 {
+			wg.Wait()
+			cmd.SetOut(out)
+			if newline(i) {
+				cmd.Println()
+			}
+		}
+	}
+	wg.Wait()
+	set.End = time.Now()
+	if flags.concurrency > 1 {
+		for i := range outs {
+			if _, err := out.Write(outs[i].Bytes()); err != nil {
+				return err
+			}
+			if newline(i) {
+				cmd.Println()
+			}
+		}
+	}
+	// The summary is skipped in case the output format was customized (e.g., JSON).
+	if !custom {
+		if err := cmdlog.SchemaApplySetTemplate.Execute(cmd.OutOrStdout(), set); err != nil {
+			return err
+		}
+	}
+	switch n := set.Failed(); {
+	case n == 0:
+		return nil
+	case !custom:
+		// Errors were reported in the summary.
+		cmd.SilenceErrors = true
+		return fmt.Errorf("schema apply failed on %d of %d targets", n, len(envs))
+	default:
+		for i, err := range errs {
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", set.Targets[i].URL, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// redactedURL returns the given URL without its credentials.
+func redactedURL(s string) string {
+	u, err := cloudapi.RedactedURL(s)
+	if err != nil {
+		return fmt.Sprintf("Error: redacting URL: %v", err)
+	}
+	return u
+}
+
 func schemaApplyRun(cmd *cobra.Command, flags schemaApplyFlags, env *Env) error {
 	var (
 		err    error
@@ -392,6 +534,9 @@ func schemaApplyRun(cmd *cobra.Command, flags schemaApplyFlags, env *Env) error
 	if err != nil {
 		return err
 	}
+	if flags.planURL != "" {
+		return schemaApplyPlan(cmd, flags, client, diff, format)
+	}
 	maySuggestUpgrade(cmd)
 	// Returning at this stage should
 	// not trigger the help message.
@@ -432,6 +577,424 @@ func schemaApplyRun(cmd *cobra.Command, flags schemaApplyFlags, env *Env) error
 	}
 }
 
+// schemaPlanRun represents the 'atlas schema plan' subcommand.
+func schemaPlanRun(cmd *cobra.Command, _ []string, flags schemaPlanFlags, env *Env) error {
+	ctx := cmd.Context()
+	dev, err := sqlclient.Open(ctx, flags.devURL)
+	if err != nil {
+		return err
+	}
+	defer dev.Close()
+	from, err := stateReader(ctx, env, &stateReaderConfig{
+		urls:    flags.fromURL,
+		dev:     dev,
+		schemas: flags.schemas,
+		exclude: flags.exclude,
+		vars:    env.Vars(),
+	})
+	if err != nil {
+		return err
+	}
+	defer from.Close()
+	// In case the current state is a database connection,
+	// the migration is planned by its driver.
+	client, ok := from.Closer.(*sqlclient.Client)
+	if !ok {
+		client = dev
+	}
+	to, err := stateReader(ctx, env, &stateReaderConfig{
+		urls:    flags.toURL,
+		dev:     dev,
+		client:  client,
+		schemas: flags.schemas,
+		exclude: flags.exclude,
+		vars:    env.Vars(),
+	})
+	if err != nil {
+		return err
+	}
+	defer to.Close()
+	diff, err := computeDiff(ctx, client, from, to, diffOptions(cmd, env)...)
+	if err != nil {
+		return err
+	}
+	if len(diff.changes) == 0 {
+		return errors.New("the database is already in the desired state, no plan was created")
+	}
+	plan := &schemaPlan{Name: flags.name}
+	if plan.Name == "" {
+		plan.Name = time.Now().UTC().Format(versionFormat)
+	}
+	if plan.From, err = schemaHash(client, diff.from); err != nil {
+		return err
+	}
+	if plan.To, err = schemaHash(client, diff.to); err != nil {
+		return err
+	}
+	p, err := client.PlanChanges(ctx, plan.Name, diff.changes, planOptions(client)...)
+	if err != nil {
+		return err
+	}
+	if plan.Migration, err = formatPlan(p); err != nil {
+		return err
+	}
+	if plan.Lint, err = lintSchemaPlan(ctx, dev, env, from.Schema, diff.from, plan); err != nil {
+		return err
+	}
+	// Returning at this stage should
+	// not trigger the help message.
+	cmd.SilenceUsage = true
+	b, err := plan.MarshalHCL()
+	if err != nil {
+		return err
+	}
+	if flags.out == "" {
+		_, err = cmd.OutOrStdout().Write(b)
+		return err
+	}
+	path := strings.TrimPrefix(flags.out, "file://")
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		return err
+	}
+	cmd.Printf("Plan %q with %d statements and %d lint results was written to %s\n", plan.Name, len(p.Changes), len(plan.Lint), path)
+	return nil
+}
+
+// formatPlan formats the planned changes as a migration file. Plans with a custom
+// delimiter, such as MySQL compound statements, are written with the atlas:delimiter
+// directive, to ensure the migration is split back into the same statements.
+func formatPlan(p *migrate.Plan) (string, error) {
+	for _, c := range p.Changes {
+		if len(c.Args) > 0 {
+			return "", fmt.Errorf("planned statement %q has arguments and cannot be written to a plan", c.Cmd)
+		}
+	}
+	var b strings.Builder
+	if err := migrate.DefaultFormatter.FormatTo(p, &b); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// planChanges splits the migration of the plan into the changes to execute.
+func planChanges(drv migrate.Driver, plan *schemaPlan) ([]*migrate.Change, error) {
+	stmts, err := migrate.FileStmtDecls(drv, migrate.NewLocalFile(plan.Name+".sql", []byte(plan.Migration)))
+	if err != nil {
+		return nil, fmt.Errorf("parse plan migration: %w", err)
+	}
+	changes := make([]*migrate.Change, len(stmts))
+	for i, s := range stmts {
+		changes[i] = &migrate.Change{Cmd: strings.TrimSuffix(s.Text, ";")}
+	}
+	return changes, nil
+}
+
+// lintSchemaPlan runs the configured analyzers on the planned migration. The
+// current state is loaded into the dev-database before the plan is analyzed.
+func lintSchemaPlan(ctx context.Context, dev *sqlclient.Client, env *Env, scope string, current *schema.Realm, plan *schemaPlan) ([]*schemaPlanLint, error) {
+	var (
+		changes []schema.Change
+		err     error
+	)
+	switch {
+	case scope != "" && len(current.Schemas) == 1:
+		changes, err = dev.SchemaDiff(schema.New(current.Schemas[0].Name), current.Schemas[0])
+	default:
+		changes, err = dev.RealmDiff(schema.NewRealm(), current)
+	}
+	if err != nil {
+		return nil, err
+	}
+	base, err := dev.PlanChanges(ctx, "base", changes, planOptions(dev)...)
+	if err != nil {
+		return nil, err
+	}
+	dir := &migrate.MemDir{}
+	if len(base.Changes) > 0 {
+		b, err := formatPlan(base)
+		if err != nil {
+			return nil, err
+		}
+		if err := dir.WriteFile("1_base.sql", []byte(b)); err != nil {
+			return nil, err
+		}
+	}
+	if err := dir.WriteFile("2_plan.sql", []byte(plan.Migration)); err != nil {
+		return nil, err
+	}
+	az, err := sqlcheck.AnalyzerFor(dev.Name, env.Lint.Remain())
+	if err != nil {
+		return nil, err
+	}
+	w := &schemaPlanLintWriter{}
+	r := &migratelint.Runner{
+		Dev:            dev,
+		Dir:            dir,
+		ChangeDetector: migratelint.LatestChanges(dir, 1),
+		Analyzers:      az,
+		ReportWriter:   w,
+	}
+	if err := r.Run(ctx); err != nil && w.lint == nil {
+		return nil, fmt.Errorf("linting plan: %w", err)
+	}
+	return w.lint, nil
+}
+
+// schemaPlanLintWriter collects the lint results of a plan.
+type schemaPlanLintWriter struct {
+	lint []*schemaPlanLint
+}
+
+// WriteReport implements migratelint.ReportWriter.
+func (w *schemaPlanLintWriter) WriteReport(r *migratelint.SummaryReport) error {
+	w.lint = []*schemaPlanLint{}
+	for _, f := range r.Files {
+		if f.Error != "" {
+			w.lint = append(w.lint, &schemaPlanLint{Text: f.Error})
+		}
+		for _, rp := range f.Reports {
+			if len(rp.Diagnostics) == 0 {
+				w.lint = append(w.lint, &schemaPlanLint{Text: rp.Text})
+			}
+			for _, d := range rp.Diagnostics {
+				w.lint = append(w.lint, &schemaPlanLint{Code: d.Code, Text: d.Text})
+			}
+		}
+	}
+	return nil
+}
+
+// schemaApplyPlan executes a pre-planned migration after verifying
+// the current and desired states match the hashes stored in the plan.
+func schemaApplyPlan(cmd *cobra.Command, flags schemaApplyFlags, client *sqlclient.Client, diff *diff, format *template.Template) error {
+	ctx := cmd.Context()
+	plan, err := readSchemaPlan(flags.planURL)
+	if err != nil {
+		return err
+	}
+	switch h, err := schemaHash(client, diff.from); {
+	case err != nil:
+		return err
+	case h != plan.From:
+		return fmt.Errorf("the current state of the database does not match the state the plan %q was computed from. Re-plan the migration", plan.Name)
+	}
+	switch h, err := schemaHash(client, diff.to); {
+	case err != nil:
+		return err
+	case h != plan.To:
+		return fmt.Errorf("the desired state does not match the state the plan %q was computed for. Re-plan the migration", plan.Name)
+	}
+	changes, err := planChanges(client.Driver, plan)
+	if err != nil {
+		return err
+	}
+	// Returning at this stage should
+	// not trigger the help message.
+	cmd.SilenceUsage = true
+	if flags.logFormat == "" || !flags.autoApprove {
+		if err := format.Execute(cmd.OutOrStdout(), cmdlog.NewSchemaPlan(ctx, cmdlog.NewEnv(client, nil), changes, nil)); err != nil {
+			return err
+		}
+		if flags.dryRun || !flags.autoApprove && !promptUser(cmd) {
+			return nil
+		}
+	}
+	applied, err := execPlanChanges(ctx, client, changes, flags.txMode)
+	if flags.logFormat != "" && flags.autoApprove {
+		var cause *cmdlog.StmtError
+		if err != nil {
+			cause = &cmdlog.StmtError{Text: err.Error()}
+			if applied < len(changes) {
+				cause.Stmt = changes[applied].Cmd
+			}
+		}
+		err1 := format.Execute(cmd.OutOrStdout(), cmdlog.NewSchemaApply(ctx, cmdlog.NewEnv(client, nil), changes[:applied], changes[applied:], cause))
+		return errors.Join(err, err1)
+	}
+	return err
+}
+
+// execPlanChanges executes the planned changes and returns the number of applied changes.
+func execPlanChanges(ctx context.Context, client *sqlclient.Client, changes []*migrate.Change, txMode string) (int, error) {
+	if txMode == txModeNone {
+		return execChanges(ctx, client, changes)
+	}
+	tx, err := client.Tx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execChanges(ctx, tx.Tx, changes)
+	if err != nil {
+		// Rollback on error, but report the failing statement to the caller.
+		return n, errors.Join(err, tx.Rollback())
+	}
+	return n, tx.Commit()
+}
+
+// execChanges executes the given changes one by one and returns the number of applied changes.
+func execChanges(ctx context.Context, e schema.ExecQuerier, changes []*migrate.Change) (int, error) {
+	for i, c := range changes {
+		if _, err := e.ExecContext(ctx, c.Cmd); err != nil {
+			return i, err
+		}
+	}
+	return len(changes), nil
+}
+
+// schemaPushRun represents the 'atlas schema push' subcommand.
+func schemaPushRun(cmd *cobra.Command, args []string, flags schemaPushFlags, env *Env) (err error) {
+	ctx := cmd.Context()
+	format := cmdlog.RegistryPushTemplate
+	if v := flags.logFormat; v != "" {
+		if format, err = template.New("format").Funcs(cmdlog.ApplyTemplateFuncs).Parse(v); err != nil {
+			return fmt.Errorf("parse format: %w", err)
+		}
+	}
+	repo, tag, err := repoTag(args, env.SchemaRepo())
+	if err != nil {
+		return err
+	}
+	dev, err := sqlclient.Open(ctx, flags.devURL)
+	if err != nil {
+		return err
+	}
+	defer dev.Close()
+	r, err := stateReader(ctx, env, &stateReaderConfig{
+		urls:    flags.urls,
+		dev:     dev,
+		schemas: flags.schemas,
+		exclude: flags.exclude,
+		vars:    env.Vars(),
+	})
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	realm, err := r.ReadState(ctx)
+	if err != nil {
+		return err
+	}
+	// Schemas that were read on a dev-database bound to
+	// a schema are unnamed, and are named after the scope.
+	for _, s := range realm.Schemas {
+		if s.Name == "" {
+			s.Name = dev.URL.Schema
+		}
+	}
+	b, err := dev.MarshalSpec(realm)
+	if err != nil {
+		return err
+	}
+	if tag == "" {
+		h := sha256.Sum256(b)
+		tag = hex.EncodeToString(h[:])[:12]
+	}
+	return pushSnapshot(cmd, &registry.Snapshot{
+		Repo:  repo,
+		Type:  registry.RepoTypeSchema,
+		Tag:   tag,
+		Files: []migrate.File{migrate.NewLocalFile("schema.hcl", b)},
+	}, format)
+}
+
+// schemaTestRun represents the 'atlas schema test' subcommand.
+func schemaTestRun(cmd *cobra.Command, args []string, flags schemaTestFlags, env *Env) (err error) {
+	ctx := cmd.Context()
+	format := cmdlog.TestTemplate
+	if v := flags.logFormat; v != "" {
+		if format, err = template.New("format").Funcs(cmdlog.TestTemplateFuncs).Parse(v); err != nil {
+			return fmt.Errorf("parse format: %w", err)
+		}
+	}
+	var run *regexp.Regexp
+	if flags.run != "" {
+		if run, err = regexp.Compile(flags.run); err != nil {
+			return fmt.Errorf("parse --%s: %w", flagRun, err)
+		}
+	}
+	vars := env.Vars()
+	if env.Test != nil {
+		if len(args) == 0 {
+			args = env.Test.Schema.Src
+		}
+		for k, v := range env.Test.Schema.Vars {
+			vars[k] = v
+		}
+	}
+	paths, err := testFilePaths(args)
+	if err != nil {
+		return err
+	}
+	cases, err := parseTestFiles(ctx, paths, "schema", vars)
+	if err != nil {
+		return err
+	}
+	dev, err := sqlclient.Open(ctx, flags.devURL)
+	if err != nil {
+		return err
+	}
+	defer dev.Close()
+	to, err := stateReader(ctx, env, &stateReaderConfig{
+		urls:    flags.urls,
+		dev:     dev,
+		client:  dev,
+		schemas: flags.schemas,
+		exclude: flags.exclude,
+		vars:    env.Vars(),
+	})
+	if err != nil {
+		return err
+	}
+	defer to.Close()
+	// The desired schema is loaded into the clean dev-database.
+	from := &cmdext.StateReadCloser{
+		StateReader: migrate.RealmConn(dev, &schema.InspectRealmOption{Schemas: flags.schemas, Exclude: flags.exclude}),
+		Schema:      dev.URL.Schema,
+	}
+	if dev.URL.Schema != "" {
+		from.StateReader = migrate.SchemaConn(dev, dev.URL.Schema, &schema.InspectOptions{Exclude: flags.exclude})
+	}
+	diff, err := computeDiff(ctx, dev, from, to, diffOptions(cmd, env)...)
+	if err != nil {
+		return err
+	}
+	// Prevent usage printing after input validation.
+	cmd.SilenceUsage = true
+	report := runTests(ctx, dev, "schema", cases, run, func() testHandler {
+		return &schemaTestHandler{dev: dev, changes: diff.changes}
+	})
+	if err := format.Execute(cmd.OutOrStdout(), report); err != nil {
+		return fmt.Errorf("execute log template: %w", err)
+	}
+	if n := report.Failed(); n > 0 {
+		// Failures were reported by the template.
+		cmd.SilenceErrors = true
+		return fmt.Errorf("%d of %d tests failed", n, len(report.Cases))
+	}
+	return nil
+}
+
+// schemaTestHandler loads the desired schema into the dev-database before each test case.
+type schemaTestHandler struct {
+	dev     *sqlclient.Client
+	changes []schema.Change
+}
+
+func (h *schemaTestHandler) setup(ctx context.Context, _ *testCase) error {
+	if err := h.dev.ApplyChanges(ctx, h.changes); err != nil {
+		return fmt.Errorf("loading the desired schema: %w", err)
+	}
+	return nil
+}
+
+func (*schemaTestHandler) step(context.Context, *schemahcl.Resource) (bool, error) {
+	return false, nil
+}
+
+func (*schemaTestHandler) teardown(context.Context) error {
+	return nil
+}
+
 // applySchemaClean is the community-version of the 'atlas schema clean' handler.
 func applySchemaClean(cmd *cobra.Command, client *sqlclient.Client, drop []schema.Change, flags schemaCleanFlags) error {
 	if flags.dryRun {
@@ -7,15 +7,28 @@
 package cmdapi
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
+	"text/template"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/veiloq/atlas/pkg/cmdlog"
 	cmdmigrate "github.com/veiloq/atlas/pkg/migrate"
+	"github.com/veiloq/atlas/pkg/migrate/ent/revision"
+	"github.com/veiloq/atlas/pkg/registry"
+	"github.com/veiloq/atlas/schemahcl"
 	"github.com/veiloq/atlas/sql/migrate"
+	"github.com/veiloq/atlas/sql/schema"
+	"github.com/veiloq/atlas/sql/sqlclient"
 )
 
 // migrateApplyRun represents the 'atlas migrate apply' subcommand.
@@ -154,3 +167,638 @@ func migrateApplyRun(cmd *cobra.Command, args []string, flags migrateApplyFlags,
 	}
 	return errors.Join(err, mr.Done(cmd, flags))
 }
+
+// migrateDownRun represents the 'atlas migrate down' subcommand.
+func migrateDownRun(cmd *cobra.Command, args []string, flags migrateDownFlags, env *Env) (err error) {
+	ctx := cmd.Context()
+	format := cmdlog.MigrateDownTemplate
+	if v := flags.logFormat; v != "" {
+		if format, err = template.New("format").Funcs(cmdlog.ApplyTemplateFuncs).Parse(v); err != nil {
+			return fmt.Errorf("parse format: %w", err)
+		}
+	}
+	switch flags.txMode {
+	case txModeNone, txModeFile, txModeAll:
+	default:
+		return fmt.Errorf("unknown tx-mode %q", flags.txMode)
+	}
+	dirURL, err := url.Parse(flags.dirURL)
+	if err != nil {
+		return fmt.Errorf("parse dir-url: %w", err)
+	}
+	dir, err := cmdmigrate.DirURL(ctx, dirURL, false)
+	if err != nil {
+		return err
+	}
+	client, err := env.openClient(ctx, flags.url)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	dev, err := sqlclient.Open(ctx, flags.devURL)
+	if err != nil {
+		return err
+	}
+	defer dev.Close()
+	if client.URL.Schema != "" && dev.URL.Schema == "" || client.URL.Schema == "" && dev.URL.Schema != "" {
+		return errors.New("--url and --dev-url must be both bound to a schema or both not bound to a schema")
+	}
+	// Prevent usage printing after input validation.
+	cmd.SilenceUsage = true
+	// Acquire a lock.
+	unlock, err := client.Driver.Lock(ctx, applyLockValue, flags.lockTimeout)
+	if err != nil {
+		return fmt.Errorf("acquiring database lock: %w", err)
+	}
+	// If unlocking fails notify the user about it.
+	defer func() { cobra.CheckErr(unlock()) }()
+	if err := checkRevisionSchemaClarity(cmd, client, flags.revisionSchema); err != nil {
+		return err
+	}
+	rrw, err := entRevisions(ctx, client, flags.revisionSchema)
+	if err != nil {
+		return err
+	}
+	if err := rrw.Migrate(ctx); err != nil {
+		return err
+	}
+	revs, err := rrw.ReadRevisions(ctx)
+	if err != nil {
+		return err
+	}
+	report := cmdlog.NewMigrateDown(ctx, client, dirURL)
+	report.DryRun = flags.dryRun
+	if report.Revisions, report.Target, err = revertRevisions(revs, args, flags.toVersion); err != nil {
+		return err
+	}
+	if len(report.Revisions) == 0 {
+		report.End = time.Now()
+		return logDown(cmd, format, flags, report)
+	}
+	report.Current = report.Revisions[len(report.Revisions)-1].Version
+	// Compute the desired state by replaying the migration
+	// directory up to the target version on the dev-database.
+	ex, err := migrate.NewExecutor(dev.Driver, dir, migrate.NopRevisionReadWriter{})
+	if err != nil {
+		return err
+	}
+	devState := func() migrate.StateReader {
+		if dev.URL.Schema != "" {
+			return migrate.SchemaConn(dev, "", nil)
+		}
+		return migrate.RealmConn(dev, nil)
+	}()
+	var desired *schema.Realm
+	switch {
+	case report.Target == "":
+		desired, err = devState.ReadState(ctx)
+	default:
+		desired, err = ex.Replay(ctx, devState, migrate.ReplayToVersion(report.Target))
+	}
+	if err != nil {
+		return fmt.Errorf("replaying the migration directory: %w", err)
+	}
+	current, err := downCurrentState(ctx, client, rrw.Ident(), desired)
+	if err != nil {
+		return err
+	}
+	var changes []schema.Change
+	switch {
+	case client.URL.Schema != "":
+		if len(current.Schemas) != 1 || len(desired.Schemas) != 1 {
+			return fmt.Errorf("expect exactly one schema in schema-bound connections, got %d and %d", len(current.Schemas), len(desired.Schemas))
+		}
+		// The dev-database schema name is irrelevant to the comparison.
+		desired.Schemas[0].Name = current.Schemas[0].Name
+		changes, err = client.SchemaDiff(current.Schemas[0], desired.Schemas[0], diffOptions(cmd, env)...)
+	default:
+		changes, err = client.RealmDiff(current, desired, diffOptions(cmd, env)...)
+	}
+	if err != nil {
+		return err
+	}
+	if len(changes) > 0 {
+		plan, err := client.PlanChanges(ctx, "", changes, planOptions(client)...)
+		if err != nil {
+			return err
+		}
+		report.Planned = plan.Changes
+	}
+	if !flags.dryRun {
+		err = downApply(ctx, client, flags, rrw, report)
+	}
+	report.End = time.Now()
+	return errors.Join(err, logDown(cmd, format, flags, report))
+}
+
+// downCurrentState returns the current state of the connected database, excluding
+// the revisions table that is managed by Atlas. In case the revisions table resides in
+// a schema that is not part of the desired state, the entire schema is excluded, as it
+// does not exist in the dev database and would otherwise be planned for deletion.
+func downCurrentState(ctx context.Context, client *sqlclient.Client, revT *migrate.TableIdent, desired *schema.Realm) (*schema.Realm, error) {
+	if client.URL.Schema != "" {
+		var exclude []string
+		if revT.Schema == "" || revT.Schema == client.URL.Schema {
+			exclude = append(exclude, revT.Name)
+		}
+		return migrate.SchemaConn(client, "", &schema.InspectOptions{Exclude: exclude}).ReadState(ctx)
+	}
+	exclude := []string{fmt.Sprintf("*.%s", revT.Name)}
+	if revT.Schema != "" {
+		if _, ok := desired.Schema(revT.Schema); !ok {
+			exclude = []string{revT.Schema}
+		}
+	}
+	return migrate.RealmConn(client, &schema.InspectRealmOption{Exclude: exclude}).ReadState(ctx)
+}
+
+// downApply executes the planned statements of the given report on the database
+// and deletes the reverted revisions from the revisions table. Unless the transaction
+// mode is "none", both are executed in one transaction, as the reverse plan is computed
+// for all reverted files at once, and a partial revert cannot be recorded in the table.
+func downApply(ctx context.Context, client *sqlclient.Client, flags migrateDownFlags, rrw migrate.RevisionReadWriter, report *cmdlog.MigrateDown) (err error) {
+	if flags.txMode != txModeNone {
+		var tx *sqlclient.TxClient
+		if tx, err = client.Tx(ctx, nil); err != nil {
+			return err
+		}
+		defer func() {
+			if err != nil {
+				err = errors.Join(err, tx.Rollback())
+			} else {
+				err = tx.Commit()
+			}
+		}()
+		if rrw, err = entRevisions(ctx, tx.Client, flags.revisionSchema); err != nil {
+			return err
+		}
+		client = tx.Client
+	}
+	for _, c := range report.Planned {
+		if _, err := client.ExecContext(ctx, c.Cmd, c.Args...); err != nil {
+			report.Error = &cmdlog.StmtError{Stmt: c.Cmd, Text: err.Error()}
+			return err
+		}
+		report.Applied = append(report.Applied, c)
+	}
+	// Delete the revisions in reverse order, to keep
+	// the history linear in case of a failure.
+	for i := len(report.Revisions) - 1; i >= 0; i-- {
+		if err := rrw.DeleteRevision(ctx, report.Revisions[i].Version); err != nil {
+			report.Error = &cmdlog.StmtError{Text: err.Error()}
+			return err
+		}
+	}
+	return nil
+}
+
+func logDown(cmd *cobra.Command, f *template.Template, flags migrateDownFlags, r *cmdlog.MigrateDown) error {
+	if err := f.Execute(cmd.OutOrStdout(), r); err != nil {
+		return fmt.Errorf("execute log template: %w", err)
+	}
+	// In case a custom logging was configured, avoid reporting errors twice.
+	cmd.SilenceErrors = flags.logFormat != ""
+	return nil
+}
+
+// migrateCheckpointRun represents the 'atlas migrate checkpoint' subcommand.
+func migrateCheckpointRun(cmd *cobra.Command, args []string, flags migrateCheckpointFlags, env *Env) error {
+	ctx := cmd.Context()
+	dev, err := sqlclient.Open(ctx, flags.devURL)
+	if err != nil {
+		return err
+	}
+	defer dev.Close()
+	// Acquire a lock.
+	unlock, err := dev.Lock(ctx, "atlas_migrate_checkpoint", flags.lockTimeout)
+	if err != nil {
+		return fmt.Errorf("acquiring database lock: %w", err)
+	}
+	// If unlocking fails notify the user about it.
+	defer func() { cobra.CheckErr(unlock()) }()
+	u, err := url.Parse(flags.dirURL)
+	if err != nil {
+		return err
+	}
+	dir, err := cmdmigrate.DirURL(ctx, u, false)
+	if err != nil {
+		return err
+	}
+	if err := migrate.Validate(dir); err != nil {
+		printChecksumError(cmd, err)
+		return err
+	}
+	var name, indent string
+	if len(args) > 0 {
+		name = args[0]
+	}
+	f, err := cmdmigrate.Formatter(u)
+	if err != nil {
+		return err
+	}
+	if f, indent, err = mayIndent(u, f, flags.format); err != nil {
+		return err
+	}
+	opts := []migrate.PlannerOption{
+		migrate.PlanFormat(f),
+		migrate.PlanWithIndent(indent),
+		migrate.PlanWithDiffOptions(diffOptions(cmd, env)...),
+	}
+	if dev.URL.Schema != "" {
+		// Disable tables qualifier in schema-mode.
+		opts = append(opts, migrate.PlanWithSchemaQualifier(flags.qualifier))
+	}
+	pl := migrate.NewPlanner(dev.Driver, dir, opts...)
+	plan, err := func() (*migrate.Plan, error) {
+		if dev.URL.Schema != "" {
+			return pl.CheckpointSchema(ctx, name)
+		}
+		return pl.Checkpoint(ctx, name)
+	}()
+	var cerr *migrate.NotCleanError
+	switch {
+	case errors.As(err, &cerr) && dev.URL.Schema == "":
+		return fmt.Errorf("dev database is not clean (%s). Add a schema to the URL to limit the scope of the connection", cerr.Reason)
+	case err != nil:
+		return err
+	default:
+		return pl.WriteCheckpoint(plan, flags.tag)
+	}
+}
+
+// migrateRebaseRun represents the 'atlas migrate rebase' subcommand.
+func migrateRebaseRun(cmd *cobra.Command, args []string, flags migrateRebaseFlags) error {
+	ctx := cmd.Context()
+	dir, err := cmdmigrate.Dir(ctx, flags.dirURL, false)
+	if err != nil {
+		return err
+	}
+	local, ok := dir.(*migrate.LocalDir)
+	if !ok {
+		return fmt.Errorf("rebase supports only atlas directories, but got: %T", dir)
+	}
+	files, err := local.Files()
+	if err != nil {
+		return err
+	}
+	layout, rebased, err := rebaseFiles(files, args, time.Now())
+	if err != nil {
+		return err
+	}
+	dev, err := sqlclient.Open(ctx, flags.devURL)
+	if err != nil {
+		return err
+	}
+	defer dev.Close()
+	// Prevent usage printing after input validation.
+	cmd.SilenceUsage = true
+	// Replay the rebased directory on the dev-database
+	// before changing the actual migration directory.
+	mem := &migrate.MemDir{}
+	defer mem.Close()
+	if err := mem.CopyFiles(layout); err != nil {
+		return err
+	}
+	ex, err := migrate.NewExecutor(dev.Driver, mem, migrate.NopRevisionReadWriter{})
+	if err != nil {
+		return err
+	}
+	if _, err := ex.Replay(ctx, func() migrate.StateReader {
+		if dev.URL.Schema != "" {
+			return migrate.SchemaConn(dev, "", nil)
+		}
+		return migrate.RealmConn(dev, nil)
+	}()); err != nil && !errors.Is(err, migrate.ErrNoPendingFiles) {
+		return fmt.Errorf("replaying the rebased migration directory: %w", err)
+	}
+	for name, f := range rebased {
+		if name == f.Name() {
+			continue
+		}
+		if err := local.WriteFile(f.Name(), f.Bytes()); err != nil {
+			return err
+		}
+		if err := os.Remove(filepath.Join(local.Path(), name)); err != nil {
+			return err
+		}
+	}
+	sum, err := local.Checksum()
+	if err != nil {
+		return err
+	}
+	return migrate.WriteSumFile(local, sum)
+}
+
+// migrateRmRun represents the 'atlas migrate rm' subcommand.
+func migrateRmRun(cmd *cobra.Command, args []string, flags migrateRmFlags) error {
+	ctx := cmd.Context()
+	dir, err := cmdmigrate.Dir(ctx, flags.dirURL, false)
+	if err != nil {
+		return err
+	}
+	local, ok := dir.(*migrate.LocalDir)
+	if !ok {
+		return fmt.Errorf("rm supports only atlas directories, but got: %T", dir)
+	}
+	files, err := local.Files()
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return errors.New("no migration files to remove")
+	}
+	f := files[len(files)-1]
+	if len(args) > 0 {
+		idx := slices.IndexFunc(files, func(f migrate.File) bool {
+			return f.Version() == args[0]
+		})
+		if idx == -1 {
+			return fmt.Errorf("migration file with version %q was not found", args[0])
+		}
+		f = files[idx]
+	}
+	if flags.url != "" {
+		client, err := sqlclient.Open(ctx, flags.url)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		if err := checkRevisionSchemaClarity(cmd, client, flags.revisionSchema); err != nil {
+			return err
+		}
+		applied, err := revisionExists(ctx, client, revisionSchemaName(client, flags.revisionSchema), f.Version())
+		if err != nil {
+			return err
+		}
+		if applied {
+			return fmt.Errorf("migration file %q was applied to the database and cannot be removed", f.Name())
+		}
+	}
+	if err := os.Remove(filepath.Join(local.Path(), f.Name())); err != nil {
+		return err
+	}
+	sum, err := local.Checksum()
+	if err != nil {
+		return err
+	}
+	return migrate.WriteSumFile(local, sum)
+}
+
+// revisionExists reports if the given version exists in the revisions table. The check is
+// read-only, and the revisions table is neither created nor migrated to its latest layout.
+func revisionExists(ctx context.Context, c *sqlclient.Client, rs, version string) (bool, error) {
+	s, err := c.InspectSchema(ctx, rs, &schema.InspectOptions{Tables: []string{revision.Table}})
+	switch {
+	case schema.IsNotExistError(err):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+	if _, ok := s.Table(revision.Table); !ok {
+		return false, nil
+	}
+	rrw, err := cmdmigrate.RevisionsForClient(ctx, c, rs)
+	if err != nil {
+		return false, err
+	}
+	switch _, err := rrw.ReadRevision(ctx, version); {
+	case errors.Is(err, migrate.ErrRevisionNotExist):
+		return false, nil
+	case err != nil:
+		return false, err
+	default:
+		return true, nil
+	}
+}
+
+// migrateEditRun represents the 'atlas migrate edit' subcommand.
+func migrateEditRun(cmd *cobra.Command, args []string, flags migrateEditFlags) error {
+	dir, err := cmdmigrate.Dir(cmd.Context(), flags.dirURL, false)
+	if err != nil {
+		return err
+	}
+	local, ok := dir.(*migrate.LocalDir)
+	if !ok {
+		return fmt.Errorf("edit supports only atlas directories, but got: %T", dir)
+	}
+	files, err := local.Files()
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(files, func(f migrate.File) bool {
+		return f.Version() == args[0] || f.Name() == args[0]
+	})
+	if idx == -1 {
+		return fmt.Errorf("migration file %q was not found", args[0])
+	}
+	if err := (&editDir{local}).WriteFile(files[idx].Name(), files[idx].Bytes()); err != nil {
+		return err
+	}
+	sum, err := local.Checksum()
+	if err != nil {
+		return err
+	}
+	if err := migrate.WriteSumFile(local, sum); err != nil {
+		return err
+	}
+	// Prevent usage printing after the file was edited.
+	cmd.SilenceUsage = true
+	return migrateValidateRun(cmd, nil, migrateValidateFlags{
+		devURL: flags.devURL,
+		dirURL: flags.dirURL,
+	})
+}
+
+// migrateTestRun represents the 'atlas migrate test' subcommand.
+func migrateTestRun(cmd *cobra.Command, args []string, flags migrateTestFlags, env *Env) (err error) {
+	ctx := cmd.Context()
+	format := cmdlog.TestTemplate
+	if v := flags.logFormat; v != "" {
+		if format, err = template.New("format").Funcs(cmdlog.TestTemplateFuncs).Parse(v); err != nil {
+			return fmt.Errorf("parse format: %w", err)
+		}
+	}
+	var run *regexp.Regexp
+	if flags.run != "" {
+		if run, err = regexp.Compile(flags.run); err != nil {
+			return fmt.Errorf("parse --%s: %w", flagRun, err)
+		}
+	}
+	vars := env.Vars()
+	if env.Test != nil {
+		if len(args) == 0 {
+			args = env.Test.Migrate.Src
+		}
+		for k, v := range env.Test.Migrate.Vars {
+			vars[k] = v
+		}
+	}
+	paths, err := testFilePaths(args)
+	if err != nil {
+		return err
+	}
+	cases, err := parseTestFiles(ctx, paths, "migrate", vars)
+	if err != nil {
+		return err
+	}
+	dir, err := cmdmigrate.Dir(ctx, flags.dirURL, false)
+	if err != nil {
+		return err
+	}
+	files, err := dir.Files()
+	if err != nil {
+		return err
+	}
+	dev, err := sqlclient.Open(ctx, flags.devURL)
+	if err != nil {
+		return err
+	}
+	defer dev.Close()
+	ex, err := migrate.NewExecutor(dev.Driver, dir, migrate.NopRevisionReadWriter{})
+	if err != nil {
+		return err
+	}
+	// Prevent usage printing after input validation.
+	cmd.SilenceUsage = true
+	report := runTests(ctx, dev, "migrate", cases, run, func() testHandler {
+		return &migrateTestHandler{ex: ex, files: migrate.SkipCheckpointFiles(files)}
+	})
+	if err := format.Execute(cmd.OutOrStdout(), report); err != nil {
+		return fmt.Errorf("execute log template: %w", err)
+	}
+	if n := report.Failed(); n > 0 {
+		// Failures were reported by the template.
+		cmd.SilenceErrors = true
+		return fmt.Errorf("%d of %d tests failed", n, len(report.Cases))
+	}
+	return nil
+}
+
+// migrateTestHandler handles the 'migrate' steps of a migration test case.
+type migrateTestHandler struct {
+	ex      *migrate.Executor
+	files   []migrate.File
+	applied int // number of applied files.
+}
+
+// setup applies the entire directory in case the test case does not contain 'migrate' steps.
+func (h *migrateTestHandler) setup(ctx context.Context, tc *testCase) error {
+	if slices.ContainsFunc(tc.steps, func(s *schemahcl.Resource) bool { return s.Type == "migrate" }) {
+		return nil
+	}
+	return h.migrate(ctx, len(h.files))
+}
+
+// step executes the migration files up to the version defined by the 'migrate' step.
+func (h *migrateTestHandler) step(ctx context.Context, s *schemahcl.Resource) (bool, error) {
+	if s.Type != "migrate" {
+		return false, nil
+	}
+	var st struct {
+		To string `spec:"to"`
+	}
+	if err := s.As(&st); err != nil {
+		return false, err
+	}
+	idx := slices.IndexFunc(h.files, func(f migrate.File) bool {
+		return f.Version() == st.To
+	})
+	switch {
+	case idx == -1:
+		return false, fmt.Errorf("migrate: migration file with version %q was not found", st.To)
+	case idx < h.applied:
+		return false, fmt.Errorf("migrate: migration file with version %q was already applied", st.To)
+	}
+	return true, h.migrate(ctx, idx+1)
+}
+
+// teardown applies the rest of the migration files.
+func (h *migrateTestHandler) teardown(ctx context.Context) error {
+	return h.migrate(ctx, len(h.files))
+}
+
+// migrate executes the pending files up to the n-th file.
+func (h *migrateTestHandler) migrate(ctx context.Context, n int) error {
+	if err := h.ex.ExecuteFiles(ctx, h.files[h.applied:n]); err != nil {
+		return err
+	}
+	h.applied = n
+	return nil
+}
+
+// migratePushRun represents the 'atlas migrate push' subcommand.
+func migratePushRun(cmd *cobra.Command, args []string, flags migratePushFlags, env *Env) (err error) {
+	ctx := cmd.Context()
+	format := cmdlog.RegistryPushTemplate
+	if v := flags.logFormat; v != "" {
+		if format, err = template.New("format").Funcs(cmdlog.ApplyTemplateFuncs).Parse(v); err != nil {
+			return fmt.Errorf("parse format: %w", err)
+		}
+	}
+	repo, tag, err := repoTag(args, env.MigrationRepo())
+	if err != nil {
+		return err
+	}
+	dir, err := cmdmigrate.Dir(ctx, flags.dirURL, false)
+	if err != nil {
+		return err
+	}
+	local, ok := dir.(*migrate.LocalDir)
+	if !ok {
+		return fmt.Errorf("push supports only atlas directories, but got: %T", dir)
+	}
+	files, err := local.Files()
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return errors.New("cannot push an empty migration directory")
+	}
+	if tag == "" {
+		tag = files[len(files)-1].Version()
+	}
+	// Ensure the directory can be replayed before it is pushed.
+	if err := migrateValidateRun(cmd, nil, migrateValidateFlags{
+		devURL: flags.devURL,
+		dirURL: flags.dirURL,
+	}); err != nil {
+		return err
+	}
+	sum, err := local.Open(migrate.HashFileName)
+	if err != nil {
+		return err
+	}
+	defer sum.Close()
+	b, err := io.ReadAll(sum)
+	if err != nil {
+		return err
+	}
+	s := &registry.Snapshot{
+		Repo:  repo,
+		Type:  registry.RepoTypeDir,
+		Tag:   tag,
+		Files: append(files, migrate.NewLocalFile(migrate.HashFileName, b)),
+	}
+	return pushSnapshot(cmd, s, format)
+}
+
+// pushSnapshot pushes the snapshot to the registry and logs its summary.
+func pushSnapshot(cmd *cobra.Command, s *registry.Snapshot, format *template.Template) error {
+	r, err := registry.Default(cmd.Context())
+	if err != nil {
+		return err
+	}
+	// Prevent usage printing after input validation.
+	cmd.SilenceUsage = true
+	if err := r.Push(cmd.Context(), s); err != nil {
+		return err
+	}
+	return format.Execute(cmd.OutOrStdout(), &cmdlog.RegistryPush{
+		URL:     s.URL(),
+		Repo:    s.Repo,
+		Type:    s.Type,
+		Tag:     s.Tag,
+		Created: s.Created,
+		Files:   len(s.Files),
+	})
+}
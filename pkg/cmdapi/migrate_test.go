@@ -22,6 +22,8 @@ import (
 
 	"github.com/veiloq/atlas/pkg/cmdlog"
 	migrate2 "github.com/veiloq/atlas/pkg/migrate"
+	"github.com/veiloq/atlas/pkg/migrate/ent/revision"
+	"github.com/veiloq/atlas/pkg/registry"
 	"github.com/veiloq/atlas/sql/migrate"
 	"github.com/veiloq/atlas/sql/schema"
 	"github.com/veiloq/atlas/sql/sqlclient"
@@ -876,6 +878,149 @@ env "local" {
 	})
 }
 
+func TestMigrate_Down(t *testing.T) {
+	// Disable text coloring in testing
+	// to assert on string matching.
+	color.NoColor = true
+	var (
+		u   = openSQLite(t, "")
+		dev = openSQLite(t, "")
+	)
+	s, err := runCmd(migrateDownCmd(), "--dir", "file://testdata/sqlite", "--url", u, "--dev-url", dev)
+	require.NoError(t, err)
+	require.Equal(t, "No migration files to revert\n", s)
+
+	_, err = runCmd(migrateApplyCmd(), "--dir", "file://testdata/sqlite", "--url", u)
+	require.NoError(t, err)
+
+	// Invalid arguments.
+	_, err = runCmd(migrateDownCmd(), "--dir", "file://testdata/sqlite", "--url", u, "--dev-url", dev, "0")
+	require.EqualError(t, err, `cannot revert '0' migration files`)
+	_, err = runCmd(migrateDownCmd(), "--dir", "file://testdata/sqlite", "--url", u, "--dev-url", dev, "--to-version", "1", "1")
+	require.EqualError(t, err, `the amount argument and --to-version are mutually exclusive`)
+	_, err = runCmd(migrateDownCmd(), "--dir", "file://testdata/sqlite", "--url", u, "--dev-url", dev, "--to-version", "1")
+	require.EqualError(t, err, `revision with version "1" was not found in the revisions table`)
+
+	// Dry-run does not change the database.
+	s, err = runCmd(migrateDownCmd(), "--dir", "file://testdata/sqlite", "--url", u, "--dev-url", dev, "--dry-run")
+	require.NoError(t, err)
+	require.Contains(t, s, "Planning to revert from version 20220318104615 to 20220318104614 (1 migration in total):")
+	require.Contains(t, s, "-- 20220318104615 (second)")
+	require.Contains(t, s, "-- planned statements")
+	require.Contains(t, s, "-- 1 migration to revert")
+	s, err = runCmd(migrateStatusCmd(), "--dir", "file://testdata/sqlite", "--url", u)
+	require.NoError(t, err)
+	require.Contains(t, s, "Current Version: 20220318104615")
+
+	// Revert the last migration file.
+	s, err = runCmd(migrateDownCmd(), "--dir", "file://testdata/sqlite", "--url", u, "--dev-url", dev)
+	require.NoError(t, err)
+	require.Contains(t, s, "Reverting from version 20220318104615 to 20220318104614 (1 migration in total):")
+	require.Contains(t, s, "-- reverting to version 20220318104614")
+	require.Contains(t, s, "-- 1 migration reverted")
+	s, err = runCmd(migrateStatusCmd(), "--dir", "file://testdata/sqlite", "--url", u)
+	require.NoError(t, err)
+	require.Contains(t, s, "Current Version: 20220318104614")
+	s, err = runCmd(schemaInspectCmd(), "--url", u, "--exclude", "atlas_schema_revisions", "--format", "{{ sql . }}")
+	require.NoError(t, err)
+	require.NotContains(t, s, "col_2")
+
+	// Custom logging.
+	s, err = runCmd(migrateDownCmd(), "--dir", "file://testdata/sqlite", "--url", u, "--dev-url", dev, "--format", "{{ .Target }} {{ len .Revisions }}")
+	require.NoError(t, err)
+	require.Equal(t, " 1", s)
+	s, err = runCmd(migrateStatusCmd(), "--dir", "file://testdata/sqlite", "--url", u)
+	require.NoError(t, err)
+	require.Contains(t, s, "Migration Status: PENDING")
+	s, err = runCmd(schemaInspectCmd(), "--url", u, "--exclude", "atlas_schema_revisions", "--format", "{{ sql . }}")
+	require.NoError(t, err)
+	require.NotContains(t, s, "tbl")
+}
+
+func TestMigrate_DownApply(t *testing.T) {
+	ctx := context.Background()
+	for mode, reverted := range map[string]bool{txModeFile: false, txModeNone: true} {
+		t.Run(mode, func(t *testing.T) {
+			u := openSQLite(t, "")
+			_, err := runCmd(migrateApplyCmd(), "--dir", "file://testdata/sqlite", "--url", u)
+			require.NoError(t, err)
+			client, err := sqlclient.Open(ctx, u)
+			require.NoError(t, err)
+			defer client.Close()
+			rrw, err := entRevisions(ctx, client, "")
+			require.NoError(t, err)
+			revs, err := rrw.ReadRevisions(ctx)
+			require.NoError(t, err)
+			require.Len(t, revs, 2)
+
+			// The second statement fails after the first one was executed.
+			report := &cmdlog.MigrateDown{
+				Revisions: revs,
+				Planned: []*migrate.Change{
+					{Cmd: "DROP TABLE `tbl`"},
+					{Cmd: "DROP TABLE `unknown`"},
+				},
+			}
+			err = downApply(ctx, client, migrateDownFlags{txMode: mode}, rrw, report)
+			require.Error(t, err)
+			require.Equal(t, "DROP TABLE `unknown`", report.Error.Stmt)
+			require.Len(t, report.Applied, 1)
+			s, err := runCmd(schemaInspectCmd(), "--url", u, "--exclude", "atlas_schema_revisions", "--format", "{{ sql . }}")
+			require.NoError(t, err)
+			require.Equal(t, reverted, !strings.Contains(s, "tbl"))
+			// Revisions are deleted only after all statements were executed.
+			s, err = runCmd(migrateStatusCmd(), "--dir", "file://testdata/sqlite", "--url", u)
+			require.NoError(t, err)
+			require.Contains(t, s, "Current Version: 20220318104615")
+		})
+	}
+}
+
+func TestMigrate_DownCurrentState(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		drv    = &realmInspectDriver{}
+		client = &sqlclient.Client{Driver: drv, URL: &sqlclient.URL{}}
+	)
+	for _, tt := range []struct {
+		revT    *migrate.TableIdent
+		desired *schema.Realm
+		exclude []string
+		schemas []string
+	}{
+		// Default revisions schema.
+		{
+			revT:    &migrate.TableIdent{Schema: defaultRevisionSchema, Name: revision.Table},
+			desired: schema.NewRealm(schema.New("app")),
+			exclude: []string{defaultRevisionSchema},
+			schemas: []string{"app", "revs"},
+		},
+		// Custom revisions schema that is not part of the migrated schemas.
+		{
+			revT:    &migrate.TableIdent{Schema: "revs", Name: revision.Table},
+			desired: schema.NewRealm(schema.New("app")),
+			exclude: []string{"revs"},
+			schemas: []string{"app", defaultRevisionSchema},
+		},
+		// Custom revisions schema that is also migrated.
+		{
+			revT:    &migrate.TableIdent{Schema: "app", Name: revision.Table},
+			desired: schema.NewRealm(schema.New("app")),
+			exclude: []string{"*." + revision.Table},
+			schemas: []string{"app", defaultRevisionSchema, "revs"},
+		},
+	} {
+		current, err := downCurrentState(ctx, client, tt.revT, tt.desired)
+		require.NoError(t, err)
+		require.Equal(t, tt.exclude, drv.opts.Exclude)
+		names := make([]string, len(current.Schemas))
+		for i, s := range current.Schemas {
+			names[i] = s.Name
+		}
+		require.Equal(t, tt.schemas, names)
+	}
+}
+
 func TestMigrate_Diff(t *testing.T) {
 	p := t.TempDir()
 	to := hclURL(t)
@@ -1252,6 +1397,323 @@ table "users" {
 	})
 }
 
+func TestMigrate_Checkpoint(t *testing.T) {
+	p := t.TempDir()
+	for _, f := range []string{"20220318104614_initial.sql", "20220318104615_second.sql", migrate.HashFileName} {
+		require.NoError(t, copyFile(filepath.Join("testdata/sqlite", f), filepath.Join(p, f)))
+	}
+	// Existing databases are migrated before the checkpoint was created.
+	existing := openSQLite(t, "")
+	_, err := runCmd(migrateApplyCmd(), "--dir", "file://"+p, "--url", existing)
+	require.NoError(t, err)
+
+	// Expect no clean dev error.
+	_, err = runCmd(
+		migrateCheckpointCmd(),
+		"--dir", "file://"+p,
+		"--dev-url", openSQLite(t, "create table t (c int);"),
+	)
+	require.ErrorAs(t, err, new(*migrate.NotCleanError))
+
+	s, err := runCmd(
+		migrateCheckpointCmd(),
+		"v1",
+		"--dir", "file://"+p,
+		"--dev-url", openSQLite(t, ""),
+		"--tag", "v1.0.0",
+	)
+	require.NoError(t, err)
+	require.Zero(t, s)
+	matches, err := filepath.Glob(filepath.Join(p, "*_v1.sql"))
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	buf, err := os.ReadFile(matches[0])
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "-- atlas:checkpoint v1.0.0")
+	require.Contains(t, string(buf), "`col_2`")
+	dir, err := migrate.NewLocalDir(p)
+	require.NoError(t, err)
+	require.NoError(t, migrate.Validate(dir))
+	files, err := dir.CheckpointFiles()
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	tag, err := files[0].(migrate.CheckpointFile).CheckpointTag()
+	require.NoError(t, err)
+	require.Equal(t, "v1.0.0", tag)
+
+	// New databases start from the checkpoint.
+	s, err = runCmd(migrateApplyCmd(), "--dir", "file://"+p, "--url", openSQLite(t, ""))
+	require.NoError(t, err)
+	require.Contains(t, s, "(1 migrations in total)")
+
+	// Existing databases are not affected by the checkpoint.
+	s, err = runCmd(migrateApplyCmd(), "--dir", "file://"+p, "--url", existing)
+	require.NoError(t, err)
+	require.Equal(t, "No migration files to execute\n", s)
+}
+
+func TestMigrate_Rebase(t *testing.T) {
+	p := t.TempDir()
+	for _, f := range []string{"20220318104614_initial.sql", "20220318104615_second.sql"} {
+		require.NoError(t, copyFile(filepath.Join("testdata/sqlite", f), filepath.Join(p, f)))
+	}
+	// A file that was added in a different branch.
+	require.NoError(t, os.WriteFile(filepath.Join(p, "20220318104610_third.sql"), []byte("CREATE TABLE t2 (c int);\n"), 0600))
+	_, err := runCmd(migrateHashCmd(), "--dir", "file://"+p)
+	require.NoError(t, err)
+
+	_, err = runCmd(migrateRebaseCmd(), "1", "--dir", "file://"+p, "--dev-url", openSQLite(t, ""))
+	require.EqualError(t, err, `migration file with version "1" was not found`)
+
+	// Files that were changed after hashing are not re-hashed by the rebase.
+	third := filepath.Join(p, "20220318104610_third.sql")
+	require.NoError(t, os.WriteFile(third, []byte("CREATE TABLE t3 (c int);\n"), 0600))
+	s, err := runCmd(migrateRebaseCmd(), "20220318104610", "--dir", "file://"+p, "--dev-url", openSQLite(t, ""))
+	require.ErrorIs(t, err, migrate.ErrChecksumMismatch)
+	require.Contains(t, s, "You have a checksum error in your migration directory.")
+	require.FileExists(t, third)
+	require.NoError(t, os.WriteFile(third, []byte("CREATE TABLE t2 (c int);\n"), 0600))
+
+	// Directory is not changed in case the replay fails.
+	_, err = runCmd(migrateRebaseCmd(), "20220318104614", "--dir", "file://"+p, "--dev-url", openSQLite(t, ""))
+	require.ErrorContains(t, err, "replaying the rebased migration directory")
+	require.FileExists(t, filepath.Join(p, "20220318104614_initial.sql"))
+	require.Equal(t, 4, countFiles(t, p))
+
+	_, err = runCmd(migrateRebaseCmd(), "20220318104610", "--dir", "file://"+p, "--dev-url", openSQLite(t, ""))
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(p, "20220318104610_third.sql"))
+	require.Equal(t, 4, countFiles(t, p))
+	dir, err := migrate.NewLocalDir(p)
+	require.NoError(t, err)
+	require.NoError(t, migrate.Validate(dir))
+	files, err := dir.Files()
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, "20220318104614_initial.sql", files[0].Name())
+	require.Equal(t, "20220318104615_second.sql", files[1].Name())
+	require.Equal(t, "third", files[2].Desc())
+	require.Equal(t, "CREATE TABLE t2 (c int);\n", string(files[2].Bytes()))
+}
+
+func TestMigrate_Push(t *testing.T) {
+	t.Setenv(registry.EnvURL, "file://"+t.TempDir())
+	p := t.TempDir()
+	for _, f := range []string{"20220318104614_initial.sql", "20220318104615_second.sql", migrate.HashFileName} {
+		require.NoError(t, copyFile(filepath.Join("testdata/sqlite", f), filepath.Join(p, f)))
+	}
+	_, err := runCmd(migratePushCmd(), "--dir", "file://"+p)
+	require.EqualError(t, err, "repository name is required. Provide it as an argument, or configure it using the repo block in the project file")
+
+	// The latest version is used as the default tag.
+	s, err := runCmd(migratePushCmd(), "app", "--dir", "file://"+p, "--dev-url", openSQLite(t, ""))
+	require.NoError(t, err)
+	require.Equal(t, "atlas://app?tag=20220318104615\n", s)
+	s, err = runCmd(migratePushCmd(), "app:v1", "--dir", "file://"+p, "--format", "{{ .Repo }} {{ .Tag }} {{ .Files }}")
+	require.NoError(t, err)
+	require.Equal(t, "app v1 3", s)
+
+	// Pushed directories are resolved by their URL.
+	u := openSQLite(t, "")
+	s, err = runCmd(migrateApplyCmd(), "--dir", "atlas://app?tag=v1", "--url", u)
+	require.NoError(t, err)
+	require.Contains(t, s, "Migrating to version 20220318104615 (2 migrations in total)")
+	_, err = runCmd(migrateApplyCmd(), "--dir", "atlas://app?tag=v2", "--url", u)
+	require.EqualError(t, err, `registry: tag "v2" was not found in repository "app"`)
+
+	// Tags are immutable.
+	require.NoError(t, os.WriteFile(filepath.Join(p, "20220318104616_third.sql"), []byte("ALTER TABLE `tbl` ADD `col_3` int;"), 0600))
+	_, err = runCmd(migrateHashCmd(), "--dir", "file://"+p)
+	require.NoError(t, err)
+	_, err = runCmd(migratePushCmd(), "app:v1", "--dir", "file://"+p)
+	require.EqualError(t, err, `registry: tag "v1" of repository "app" already exists with different content`)
+
+	// The repository is configured by the project file.
+	cfg := filepath.Join(p, "atlas.hcl")
+	require.NoError(t, os.WriteFile(cfg, []byte(`
+env "local" {
+  url = "`+u+`"
+  migration {
+    dir = "file://`+p+`"
+    repo {
+      name = "app"
+    }
+  }
+}
+
+env "deploy" {
+  url = "`+u+`"
+  migration {
+    repo {
+      name = "app"
+    }
+  }
+}`), 0600))
+	cmd := migrateCmd()
+	cmd.AddCommand(migratePushCmd())
+	s, err = runCmd(cmd, "push", "-c", "file://"+cfg, "--env", "local")
+	require.NoError(t, err)
+	require.Equal(t, "atlas://app?tag=20220318104616\n", s)
+	cmd = migrateCmd()
+	cmd.AddCommand(migrateApplyCmd())
+	s, err = runCmd(cmd, "apply", "-c", "file://"+cfg, "--env", "deploy")
+	require.NoError(t, err)
+	require.Contains(t, s, "Migrating to version 20220318104616 from 20220318104615 (1 migrations in total)")
+}
+
+func TestMigrate_Rm(t *testing.T) {
+	p := t.TempDir()
+	for _, f := range []string{"20220318104614_initial.sql", "20220318104615_second.sql", migrate.HashFileName} {
+		require.NoError(t, copyFile(filepath.Join("testdata/sqlite", f), filepath.Join(p, f)))
+	}
+	u := openSQLite(t, "")
+	_, err := runCmd(migrateApplyCmd(), "1", "--dir", "file://"+p, "--url", u)
+	require.NoError(t, err)
+
+	_, err = runCmd(migrateRmCmd(), "1", "--dir", "file://"+p)
+	require.EqualError(t, err, `migration file with version "1" was not found`)
+
+	// Applied files cannot be removed.
+	_, err = runCmd(migrateRmCmd(), "20220318104614", "--dir", "file://"+p, "--url", u)
+	require.EqualError(t, err, `migration file "20220318104614_initial.sql" was applied to the database and cannot be removed`)
+	require.Equal(t, 3, countFiles(t, p))
+
+	// Latest file is removed by default.
+	_, err = runCmd(migrateRmCmd(), "--dir", "file://"+p, "--url", u)
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(p, "20220318104615_second.sql"))
+	require.Equal(t, 2, countFiles(t, p))
+	dir, err := migrate.NewLocalDir(p)
+	require.NoError(t, err)
+	require.NoError(t, migrate.Validate(dir))
+
+	// Databases without revisions table.
+	_, err = runCmd(migrateRmCmd(), "--dir", "file://"+p, "--url", openSQLite(t, ""))
+	require.NoError(t, err)
+	require.Equal(t, 1, countFiles(t, p))
+	require.NoError(t, migrate.Validate(dir))
+
+	_, err = runCmd(migrateRmCmd(), "--dir", "file://"+p)
+	require.EqualError(t, err, "no migration files to remove")
+}
+
+func TestMigrate_Edit(t *testing.T) {
+	p := t.TempDir()
+	for _, f := range []string{"20220318104614_initial.sql", "20220318104615_second.sql", migrate.HashFileName} {
+		require.NoError(t, copyFile(filepath.Join("testdata/sqlite", f), filepath.Join(p, f)))
+	}
+	_, err := runCmd(migrateEditCmd(), "1", "--dir", "file://"+p)
+	require.EqualError(t, err, `migration file "1" was not found`)
+
+	t.Setenv("EDITOR", "echo 'ALTER TABLE `tbl` ADD `col_3` int;' >>")
+	_, err = runCmd(migrateEditCmd(), "20220318104615", "--dir", "file://"+p, "--dev-url", openSQLite(t, ""))
+	require.NoError(t, err)
+	buf, err := os.ReadFile(filepath.Join(p, "20220318104615_second.sql"))
+	require.NoError(t, err)
+	require.Equal(t, "ALTER TABLE `tbl` ADD `col_2` bigint;\nALTER TABLE `tbl` ADD `col_3` int;\n", string(buf))
+	dir, err := migrate.NewLocalDir(p)
+	require.NoError(t, err)
+	require.NoError(t, migrate.Validate(dir))
+
+	// Broken statements are reported, but the sum file is still updated.
+	t.Setenv("EDITOR", "echo 'ALTER TABLE `unknown` ADD `c` int;' >>")
+	_, err = runCmd(migrateEditCmd(), "20220318104615_second.sql", "--dir", "file://"+p, "--dev-url", openSQLite(t, ""))
+	require.ErrorContains(t, err, "replaying the migration directory")
+	require.NoError(t, migrate.Validate(dir))
+}
+
+func TestMigrate_Test(t *testing.T) {
+	p := t.TempDir()
+	err := os.WriteFile(filepath.Join(p, "migrate.test.hcl"), []byte(`
+test "migrate" "columns" {
+  migrate {
+    to = "20220318104614"
+  }
+  exec {
+    sql = "INSERT INTO tbl (col) VALUES (1)"
+  }
+  migrate {
+    to = "20220318104615"
+  }
+  exec {
+    sql    = "SELECT col, col_2 FROM tbl"
+    output = "1, NULL"
+  }
+  log {
+    message = "done"
+  }
+}
+
+test "migrate" "all" {
+  assert {
+    sql = "SELECT COUNT(*) = 0 FROM tbl"
+  }
+  catch {
+    sql   = "INSERT INTO tbl (col) VALUES (NULL)"
+    error = "NOT NULL constraint failed"
+  }
+}
+
+test "migrate" "skipped" {
+  skip = true
+  exec {
+    sql = "SELECT unknown"
+  }
+}
+
+test "schema" "ignored" {}
+`), 0644)
+	require.NoError(t, err)
+	s, err := runCmd(migrateTestCmd(), "--dir", "file://testdata/sqlite", "--dev-url", openSQLite(t, ""), p)
+	require.NoError(t, err)
+	require.Equal(t, `TAP version 13
+1..3
+ok 1 - columns
+# done
+ok 2 - all
+ok 3 - skipped # SKIP
+`, s)
+
+	// Filter test cases by name.
+	s, err = runCmd(migrateTestCmd(), "--dir", "file://testdata/sqlite", "--dev-url", openSQLite(t, ""), "--run", "^all$", p)
+	require.NoError(t, err)
+	require.Equal(t, "TAP version 13\n1..1\nok 1 - all\n", s)
+
+	// Failing test cases.
+	err = os.WriteFile(filepath.Join(p, "fail.test.hcl"), []byte(`
+test "migrate" "fail" {
+  migrate {
+    to = "20220318104615"
+  }
+  assert {
+    sql           = "SELECT COUNT(*) > 0 FROM tbl"
+    error_message = "tbl is empty"
+  }
+}
+
+test "migrate" "unknown" {
+  migrate {
+    to = "1"
+  }
+}
+`), 0644)
+	require.NoError(t, err)
+	s, err = runCmd(migrateTestCmd(), "--dir", "file://testdata/sqlite", "--dev-url", openSQLite(t, ""), "--run", "fail|unknown", p)
+	require.EqualError(t, err, "2 of 2 tests failed")
+	require.Contains(t, s, "not ok 1 - fail\n  ---\n  message: \"tbl is empty\"\n")
+	require.Contains(t, s, `not ok 2 - unknown`)
+	require.Contains(t, s, `migrate: migration file with version \"1\" was not found`)
+
+	// JUnit format.
+	s, err = runCmd(migrateTestCmd(), "--dir", "file://testdata/sqlite", "--dev-url", openSQLite(t, ""), "--run", "^fail$", "--format", "{{ junit . }}", p)
+	require.Error(t, err)
+	require.Contains(t, s, `<testsuite name="migrate" tests="1" failures="1"`)
+	require.Contains(t, s, `<failure message="tbl is empty">`)
+
+	_, err = runCmd(migrateTestCmd(), "--dir", "file://testdata/sqlite", "--dev-url", openSQLite(t, ""), t.TempDir())
+	require.EqualError(t, err, "no test files were found")
+}
+
 func TestMigrate_StatusJSON(t *testing.T) {
 	p := t.TempDir()
 	s, err := runCmd(
@@ -1773,6 +2235,22 @@ func copyFile(src, dst string) error {
 
 type sqliteLockerDriver struct{ migrate.Driver }
 
+// realmInspectDriver is a migrate.Driver that is connected to a realm with
+// an application schema, and two schemas that hold a revisions table.
+type realmInspectDriver struct {
+	migrate.Driver
+	opts *schema.InspectRealmOption
+}
+
+func (d *realmInspectDriver) InspectRealm(_ context.Context, opts *schema.InspectRealmOption) (*schema.Realm, error) {
+	d.opts = opts
+	return schema.ExcludeRealm(schema.NewRealm(
+		schema.New("app").AddTables(schema.NewTable(revision.Table)),
+		schema.New(defaultRevisionSchema).AddTables(schema.NewTable(revision.Table)),
+		schema.New("revs").AddTables(schema.NewTable(revision.Table)),
+	), opts.Exclude)
+}
+
 var errLock = errors.New("lockErr")
 
 func (d *sqliteLockerDriver) Lock(context.Context, string, time.Duration) (schema.UnlockFunc, error) {
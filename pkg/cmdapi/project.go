@@ -181,10 +181,14 @@ type (
 			Down string `spec:"down"`
 			// Lint configures the formatting for 'migrate lint'.
 			Lint string `spec:"lint"`
+			// Push configures the formatting for 'migrate push'.
+			Push string `spec:"push"`
 			// Status configures the formatting for 'migrate status'.
 			Status string `spec:"status"`
 			// Apply configures the formatting for 'migrate diff'.
 			Diff string `spec:"diff"`
+			// Test configures the formatting for 'migrate test'.
+			Test string `spec:"test"`
 		} `spec:"migrate"`
 		Schema struct {
 			// Clean configures the formatting for 'schema clean'.
@@ -197,6 +201,8 @@ type (
 			Diff string `spec:"diff"`
 			// Push configures the formatting for 'schema push'.
 			Push string `spec:"push"`
+			// Test configures the formatting for 'schema test'.
+			Test string `spec:"test"`
 		} `spec:"schema"`
 		schemahcl.DefaultExtension
 	}
@@ -13,12 +13,15 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/veiloq/atlas/pkg/cmdlog"
+	"github.com/veiloq/atlas/pkg/registry"
 	"github.com/veiloq/atlas/sql/migrate"
+	"github.com/veiloq/atlas/sql/mysql"
 	"github.com/veiloq/atlas/sql/schema"
 	"github.com/veiloq/atlas/sql/sqlclient"
 )
@@ -707,6 +710,76 @@ diff {
 	)
 }
 
+func TestSchema_ApplyMultiEnv(t *testing.T) {
+	p := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(p, "schema.sql"), []byte("CREATE TABLE t1 (id int);\nCREATE UNIQUE INDEX t1_id ON t1 (id);"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(p, "atlas.hcl"), []byte(`
+variable "tenants" {
+  type = list(string)
+}
+
+env "tenants" {
+  for_each = toset(var.tenants)
+  url      = "sqlite://${each.value}"
+  dev      = "sqlite://dev?mode=memory"
+  src      = "file://`+filepath.Join(p, "schema.sql")+`"
+}
+`), 0600))
+	// The second tenant contains duplicate rows, and the unique index cannot be created.
+	dbs := []string{filepath.Join(p, "t1.db"), filepath.Join(p, "t2.db"), filepath.Join(p, "t3.db")}
+	db, err := sql.Open("sqlite3", dbs[1])
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE TABLE t1 (id int); INSERT INTO t1 VALUES (1), (1);")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	apply := func(args ...string) (string, error) {
+		cmd := schemaCmd()
+		cmd.AddCommand(schemaApplyCmd())
+		args = append([]string{"apply", "-c", "file://" + filepath.Join(p, "atlas.hcl"), "--env", "tenants"}, args...)
+		for _, db := range dbs {
+			args = append(args, "--var", "tenants="+db)
+		}
+		return runCmd(cmd, args...)
+	}
+
+	// Changes cannot be approved concurrently.
+	_, err = apply("--concurrency", "2")
+	require.EqualError(t, err, "--concurrency requires --dry-run or --auto-approve, as changes cannot be approved concurrently")
+	_, err = apply("--concurrency", "0", "--auto-approve")
+	require.EqualError(t, err, "--concurrency must be a positive number, got 0")
+
+	// Fail fast.
+	s, err := apply("--auto-approve")
+	require.EqualError(t, err, "schema apply failed on 1 of 3 targets")
+	require.Contains(t, s, "Applied 1 of 3 targets, 1 failed, 1 skipped:\n")
+	require.Regexp(t, `-- sqlite://`+regexp.QuoteMeta(dbs[0])+` ok \(.+\)\n`, s)
+	require.Regexp(t, `-- sqlite://`+regexp.QuoteMeta(dbs[1])+` failed \(.+\): .*UNIQUE constraint failed`, s)
+	require.Contains(t, s, "-- sqlite://"+dbs[2]+" skipped\n")
+	_, err = os.Stat(dbs[2])
+	require.True(t, os.IsNotExist(err), "skipped targets should not be connected")
+
+	// Continue on error.
+	s, err = apply("--auto-approve", "--continue-on-error", "--concurrency", "3")
+	require.EqualError(t, err, "schema apply failed on 1 of 3 targets")
+	require.Contains(t, s, "Applied 2 of 3 targets, 1 failed:\n")
+	require.Regexp(t, `-- sqlite://`+regexp.QuoteMeta(dbs[2])+` ok \(.+\)\n`, s)
+	s, err = runCmd(schemaInspectCmd(), "-u", "sqlite://"+dbs[2])
+	require.NoError(t, err)
+	require.Contains(t, s, `index "t1_id"`)
+
+	// All targets are synced, and the output is printed in the order of the targets.
+	require.NoError(t, os.Remove(dbs[1]))
+	s, err = apply("--auto-approve", "--concurrency", "3")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(s, "Schema is synced, no changes to be made\n-- Planned Changes:\n"), s)
+	require.Contains(t, s, "Applied 3 of 3 targets:\n")
+
+	// Custom formats are printed per target, without a summary.
+	s, err = apply("--dry-run", "--concurrency", "2", "--format", "{{ len .Changes.Pending }}")
+	require.NoError(t, err)
+	require.Equal(t, "0\n0\n0\n", s)
+}
+
 func TestSchema_ApplySources(t *testing.T) {
 	var (
 		p   = t.TempDir()
@@ -1023,6 +1096,239 @@ table "users" {
 	require.Equal(t, "-- Create \"users\" table\nCREATE TABLE `users` (`id` int NOT NULL);\n", s)
 }
 
+func TestSchema_Plan(t *testing.T) {
+	var (
+		p      = t.TempDir()
+		db     = openSQLite(t, "create table t1 (id int);")
+		to     = filepath.Join(p, "schema.sql")
+		planf  = filepath.Join(p, "plan.hcl")
+		planFn = func(args ...string) (string, error) {
+			return runCmd(schemaPlanCmd(), append([]string{"--from", db, "--to", "file://" + to, "--dev-url", openSQLite(t, "")}, args...)...)
+		}
+	)
+	require.NoError(t, os.WriteFile(to, []byte("create table t2 (id int);"), 0600))
+	s, err := planFn("--name", "drop_t1")
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(s, `plan "drop_t1" {`), s)
+	require.Contains(t, s, `migration = <<-SQL`)
+	require.Contains(t, s, "DROP TABLE `t1`;")
+	require.Contains(t, s, "CREATE TABLE `t2`")
+	require.Contains(t, s, `code = "DS102"`)
+	require.Contains(t, s, "lint {\n    text = \"destructive changes detected\"\n  }")
+
+	s, err = planFn("--name", "drop_t1", "--out", "file://"+planf)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("Plan \"drop_t1\" with 4 statements and 2 lint results was written to %s\n", planf), s)
+	plan, err := readSchemaPlan("file://" + planf)
+	require.NoError(t, err)
+	require.Equal(t, "drop_t1", plan.Name)
+	require.NotEmpty(t, plan.From)
+	require.NotEmpty(t, plan.To)
+	require.NotEqual(t, plan.From, plan.To)
+
+	// The desired state was changed after the plan was computed.
+	require.NoError(t, os.WriteFile(to, []byte("create table t3 (id int);"), 0600))
+	_, err = runCmd(schemaApplyCmd(), "-u", db, "--to", "file://"+to, "--dev-url", openSQLite(t, ""), "--plan", "file://"+planf, "--auto-approve")
+	require.EqualError(t, err, `the desired state does not match the state the plan "drop_t1" was computed for. Re-plan the migration`)
+
+	require.NoError(t, os.WriteFile(to, []byte("create table t2 (id int);"), 0600))
+	s, err = runCmd(schemaApplyCmd(), "-u", db, "--to", "file://"+to, "--dev-url", openSQLite(t, ""), "--plan", "file://"+planf, "--dry-run")
+	require.NoError(t, err)
+	require.Contains(t, s, "DROP TABLE `t1`")
+	s, err = runCmd(schemaApplyCmd(), "-u", db, "--to", "file://"+to, "--dev-url", openSQLite(t, ""), "--plan", "file://"+planf, "--auto-approve")
+	require.NoError(t, err)
+	s, err = runCmd(schemaInspectCmd(), "-u", db)
+	require.NoError(t, err)
+	require.Contains(t, s, `table "t2"`)
+	require.NotContains(t, s, `table "t1"`)
+
+	// The database was changed after the plan was computed.
+	_, err = runCmd(schemaApplyCmd(), "-u", db, "--to", "file://"+to, "--dev-url", openSQLite(t, ""), "--plan", "file://"+planf, "--auto-approve")
+	require.EqualError(t, err, `the current state of the database does not match the state the plan "drop_t1" was computed from. Re-plan the migration`)
+
+	_, err = planFn()
+	require.EqualError(t, err, "the database is already in the desired state, no plan was created")
+}
+
+func TestSchema_PlanDelimiter(t *testing.T) {
+	s := schema.New("s")
+	p, err := mysql.DefaultPlan.PlanChanges(context.Background(), "routines", []schema.Change{
+		&schema.AddProc{P: &schema.Proc{Name: "p1", Schema: s, Body: "BEGIN\n  INSERT INTO t VALUES (1);\n  INSERT INTO t VALUES (2);\nEND"}},
+		&schema.AddProc{P: &schema.Proc{Name: "p2", Schema: s, Body: "SELECT 1"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "//", p.Delimiter)
+	m, err := formatPlan(p)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(m, "-- atlas:delimiter //\n"), m)
+
+	// The plan file is split back into the planned statements.
+	b, err := (&schemaPlan{Name: "routines", From: "a", To: "b", Migration: m}).MarshalHCL()
+	require.NoError(t, err)
+	planf := filepath.Join(t.TempDir(), "plan.hcl")
+	require.NoError(t, os.WriteFile(planf, b, 0600))
+	plan, err := readSchemaPlan("file://" + planf)
+	require.NoError(t, err)
+	changes, err := planChanges(&mysql.Driver{}, plan)
+	require.NoError(t, err)
+	require.Len(t, changes, len(p.Changes))
+	for i := range changes {
+		require.Equal(t, p.Changes[i].Cmd, changes[i].Cmd)
+	}
+
+	_, err = formatPlan(&migrate.Plan{Changes: []*migrate.Change{{Cmd: "INSERT INTO t VALUES (?)", Args: []any{1}}}})
+	require.EqualError(t, err, `planned statement "INSERT INTO t VALUES (?)" has arguments and cannot be written to a plan`)
+}
+
+func TestSchema_Push(t *testing.T) {
+	t.Setenv(registry.EnvURL, "file://"+t.TempDir())
+	p := t.TempDir()
+	src := filepath.Join(p, "schema.sql")
+	require.NoError(t, os.WriteFile(src, []byte("create table t1 (id int);"), 0600))
+	s, err := runCmd(schemaPushCmd(), "app:v1", "--url", "file://"+src, "--dev-url", openSQLite(t, ""))
+	require.NoError(t, err)
+	require.Equal(t, "atlas://app?tag=v1\n", s)
+	// Tags default to the schema content hash.
+	s, err = runCmd(schemaPushCmd(), "app", "--url", "file://"+src, "--dev-url", openSQLite(t, ""))
+	require.NoError(t, err)
+	require.Regexp(t, `^atlas://app\?tag=[0-9a-f]{12}\n$`, s)
+
+	// Pushed schemas are resolved by their URL.
+	db := openSQLite(t, "")
+	_, err = runCmd(schemaApplyCmd(), "-u", db, "--to", "atlas://app?tag=v1", "--dev-url", openSQLite(t, ""), "--auto-approve")
+	require.NoError(t, err)
+	s, err = runCmd(schemaInspectCmd(), "-u", db)
+	require.NoError(t, err)
+	require.Contains(t, s, `table "t1"`)
+
+	// Migration directories cannot be used as schemas.
+	_, err = runCmd(migratePushCmd(), "dir", "--dir", "file://testdata/sqlite")
+	require.NoError(t, err)
+	_, err = runCmd(schemaPushCmd(), "dir:v1", "--url", "file://"+src, "--dev-url", openSQLite(t, ""))
+	require.EqualError(t, err, `registry: cannot push schema to repository "dir" of type migration_directory`)
+
+	// The repository is the desired state of the environment.
+	require.NoError(t, os.WriteFile(src, []byte("create table t1 (id int);\ncreate table t2 (id int);"), 0600))
+	cfg := filepath.Join(p, "atlas.hcl")
+	require.NoError(t, os.WriteFile(cfg, []byte(`
+env "local" {
+  url = "`+db+`"
+  dev = "sqlite://dev?mode=memory"
+  schema {
+    src = "file://`+src+`"
+    repo {
+      name = "app"
+    }
+  }
+}
+
+env "deploy" {
+  url = "`+db+`"
+  dev = "sqlite://dev?mode=memory"
+  schema {
+    repo {
+      name = "app"
+    }
+  }
+}`), 0600))
+	cmd := schemaCmd()
+	cmd.AddCommand(schemaPushCmd())
+	_, err = runCmd(cmd, "push", "-c", "file://"+cfg, "--env", "local")
+	require.NoError(t, err)
+	cmd = schemaCmd()
+	cmd.AddCommand(schemaApplyCmd())
+	_, err = runCmd(cmd, "apply", "-c", "file://"+cfg, "--env", "deploy", "--auto-approve")
+	require.NoError(t, err)
+	s, err = runCmd(schemaInspectCmd(), "-u", db)
+	require.NoError(t, err)
+	require.Contains(t, s, `table "t2"`)
+}
+
+func TestSchema_Test(t *testing.T) {
+	p := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(p, "schema.sql"), []byte(`
+CREATE TABLE products (
+  id integer PRIMARY KEY,
+  price int NOT NULL CHECK (price > 0),
+  total int GENERATED ALWAYS AS (price * 2) VIRTUAL
+);`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(p, "schema.test.hcl"), []byte(`
+test "schema" "price" {
+  for_each = [
+    {price: 1, total: 2},
+    {price: 10, total: 20},
+  ]
+  exec {
+    sql = "INSERT INTO products (price) VALUES (${each.value.price})"
+  }
+  exec {
+    sql    = "SELECT price, total FROM products"
+    output = "${each.value.price}, ${each.value.total}"
+  }
+  assert {
+    sql = "SELECT COUNT(*) = 1 FROM products"
+  }
+}
+
+test "schema" "check" {
+  catch {
+    sql   = "INSERT INTO products (price) VALUES (0)"
+    error = "CHECK constraint failed"
+  }
+  output {
+    sql   = "SELECT COUNT(*) FROM products"
+    match = "^0$"
+  }
+}
+
+test "migrate" "ignored" {}
+`), 0600))
+	s, err := runCmd(schemaTestCmd(), "--url", "file://"+filepath.Join(p, "schema.sql"), "--dev-url", openSQLite(t, ""), p)
+	require.NoError(t, err)
+	require.Equal(t, `TAP version 13
+1..3
+ok 1 - check
+# 0
+ok 2 - price
+ok 3 - price
+`, s)
+
+	// Failing test cases.
+	require.NoError(t, os.WriteFile(filepath.Join(p, "fail.test.hcl"), []byte(`
+test "schema" "fail" {
+  exec {
+    sql = "INSERT INTO products (price) VALUES (-1)"
+  }
+}
+`), 0600))
+	s, err = runCmd(schemaTestCmd(), "--url", "file://"+filepath.Join(p, "schema.sql"), "--dev-url", openSQLite(t, ""), "--run", "fail", p)
+	require.EqualError(t, err, "1 of 1 tests failed")
+	require.Contains(t, s, "not ok 1 - fail\n")
+	require.Contains(t, s, "CHECK constraint failed")
+
+	// Tests are configured by the project file.
+	cfg := filepath.Join(p, "atlas.hcl")
+	require.NoError(t, os.WriteFile(cfg, []byte(`
+env "local" {
+  src = "file://`+filepath.Join(p, "schema.sql")+`"
+  test {
+    schema {
+      src = ["`+filepath.Join(p, "schema.test.hcl")+`"]
+    }
+  }
+  format {
+    schema {
+      test = "{{ len .Cases }}"
+    }
+  }
+}`), 0600))
+	cmd := schemaCmd()
+	cmd.AddCommand(schemaTestCmd())
+	s, err = runCmd(cmd, "test", "-c", "file://"+cfg, "--env", "local", "--dev-url", openSQLite(t, ""))
+	require.NoError(t, err)
+	require.Equal(t, "3", s)
+}
+
 func TestFmt(t *testing.T) {
 	for _, tt := range []struct {
 		name          string
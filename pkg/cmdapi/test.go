@@ -0,0 +1,298 @@
+// Copyright 2021-present The Atlas Authors. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package cmdapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/veiloq/atlas/pkg/cmdext"
+	"github.com/veiloq/atlas/pkg/cmdlog"
+	"github.com/veiloq/atlas/schemahcl"
+	"github.com/veiloq/atlas/sql/sqlclient"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+type (
+	// testCase is a test case defined in a test file.
+	testCase struct {
+		name, file string
+		skip       bool
+		// Steps are kept as raw resources to preserve their order.
+		steps []*schemahcl.Resource
+	}
+
+	// testHandler handles the suite-specific behavior of a test case.
+	testHandler interface {
+		// setup is called on the clean dev-database before the test steps are executed.
+		setup(context.Context, *testCase) error
+		// step executes a suite-specific step, and reports false if the step is not supported.
+		step(context.Context, *schemahcl.Resource) (bool, error)
+		// teardown is called after all test steps were executed successfully.
+		teardown(context.Context) error
+	}
+
+	// Test steps that are shared by all test suites.
+	execStep struct {
+		SQL    string `spec:"sql"`
+		Output string `spec:"output"`
+	}
+	catchStep struct {
+		SQL   string `spec:"sql"`
+		Error string `spec:"error"`
+	}
+	assertStep struct {
+		SQL          string `spec:"sql"`
+		ErrorMessage string `spec:"error_message"`
+	}
+	outputStep struct {
+		SQL   string `spec:"sql"`
+		Match string `spec:"match"`
+	}
+	logStep struct {
+		Message string `spec:"message"`
+	}
+)
+
+// testFilePaths returns the test files found in the given paths.
+// Directories are scanned for files with the .test.hcl extension.
+func testFilePaths(paths []string) ([]string, error) {
+	var files []string
+	for _, p := range paths {
+		p = strings.TrimPrefix(p, "file://")
+		fi, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(p, "*"+cmdext.FileTypeTest))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	if len(files) == 0 {
+		return nil, errors.New("no test files were found")
+	}
+	return files, nil
+}
+
+// parseTestFiles parses the test cases of the given suite from the test files.
+func parseTestFiles(ctx context.Context, paths []string, suite string, vars map[string]cty.Value) ([]*testCase, error) {
+	var cases []*testCase
+	for _, p := range paths {
+		var doc struct{ schemahcl.DefaultExtension }
+		if err := schemahcl.New(schemahcl.WithContext(ctx)).EvalFiles([]string{p}, &doc, vars); err != nil {
+			return nil, err
+		}
+		for _, r := range doc.Remain().Resources("test") {
+			switch r.Qualifier {
+			case suite:
+			case "":
+				return nil, fmt.Errorf("%s: test block %q must have a type label, e.g. test %q %q", p, r.Name, suite, r.Name)
+			default:
+				continue
+			}
+			tc := &testCase{name: r.Name, file: p}
+			for _, a := range r.Attrs {
+				switch a.K {
+				case "skip":
+					v, err := a.Bool()
+					if err != nil {
+						return nil, fmt.Errorf("%s: test %q: %w", p, r.Name, err)
+					}
+					tc.skip = v
+				default:
+					return nil, fmt.Errorf("%s: test %q: unknown attribute %q", p, r.Name, a.K)
+				}
+			}
+			tc.steps = r.Children
+			cases = append(cases, tc)
+		}
+	}
+	return cases, nil
+}
+
+// runTests executes the given test cases on the dev-database. Test cases that do not match
+// the run expression are ignored, and a new handler is created for each executed case.
+func runTests(ctx context.Context, dev *sqlclient.Client, suite string, cases []*testCase, run *regexp.Regexp, newHandler func() testHandler) *cmdlog.TestReport {
+	r := &cmdlog.TestReport{Suite: suite, Start: time.Now()}
+	for _, tc := range cases {
+		if run != nil && !run.MatchString(tc.name) {
+			continue
+		}
+		c := &cmdlog.TestCase{Name: tc.name, File: tc.file, Start: time.Now(), Skipped: tc.skip}
+		if !tc.skip {
+			if err := runTest(ctx, dev, tc, newHandler(), c); err != nil {
+				c.Error = err.Error()
+			}
+		}
+		c.End = time.Now()
+		r.Cases = append(r.Cases, c)
+	}
+	r.End = time.Now()
+	return r
+}
+
+// runTest executes a single test case on the dev-database, and restores its state afterward.
+func runTest(ctx context.Context, dev *sqlclient.Client, tc *testCase, h testHandler, c *cmdlog.TestCase) (err error) {
+	restore, err := dev.Driver.Snapshot(ctx)
+	if err != nil {
+		return fmt.Errorf("taking dev-database snapshot: %w", err)
+	}
+	defer func() {
+		if rerr := restore(ctx); rerr != nil {
+			err = errors.Join(err, fmt.Errorf("restoring dev-database: %w", rerr))
+		}
+	}()
+	if err := h.setup(ctx, tc); err != nil {
+		return err
+	}
+	for _, s := range tc.steps {
+		if err := runStep(ctx, dev, s, h, c); err != nil {
+			return err
+		}
+	}
+	return h.teardown(ctx)
+}
+
+// runStep executes a single test step.
+func runStep(ctx context.Context, dev *sqlclient.Client, s *schemahcl.Resource, h testHandler, c *cmdlog.TestCase) error {
+	switch s.Type {
+	case "exec":
+		var st execStep
+		if err := s.As(&st); err != nil {
+			return err
+		}
+		if _, ok := s.Attr("output"); !ok {
+			if _, err := dev.ExecContext(ctx, st.SQL); err != nil {
+				return fmt.Errorf("exec %q: %w", st.SQL, err)
+			}
+			return nil
+		}
+		out, err := queryOutput(ctx, dev, st.SQL)
+		if err != nil {
+			return fmt.Errorf("exec %q: %w", st.SQL, err)
+		}
+		if strings.TrimSpace(out) != strings.TrimSpace(st.Output) {
+			return fmt.Errorf("exec %q: unexpected output:\ngot:\n%s\nwant:\n%s", st.SQL, out, st.Output)
+		}
+	case "catch":
+		var st catchStep
+		if err := s.As(&st); err != nil {
+			return err
+		}
+		_, err := dev.ExecContext(ctx, st.SQL)
+		if err == nil {
+			return fmt.Errorf("catch %q: expected statement to fail", st.SQL)
+		}
+		if st.Error != "" {
+			rx, rerr := regexp.Compile(st.Error)
+			if rerr != nil {
+				return fmt.Errorf("catch %q: compile error pattern: %w", st.SQL, rerr)
+			}
+			if !rx.MatchString(err.Error()) {
+				return fmt.Errorf("catch %q: error %q does not match %q", st.SQL, err, st.Error)
+			}
+		}
+	case "assert":
+		var st assertStep
+		if err := s.As(&st); err != nil {
+			return err
+		}
+		out, err := queryOutput(ctx, dev, st.SQL)
+		if err != nil {
+			return fmt.Errorf("assert %q: %w", st.SQL, err)
+		}
+		if ok, _ := strconv.ParseBool(strings.TrimSpace(out)); !ok {
+			if st.ErrorMessage != "" {
+				return errors.New(st.ErrorMessage)
+			}
+			return fmt.Errorf("assert %q: assertion failed, got: %q", st.SQL, out)
+		}
+	case "output":
+		var st outputStep
+		if err := s.As(&st); err != nil {
+			return err
+		}
+		out, err := queryOutput(ctx, dev, st.SQL)
+		if err != nil {
+			return fmt.Errorf("output %q: %w", st.SQL, err)
+		}
+		c.Output = append(c.Output, out)
+		if st.Match != "" {
+			rx, err := regexp.Compile(st.Match)
+			if err != nil {
+				return fmt.Errorf("output %q: compile match pattern: %w", st.SQL, err)
+			}
+			if !rx.MatchString(out) {
+				return fmt.Errorf("output %q: %q does not match %q", st.SQL, out, st.Match)
+			}
+		}
+	case "log":
+		var st logStep
+		if err := s.As(&st); err != nil {
+			return err
+		}
+		c.Output = append(c.Output, st.Message)
+	default:
+		ok, err := h.step(ctx, s)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("unknown test step %q", s.Type)
+		}
+	}
+	return nil
+}
+
+// queryOutput executes the given query and returns its output. Columns
+// are separated by commas, and rows are separated by new lines.
+func queryOutput(ctx context.Context, dev *sqlclient.Client, query string) (string, error) {
+	rows, err := dev.QueryContext(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+	var lines []string
+	for rows.Next() {
+		vs, ptrs := make([]any, len(columns)), make([]any, len(columns))
+		for i := range vs {
+			ptrs[i] = &vs[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return "", err
+		}
+		row := make([]string, len(vs))
+		for i, v := range vs {
+			switch v := v.(type) {
+			case nil:
+				row[i] = "NULL"
+			case []byte:
+				row[i] = string(v)
+			default:
+				row[i] = fmt.Sprint(v)
+			}
+		}
+		lines = append(lines, strings.Join(row, ", "))
+	}
+	return strings.Join(lines, "\n"), rows.Err()
+}
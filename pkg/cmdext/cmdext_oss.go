@@ -15,6 +15,7 @@ import (
 	"runtime"
 
 	cmdmigrate "github.com/veiloq/atlas/pkg/migrate"
+	cmdregistry "github.com/veiloq/atlas/pkg/registry"
 	"github.com/veiloq/atlas/schemahcl"
 	"github.com/veiloq/atlas/sql/migrate"
 
@@ -35,9 +36,49 @@ func RemoteDir(context.Context, *hcl.EvalContext, *hclsyntax.Block) (cty.Value,
 	return cty.Zero, unsupportedError("data.remote_dir")
 }
 
-// StateReaderAtlas returns a migrate.StateReader from an Atlas Cloud schema.
-func StateReaderAtlas(context.Context, *StateReaderConfig) (*StateReadCloser, error) {
-	return nil, unsupportedError("atlas remote state")
+// StateReaderAtlas returns a migrate.StateReader from a schema or a migration
+// directory stored in the registry, e.g. atlas://app?tag=v1.
+func StateReaderAtlas(ctx context.Context, config *StateReaderConfig) (*StateReadCloser, error) {
+	if len(config.URLs) != 1 {
+		return nil, fmt.Errorf("the provided atlas state must be a single repository, but %d URLs were found", len(config.URLs))
+	}
+	u := config.URLs[0]
+	r, err := cmdregistry.Default(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.Pull(ctx, u.Host, u.Query().Get("tag"))
+	if err != nil {
+		return nil, err
+	}
+	switch s.Type {
+	case cmdregistry.RepoTypeDir:
+		dir, err := s.Dir()
+		if err != nil {
+			return nil, err
+		}
+		var opts []migrate.ReplayOption
+		if v := u.Query().Get("version"); v != "" {
+			opts = append(opts, migrate.ReplayToVersion(v))
+		}
+		return stateReaderSQL(ctx, config, dir, nil, opts)
+	case cmdregistry.RepoTypeSchema:
+		// The HCL files are parsed and evaluated by the
+		// reader, and are not needed after it was created.
+		path, err := os.MkdirTemp("", "atlas-schema-")
+		if err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(path)
+		for _, f := range s.Files {
+			if err := os.WriteFile(filepath.Join(path, f.Name()), f.Bytes(), 0644); err != nil {
+				return nil, err
+			}
+		}
+		return stateReaderHCL(ctx, config, []string{path})
+	default:
+		return nil, fmt.Errorf("unexpected repository type %q", s.Type)
+	}
 }
 
 // SchemaExternal is a data source that for reading external schemas.
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
@@ -710,6 +711,159 @@ func (f *AppliedFile) MarshalJSON() ([]byte, error) {
 	})
 }
 
+var (
+	// MigrateDownTemplate holds the default template of the 'migrate down' command.
+	MigrateDownTemplate = template.Must(template.
+		New("report").
+		Funcs(ApplyTemplateFuncs).
+		Parse(`{{- if not .Revisions -}}
+{{- println "No migration files to revert" }}
+{{- else -}}
+{{- println .Header }}
+{{- println }}
+{{- range .Revisions }}
+	{{- printf "  %s %s" (yellow "--") (cyan .Version) }}{{ with .Description }} ({{ . }}){{ end }}
+	{{- println }}
+{{- end }}
+{{- if .Planned }}
+	{{- println }}
+	{{- if .Applied }}
+		{{- println " " (yellow "--") "reverting to version" (cyan (or .Target "0")) }}
+		{{- range .Applied }}
+			{{- println "   " (cyan "->") (indent_ln .Cmd 7) }}
+		{{- end }}
+	{{- else if not .Error }}
+		{{- println " " (yellow "--") "planned statements" }}
+		{{- range .Planned }}
+			{{- println "   " (cyan "->") (indent_ln .Cmd 7) }}
+		{{- end }}
+	{{- end }}
+{{- end }}
+{{- with .Error }}
+	{{- with .Stmt }}
+		{{- println "   " (red "->") (indent_ln . 7) }}
+	{{- end }}
+	{{- println "   " (redBgWhiteFg .Text) }}
+{{- end }}
+{{- println }}
+{{- println " " (cyan "-------------------------") }}
+{{- println " " (.Summary "  ") }}
+{{- end -}}
+`))
+)
+
+// MigrateDown contains a summary of a migration down attempt on a database.
+type MigrateDown struct {
+	ctx context.Context
+	Env
+	Revisions []*migrate.Revision `json:"Revisions,omitempty"` // Revisions to revert
+	Planned   []*migrate.Change   `json:"Planned,omitempty"`   // Planned statements
+	Applied   []*migrate.Change   `json:"Applied,omitempty"`   // Applied statements
+	Current   string              `json:"Current,omitempty"`   // Current migration version
+	Target    string              `json:"Target,omitempty"`    // Target migration version
+	DryRun    bool                `json:"DryRun,omitempty"`    // Whether the reverting was a dry-run
+	Start     time.Time
+	End       time.Time
+	// Error is set in case the reverting failed.
+	Error *StmtError `json:"Error,omitempty"`
+}
+
+// NewMigrateDown returns a MigrateDown.
+func NewMigrateDown(ctx context.Context, client *sqlclient.Client, dirURL *url.URL) *MigrateDown {
+	return &MigrateDown{
+		ctx:   ctx,
+		Env:   NewEnv(client, dirURL),
+		Start: time.Now(),
+	}
+}
+
+// Header returns a header of the migration log.
+func (d *MigrateDown) Header() string {
+	if len(d.Revisions) == 0 {
+		return "No migration files to revert"
+	}
+	var b strings.Builder
+	if d.DryRun {
+		b.WriteString("Planning to revert")
+	} else {
+		b.WriteString("Reverting")
+	}
+	b.WriteString(" from version ")
+	b.WriteString(ColorCyan(d.Current))
+	b.WriteString(" to ")
+	if d.Target != "" {
+		b.WriteString(ColorCyan(d.Target))
+	} else {
+		b.WriteString(ColorCyan("an empty state"))
+	}
+	b.WriteString(" (")
+	b.WriteString(strconv.Itoa(len(d.Revisions)))
+	b.WriteString(" migration")
+	b.WriteString(plural(len(d.Revisions)))
+	b.WriteString(" in total):")
+	return b.String()
+}
+
+// Summary returns a footer of the migration log.
+func (d *MigrateDown) Summary(ident string) string {
+	lines := []string{d.End.Sub(d.Start).String()}
+	switch n := len(d.Revisions); {
+	case d.DryRun:
+		lines = append(lines, fmt.Sprintf("%d migration%s to revert", n, plural(n)))
+	case d.Error != nil:
+		lines = append(lines, fmt.Sprintf("%d migration%s with errors", n, plural(n)))
+	default:
+		lines = append(lines, fmt.Sprintf("%d migration%s reverted", n, plural(n)))
+	}
+	switch p, a := len(d.Planned), len(d.Applied); {
+	case d.DryRun:
+		lines = append(lines, fmt.Sprintf("%d sql statement%s planned", p, plural(p)))
+	case d.Error != nil && d.Error.Stmt != "":
+		lines = append(lines, fmt.Sprintf("%d sql statement%s ok, 1 with errors", a, plural(a)))
+	case a > 0:
+		lines = append(lines, fmt.Sprintf("%d sql statement%s", a, plural(a)))
+	}
+	var b strings.Builder
+	for i, l := range lines {
+		b.WriteString(ColorYellow("--"))
+		b.WriteByte(' ')
+		b.WriteString(l)
+		if i < len(lines)-1 {
+			b.WriteByte('\n')
+			b.WriteString(ident)
+		}
+	}
+	return b.String()
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d *MigrateDown) MarshalJSON() ([]byte, error) {
+	type Alias MigrateDown
+	var v struct {
+		*Alias
+		Planned []string `json:"Planned,omitempty"`
+		Applied []string `json:"Applied,omitempty"`
+		Message string   `json:"Message,omitempty"`
+	}
+	v.Alias = (*Alias)(d)
+	for _, c := range d.Planned {
+		v.Planned = append(v.Planned, c.Cmd)
+	}
+	for _, c := range d.Applied {
+		v.Applied = append(v.Applied, c.Cmd)
+	}
+	switch {
+	case d.Error != nil:
+	case len(d.Revisions) == 0:
+		v.Message = "No migration files to revert"
+	case d.DryRun:
+		v.Message = fmt.Sprintf("Planned reverting from version %s to %q (%d migrations in total)", d.Current, d.Target, len(d.Revisions))
+	default:
+		v.Message = fmt.Sprintf("Reverted from version %s to %q (%d migrations in total)", d.Current, d.Target, len(d.Revisions))
+	}
+	return json.Marshal(v)
+}
+
 // SchemaPlanTemplate holds the default template of the 'schema apply --dry-run' command.
 var SchemaPlanTemplate = template.Must(template.
 	New("plan").
@@ -751,6 +905,208 @@ func (c Changes) MarshalJSON() ([]byte, error) {
 	return json.Marshal(v)
 }
 
+// SchemaApplySetTemplate holds the default template of the summary
+// printed by 'schema apply' when executed on multiple environments.
+var SchemaApplySetTemplate = template.Must(template.
+	New("apply_set").
+	Funcs(ApplyTemplateFuncs).
+	Parse(`
+{{- println }}
+{{- printf "Applied %d of %d targets" .Succeeded (len .Targets) }}
+{{- with .Failed }}{{ printf ", %d failed" . }}{{ end }}
+{{- with .Skipped }}{{ printf ", %d skipped" . }}{{ end }}
+{{- println ":" }}
+{{- range .Targets }}
+	{{- if .Skipped }}
+		{{- printf "  %s %s skipped\n" (yellow "--") .URL }}
+	{{- else if .Error }}
+		{{- printf "  %s %s failed (%s): %s\n" (yellow "--") .URL (yellow (.End.Sub .Start).String) (red .Error) }}
+	{{- else }}
+		{{- printf "  %s %s ok (%s)\n" (yellow "--") .URL (yellow (.End.Sub .Start).String) }}
+	{{- end }}
+{{- end }}
+`))
+
+type (
+	// SchemaApplySet contains a summary of a 'schema apply' execution on multiple environments.
+	SchemaApplySet struct {
+		Start   time.Time            // Start time of the execution.
+		End     time.Time            // End time of the execution.
+		Targets []*SchemaApplyTarget // Targets the schema was applied on, in the order they were defined.
+	}
+
+	// SchemaApplyTarget contains the result of 'schema apply' on a single environment.
+	SchemaApplyTarget struct {
+		URL     string    // Redacted URL of the target database.
+		Start   time.Time // Start time of the execution.
+		End     time.Time // End time of the execution.
+		Skipped bool      // Whether the target was skipped due to an earlier failure.
+		Error   string    `json:",omitempty"` // Failure reason, if applying failed.
+	}
+)
+
+// Succeeded returns the number of targets the schema was applied on successfully.
+func (s *SchemaApplySet) Succeeded() (n int) {
+	for _, t := range s.Targets {
+		if !t.Skipped && t.Error == "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns the number of targets that failed to apply.
+func (s *SchemaApplySet) Failed() (n int) {
+	for _, t := range s.Targets {
+		if t.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// Skipped returns the number of targets that were skipped.
+func (s *SchemaApplySet) Skipped() (n int) {
+	for _, t := range s.Targets {
+		if t.Skipped {
+			n++
+		}
+	}
+	return n
+}
+
+var (
+	// TestTemplateFuncs are global functions available in test report templates.
+	TestTemplateFuncs = template.FuncMap{
+		"add":       add,
+		"indent_ln": indentLn,
+		"json":      jsonEncode,
+		"junit":     junitEncode,
+	}
+
+	// TestTemplate holds the default template of the 'migrate test' and 'schema test'
+	// commands. The results are reported in TAP (Test Anything Protocol) format.
+	TestTemplate = template.Must(template.New("test").
+			Funcs(TestTemplateFuncs).
+			Parse(`TAP version 13
+1..{{ len .Cases }}
+{{- range $i, $c := .Cases }}
+{{ if $c.Error }}not {{ end }}ok {{ add $i 1 }} - {{ $c.Name }}{{ if $c.Skipped }} # SKIP{{ end }}
+	{{- range $c.Output }}
+# {{ indent_ln . 2 }}
+	{{- end }}
+	{{- with $c.Error }}
+  ---
+  message: {{ json . }}
+  file: {{ json $c.File }}
+  ...
+	{{- end }}
+{{- end }}
+`))
+)
+
+type (
+	// TestReport contains the results of a 'migrate test' or 'schema test' execution.
+	TestReport struct {
+		Suite string      // Test suite, "migrate" or "schema".
+		Start time.Time   // Start time of the execution.
+		End   time.Time   // End time of the execution.
+		Cases []*TestCase // Executed test cases.
+	}
+
+	// TestCase contains the result of a single test case.
+	TestCase struct {
+		Name    string    // Name of the test case.
+		File    string    // File the test case is defined in.
+		Start   time.Time // Start time of the test case.
+		End     time.Time // End time of the test case.
+		Skipped bool      // Whether the test case was skipped.
+		Output  []string  `json:",omitempty"` // Output collected from the test steps.
+		Error   string    `json:",omitempty"` // Failure reason, if the test case failed.
+	}
+)
+
+// Failed returns the number of failed test cases.
+func (r *TestReport) Failed() (n int) {
+	for _, c := range r.Cases {
+		if c.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// junitEncode encodes the given report in JUnit XML format.
+func junitEncode(r *TestReport) (string, error) {
+	type (
+		failure struct {
+			Message string `xml:"message,attr"`
+			Text    string `xml:",chardata"`
+		}
+		testcase struct {
+			Name      string    `xml:"name,attr"`
+			Classname string    `xml:"classname,attr"`
+			Time      string    `xml:"time,attr"`
+			Skipped   *struct{} `xml:"skipped"`
+			Failure   *failure  `xml:"failure"`
+			SystemOut string    `xml:"system-out,omitempty"`
+		}
+		testsuite struct {
+			XMLName  xml.Name   `xml:"testsuite"`
+			Name     string     `xml:"name,attr"`
+			Tests    int        `xml:"tests,attr"`
+			Failures int        `xml:"failures,attr"`
+			Skipped  int        `xml:"skipped,attr"`
+			Time     string     `xml:"time,attr"`
+			Cases    []testcase `xml:"testcase"`
+		}
+	)
+	seconds := func(d time.Duration) string {
+		return strconv.FormatFloat(d.Seconds(), 'f', 3, 64)
+	}
+	s := testsuite{
+		Name:     r.Suite,
+		Tests:    len(r.Cases),
+		Failures: r.Failed(),
+		Time:     seconds(r.End.Sub(r.Start)),
+	}
+	for _, c := range r.Cases {
+		tc := testcase{
+			Name:      c.Name,
+			Classname: c.File,
+			Time:      seconds(c.End.Sub(c.Start)),
+			SystemOut: strings.Join(c.Output, "\n"),
+		}
+		if c.Skipped {
+			s.Skipped++
+			tc.Skipped = &struct{}{}
+		}
+		if c.Error != "" {
+			tc.Failure = &failure{Message: c.Error, Text: c.Error}
+		}
+		s.Cases = append(s.Cases, tc)
+	}
+	b, err := xml.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(b), nil
+}
+
+// RegistryPushTemplate holds the default template of the 'migrate push' and 'schema push' commands.
+var RegistryPushTemplate = template.Must(template.New("push").Parse(`{{ .URL }}
+`))
+
+// RegistryPush contains a summary of a 'migrate push' or 'schema push' execution.
+type RegistryPush struct {
+	URL     string    // URL of the pushed snapshot, e.g. atlas://app?tag=v1.
+	Repo    string    // Name of the repository.
+	Type    string    // Type of the repository.
+	Tag     string    // Tag of the pushed snapshot.
+	Created time.Time // Time the snapshot was pushed.
+	Files   int       // Number of pushed files.
+}
+
 // SchemaInspect contains a summary of the 'schema inspect' command.
 type SchemaInspect struct {
 	ctx    context.Context
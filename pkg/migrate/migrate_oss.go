@@ -11,9 +11,23 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/veiloq/atlas/pkg/registry"
 	"github.com/veiloq/atlas/sql/migrate"
 )
 
-func openAtlasDir(context.Context, *url.URL) (migrate.Dir, error) {
-	return nil, fmt.Errorf("atlas remote directory is not supported by this release. See: https://atlasgo.io/getting-started")
+// openAtlasDir opens the migration directory stored in the registry. The tag is selected
+// using the "tag" query parameter, and defaults to the latest pushed directory.
+func openAtlasDir(ctx context.Context, u *url.URL) (migrate.Dir, error) {
+	r, err := registry.Default(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.Pull(ctx, u.Host, u.Query().Get("tag"))
+	if err != nil {
+		return nil, err
+	}
+	if s.Type != registry.RepoTypeDir {
+		return nil, fmt.Errorf("repository %q is not a migration directory (%s)", s.Repo, s.Type)
+	}
+	return s.Dir()
 }
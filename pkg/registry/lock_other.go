@@ -0,0 +1,16 @@
+// Copyright 2021-present The Atlas Authors. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd || windows)
+
+package registry
+
+import "os"
+
+// lockFile is a nop on platforms without file locking support.
+// Metadata writes are still atomic, but concurrent pushes are not serialized.
+func lockFile(*os.File) error { return nil }
+
+// unlockFile is a nop on platforms without file locking support.
+func unlockFile(*os.File) error { return nil }
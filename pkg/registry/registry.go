@@ -0,0 +1,381 @@
+// Copyright 2021-present The Atlas Authors. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package registry provides a storage for tagged, immutable snapshots of
+// migration directories and schemas, referenced by atlas://<repo>?tag=<tag> URLs.
+package registry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/veiloq/atlas/sql/migrate"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+type (
+	// Registry stores tagged snapshots of migration directories and schemas.
+	Registry interface {
+		// Push stores the given files under the given tag of the repository. Tags
+		// are immutable, and pushing different content to an existing tag fails.
+		Push(context.Context, *Snapshot) error
+		// Pull returns the snapshot stored under the given tag of the repository.
+		// An empty tag selects the latest snapshot pushed to the repository.
+		Pull(ctx context.Context, repo, tag string) (*Snapshot, error)
+	}
+
+	// Snapshot is a tagged version of a repository.
+	Snapshot struct {
+		Repo    string         // Name of the repository.
+		Type    string         // Type of the repository. See RepoTypeDir and RepoTypeSchema.
+		Tag     string         // Tag of the snapshot.
+		Created time.Time      // Time the snapshot was pushed.
+		Files   []migrate.File // Files of the snapshot.
+	}
+
+	// Opener opens a Registry from the given URL.
+	Opener func(context.Context, *url.URL) (Registry, error)
+
+	// NotExistError is returned when a repository or a tag does not exist.
+	NotExistError struct {
+		Repo, Tag string
+	}
+)
+
+// Error implements the error interface.
+func (e *NotExistError) Error() string {
+	if e.Tag != "" {
+		return fmt.Sprintf("registry: tag %q was not found in repository %q", e.Tag, e.Repo)
+	}
+	return fmt.Sprintf("registry: repository %q was not found", e.Repo)
+}
+
+// IsNotExistError reports if an error is a NotExistError.
+func IsNotExistError(err error) bool {
+	var e *NotExistError
+	return errors.As(err, &e)
+}
+
+// Repository types.
+const (
+	RepoTypeDir    = "migration_directory"
+	RepoTypeSchema = "schema"
+)
+
+const (
+	// EnvURL is the environment variable that configures the registry URL.
+	EnvURL = "ATLAS_REGISTRY"
+	// DefaultURL is the registry URL used in case EnvURL is not set.
+	DefaultURL = "file://~/.atlas/registry"
+)
+
+var (
+	openers = struct {
+		sync.Mutex
+		m map[string]Opener
+	}{m: make(map[string]Opener)}
+
+	// validName matches valid repository names and tags.
+	validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.\-]*$`)
+)
+
+// Register registers a registry opener for the given URL scheme.
+func Register(scheme string, o Opener) {
+	openers.Lock()
+	defer openers.Unlock()
+	if o == nil {
+		panic("registry: Register opener is nil")
+	}
+	if _, dup := openers.m[scheme]; dup {
+		panic("registry: Register called twice for " + scheme)
+	}
+	openers.m[scheme] = o
+}
+
+// Open opens the registry from the given URL.
+func Open(ctx context.Context, s string) (Registry, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("registry: parse url %q: %w", s, err)
+	}
+	openers.Lock()
+	o, ok := openers.m[u.Scheme]
+	openers.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("registry: unsupported scheme %q", u.Scheme)
+	}
+	return o(ctx, u)
+}
+
+// Default opens the registry configured by the ATLAS_REGISTRY
+// environment variable, or the local registry in the home directory.
+func Default(ctx context.Context) (Registry, error) {
+	u := os.Getenv(EnvURL)
+	if u == "" {
+		u = DefaultURL
+	}
+	return Open(ctx, u)
+}
+
+// Validate validates the repository name and the tag of the snapshot.
+func (s *Snapshot) Validate() error {
+	switch {
+	case !validName.MatchString(s.Repo):
+		return fmt.Errorf("registry: invalid repository name %q", s.Repo)
+	case !validName.MatchString(s.Tag):
+		return fmt.Errorf("registry: invalid tag %q", s.Tag)
+	case s.Type != RepoTypeDir && s.Type != RepoTypeSchema:
+		return fmt.Errorf("registry: unknown repository type %q", s.Type)
+	case len(s.Files) == 0:
+		return errors.New("registry: snapshot has no files")
+	}
+	return nil
+}
+
+// Dir returns the files of the snapshot as a read-only migration directory.
+func (s *Snapshot) Dir() (migrate.Dir, error) {
+	d := &migrate.MemDir{}
+	for _, f := range s.Files {
+		if err := d.WriteFile(f.Name(), f.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// URL returns the atlas:// URL of the snapshot.
+func (s *Snapshot) URL() string {
+	return (&url.URL{Scheme: "atlas", Host: s.Repo, RawQuery: url.Values{"tag": {s.Tag}}.Encode()}).String()
+}
+
+// LocalRegistry is a Registry that stores snapshots in a local directory
+// using the following layout: <path>/<repo>/{repo.json,tags/<tag>/<file>}.
+type LocalRegistry struct {
+	path string
+}
+
+// repoMeta is the metadata of a repository stored in the local registry.
+type repoMeta struct {
+	Type   string               `json:"type"`
+	Latest string               `json:"latest"`
+	Tags   map[string]time.Time `json:"tags"`
+}
+
+// NewLocalRegistry returns a LocalRegistry that is stored in the given path.
+func NewLocalRegistry(path string) (*LocalRegistry, error) {
+	p, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(p, 0755); err != nil {
+		return nil, fmt.Errorf("registry: create directory: %w", err)
+	}
+	return &LocalRegistry{path: p}, nil
+}
+
+// Path returns the path of the registry.
+func (r *LocalRegistry) Path() string {
+	return r.path
+}
+
+// Push implements Registry.Push.
+func (r *LocalRegistry) Push(ctx context.Context, s *Snapshot) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	// Concurrent pushes to the same repository
+	// are serialized by the repository lock.
+	unlock, err := r.lock(ctx, s.Repo)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	meta, err := r.meta(s.Repo)
+	switch {
+	case IsNotExistError(err):
+		meta = &repoMeta{Type: s.Type, Tags: make(map[string]time.Time)}
+	case err != nil:
+		return err
+	case meta.Type != s.Type:
+		return fmt.Errorf("registry: cannot push %s to repository %q of type %s", s.Type, s.Repo, meta.Type)
+	}
+	if _, ok := meta.Tags[s.Tag]; ok {
+		stored, err := r.files(s.Repo, s.Tag)
+		if err != nil {
+			return err
+		}
+		if !sameFiles(stored, s.Files) {
+			return fmt.Errorf("registry: tag %q of repository %q already exists with different content", s.Tag, s.Repo)
+		}
+		s.Created = meta.Tags[s.Tag]
+		return nil
+	}
+	// Write the files into a temporary directory first
+	// to avoid leaving partial snapshots in case of errors.
+	tags := filepath.Join(r.path, s.Repo, "tags")
+	if err := os.MkdirAll(tags, 0755); err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp(tags, ".push-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	for _, f := range s.Files {
+		if err := os.WriteFile(filepath.Join(tmp, f.Name()), f.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(tmp, filepath.Join(tags, s.Tag)); err != nil {
+		return err
+	}
+	s.Created = time.Now().UTC()
+	meta.Tags[s.Tag], meta.Latest = s.Created, s.Tag
+	return r.writeMeta(s.Repo, meta)
+}
+
+// Pull implements Registry.Pull.
+func (r *LocalRegistry) Pull(_ context.Context, repo, tag string) (*Snapshot, error) {
+	if !validName.MatchString(repo) {
+		return nil, fmt.Errorf("registry: invalid repository name %q", repo)
+	}
+	meta, err := r.meta(repo)
+	if err != nil {
+		return nil, err
+	}
+	if tag == "" {
+		tag = meta.Latest
+	}
+	created, ok := meta.Tags[tag]
+	if !ok {
+		return nil, &NotExistError{Repo: repo, Tag: tag}
+	}
+	files, err := r.files(repo, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{Repo: repo, Type: meta.Type, Tag: tag, Created: created, Files: files}, nil
+}
+
+// meta reads the metadata of the given repository.
+func (r *LocalRegistry) meta(repo string) (*repoMeta, error) {
+	b, err := os.ReadFile(filepath.Join(r.path, repo, "repo.json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, &NotExistError{Repo: repo}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m repoMeta
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("registry: read repository %q: %w", repo, err)
+	}
+	return &m, nil
+}
+
+// writeMeta writes the metadata of the given repository. The file is written to a
+// temporary file first and then renamed, to ensure readers never see a partial file.
+func (r *LocalRegistry) writeMeta(repo string, m *repoMeta) error {
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Join(r.path, repo), ".repo-*.json")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		return errors.Join(err, f.Close())
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(r.path, repo, "repo.json"))
+}
+
+// lockRetry is the interval between attempts to acquire a repository lock.
+const lockRetry = 50 * time.Millisecond
+
+// lock acquires an exclusive lock on the lock file of the given repository, and
+// returns a function for releasing it. Unlike lock files that are created and
+// removed, the OS releases the lock in case the process holding it exits.
+func (r *LocalRegistry) lock(ctx context.Context, repo string) (func() error, error) {
+	dir := filepath.Join(r.path, repo)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, ".lock"), os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("registry: lock repository %q: %w", repo, err)
+	}
+	for {
+		switch err := lockFile(f); {
+		case err == nil:
+			return func() error { return errors.Join(unlockFile(f), f.Close()) }, nil
+		case !errors.Is(err, errLocked):
+			return nil, errors.Join(fmt.Errorf("registry: lock repository %q: %w", repo, err), f.Close())
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.Join(fmt.Errorf("registry: lock repository %q: %w", repo, ctx.Err()), f.Close())
+		case <-time.After(lockRetry):
+		}
+	}
+}
+
+// errLocked is returned by lockFile in case the file is locked by another process.
+var errLocked = errors.New("registry: file is locked")
+
+// files reads the files stored under the given tag.
+func (r *LocalRegistry) files(repo, tag string) ([]migrate.File, error) {
+	path := filepath.Join(r.path, repo, "tags", tag)
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]migrate.File, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		b, err := os.ReadFile(filepath.Join(path, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, migrate.NewLocalFile(e.Name(), b))
+	}
+	return files, nil
+}
+
+// sameFiles reports if the two file lists have the same names and contents.
+func sameFiles(a, b []migrate.File) bool {
+	return slices.EqualFunc(sortFiles(a), sortFiles(b), func(f1, f2 migrate.File) bool {
+		return f1.Name() == f2.Name() && bytes.Equal(f1.Bytes(), f2.Bytes())
+	})
+}
+
+func sortFiles(files []migrate.File) []migrate.File {
+	return slices.SortedFunc(slices.Values(files), func(f1, f2 migrate.File) int {
+		return strings.Compare(f1.Name(), f2.Name())
+	})
+}
+
+func init() {
+	Register("file", func(_ context.Context, u *url.URL) (Registry, error) {
+		return NewLocalRegistry(filepath.Join(u.Host, u.Path))
+	})
+}
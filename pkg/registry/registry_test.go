@@ -0,0 +1,114 @@
+// Copyright 2021-present The Atlas Authors. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package registry_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/veiloq/atlas/pkg/registry"
+	"github.com/veiloq/atlas/sql/migrate"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalRegistry(t *testing.T) {
+	ctx := context.Background()
+	p := t.TempDir()
+	t.Setenv(registry.EnvURL, "file://"+p)
+	r, err := registry.Default(ctx)
+	require.NoError(t, err)
+
+	_, err = r.Pull(ctx, "app", "")
+	require.True(t, registry.IsNotExistError(err))
+	require.EqualError(t, err, `registry: repository "app" was not found`)
+
+	s := &registry.Snapshot{
+		Repo: "app",
+		Type: registry.RepoTypeDir,
+		Tag:  "1",
+		Files: []migrate.File{
+			migrate.NewLocalFile("1.sql", []byte("CREATE TABLE t1(c int);")),
+			migrate.NewLocalFile(migrate.HashFileName, []byte("sum")),
+		},
+	}
+	require.NoError(t, r.Push(ctx, s))
+	require.False(t, s.Created.IsZero())
+	require.Equal(t, "atlas://app?tag=1", s.URL())
+	require.FileExists(t, filepath.Join(p, "app", "tags", "1", "1.sql"))
+
+	// Pushing the same content is a nop.
+	created := s.Created
+	require.NoError(t, r.Push(ctx, s))
+	require.Equal(t, created, s.Created)
+
+	// Tags are immutable.
+	s.Files = append(s.Files, migrate.NewLocalFile("2.sql", []byte("CREATE TABLE t2(c int);")))
+	require.EqualError(t, r.Push(ctx, s), `registry: tag "1" of repository "app" already exists with different content`)
+	s.Tag = "2"
+	require.NoError(t, r.Push(ctx, s))
+
+	// Repository types cannot be mixed.
+	err = r.Push(ctx, &registry.Snapshot{Repo: "app", Type: registry.RepoTypeSchema, Tag: "3", Files: s.Files})
+	require.EqualError(t, err, `registry: cannot push schema to repository "app" of type migration_directory`)
+	err = r.Push(ctx, &registry.Snapshot{Repo: "../app", Type: registry.RepoTypeDir, Tag: "3", Files: s.Files})
+	require.EqualError(t, err, `registry: invalid repository name "../app"`)
+
+	latest, err := r.Pull(ctx, "app", "")
+	require.NoError(t, err)
+	require.Equal(t, "2", latest.Tag)
+	require.Len(t, latest.Files, 3)
+	first, err := r.Pull(ctx, "app", "1")
+	require.NoError(t, err)
+	require.Equal(t, "1", first.Tag)
+	require.Len(t, first.Files, 2)
+	_, err = r.Pull(ctx, "app", "3")
+	require.EqualError(t, err, `registry: tag "3" was not found in repository "app"`)
+
+	// Snapshots are read-only.
+	dir, err := first.Dir()
+	require.NoError(t, err)
+	require.NoError(t, dir.WriteFile("3.sql", []byte("CREATE TABLE t3(c int);")))
+	_, err = os.Stat(filepath.Join(p, "app", "tags", "1", "3.sql"))
+	require.True(t, os.IsNotExist(err))
+
+	_, err = registry.Open(ctx, "unknown://registry")
+	require.EqualError(t, err, `registry: unsupported scheme "unknown"`)
+}
+
+func TestLocalRegistry_ConcurrentPush(t *testing.T) {
+	ctx := context.Background()
+	r, err := registry.NewLocalRegistry(t.TempDir())
+	require.NoError(t, err)
+	var (
+		n  = 20
+		wg sync.WaitGroup
+	)
+	errs := make([]error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = r.Push(ctx, &registry.Snapshot{
+				Repo:  "app",
+				Type:  registry.RepoTypeDir,
+				Tag:   strconv.Itoa(i),
+				Files: []migrate.File{migrate.NewLocalFile("1.sql", []byte("CREATE TABLE t1(c int);"))},
+			})
+		}()
+	}
+	wg.Wait()
+	for i := range n {
+		require.NoError(t, errs[i])
+		// No tag was lost by a concurrent push.
+		s, err := r.Pull(ctx, "app", strconv.Itoa(i))
+		require.NoError(t, err)
+		require.Equal(t, strconv.Itoa(i), s.Tag)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2021-present The Atlas Authors. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// A MySQL and MariaDB lexer that recognizes the keywords needed for analyzing
+// migration files. Other words are emitted as identifiers.
+
+lexer grammar Lexer;
+
+options { caseInsensitive = true; }
+
+SCOL:      ';';
+DOT:       '.';
+OPEN_PAR:  '(';
+CLOSE_PAR: ')';
+COMMA:     ',';
+ASSIGN:    '=';
+
+OP:
+    '<=>' | '->>' | '->' | '<=' | '>=' | '<>' | '!=' | ':=' | '||' | '&&' | '<<' | '>>'
+    | [-+*/%<>!~^&|:?{}\\] | '[' | ']'
+;
+
+// https://dev.mysql.com/doc/refman/8.0/en/keywords.html
+ALGORITHM_:     'ALGORITHM';
+ALTER_:         'ALTER';
+AS_:            'AS';
+BY_:            'BY';
+CHANGE_:        'CHANGE';
+COLUMN_:        'COLUMN';
+CREATE_:        'CREATE';
+CROSS_:         'CROSS';
+DEFAULT_:       'DEFAULT';
+DEFINER_:       'DEFINER';
+EXCEPT_:        'EXCEPT';
+EXISTS_:        'EXISTS';
+FOR_:           'FOR';
+FORCE_:         'FORCE';
+FROM_:          'FROM';
+GROUP_:         'GROUP';
+HAVING_:        'HAVING';
+IF_:            'IF';
+IGNORE_:        'IGNORE';
+INDEX_:         'INDEX';
+INNER_:         'INNER';
+INTERSECT_:     'INTERSECT';
+INTO_:          'INTO';
+IS_:            'IS';
+JOIN_:          'JOIN';
+KEY_:           'KEY';
+LEFT_:          'LEFT';
+LIMIT_:         'LIMIT';
+LOCK_:          'LOCK';
+LOW_PRIORITY_:  'LOW_PRIORITY';
+NATURAL_:       'NATURAL';
+NOWAIT_:        'NOWAIT';
+NULL_:          'NULL';
+ON_:            'ON';
+ONLINE_:        'ONLINE';
+OR_:            'OR';
+ORDER_:         'ORDER';
+OUTER_:         'OUTER';
+PARTITION_:     'PARTITION';
+RENAME_:        'RENAME';
+REPLACE_:       'REPLACE';
+RIGHT_:         'RIGHT';
+SECURITY_:      'SECURITY';
+SELECT_:        'SELECT';
+SET_:           'SET';
+SQL_:           'SQL';
+STRAIGHT_JOIN_: 'STRAIGHT_JOIN';
+TABLE_:         'TABLE';
+TABLES_:        'TABLES';
+TO_:            'TO';
+UNION_:         'UNION';
+UPDATE_:        'UPDATE';
+USE_:           'USE';
+USING_:         'USING';
+VIEW_:          'VIEW';
+WAIT_:          'WAIT';
+WHERE_:         'WHERE';
+WINDOW_:        'WINDOW';
+WITH_:          'WITH';
+
+NUMERIC_LITERAL: ((DIGIT+ ('.' DIGIT*)?) | ('.' DIGIT+)) ('E' [-+]? DIGIT+)? | '0x' HEX_DIGIT+;
+
+IDENTIFIER: [A-Z_$0-9\u0080-\uFFFF]+;
+
+QUOTED_IDENTIFIER: '`' (~'`' | '``')* '`';
+
+STRING_LITERAL: '\'' (~['\\] | '\\' . | '\'\'')* '\'' | '"' (~["\\] | '\\' . | '""')* '"';
+
+VARIABLE: '@' '@'? ([A-Z_$0-9.\u0080-\uFFFF]+ | QUOTED_IDENTIFIER | STRING_LITERAL);
+
+SINGLE_LINE_COMMENT: ('--' ([ \t\f\u000B] ~[\r\n]*)? | '#' ~[\r\n]*) (('\r'? '\n') | EOF) -> channel(HIDDEN);
+
+MULTILINE_COMMENT: '/*' .*? '*/' -> channel(HIDDEN);
+
+SPACES: [ \u000B\t\f\r\n] -> channel(HIDDEN);
+
+fragment HEX_DIGIT: [0-9A-F];
+fragment DIGIT:     [0-9];
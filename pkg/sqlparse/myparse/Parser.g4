@@ -0,0 +1,244 @@
+// Copyright 2021-present The Atlas Authors. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// A MySQL and MariaDB parser that recognizes the statements needed for analyzing
+// migration files. Other statements, and the parts of the recognized statements
+// that are not needed, are parsed as sequences of tokens.
+
+parser grammar Parser;
+
+options {
+    tokenVocab = Lexer;
+}
+
+parse: sql_stmt? SCOL? EOF
+;
+
+sql_stmt:
+    rename_table_stmt
+    | alter_table_stmt
+    | update_stmt
+    | create_view_stmt
+    | any_stmt
+;
+
+rename_table_stmt:
+    RENAME_ (TABLE_ | TABLES_) rename_pair (COMMA rename_pair)*
+;
+
+rename_pair:
+    from=table_name TO_ to=table_name
+;
+
+// ONLINE, IGNORE, IF EXISTS, WAIT and NOWAIT are supported only by MariaDB.
+alter_table_stmt:
+    ALTER_ ONLINE_? IGNORE_? TABLE_ (IF_ EXISTS_)? table_name (WAIT_ NUMERIC_LITERAL | NOWAIT_)? (
+        alter_spec (COMMA alter_spec)*
+    )?
+;
+
+alter_spec:
+    rename_column
+    | rename_index
+    | rename_table
+    | change_column
+    | definition
+;
+
+rename_column:
+    RENAME_ COLUMN_ from=any_name TO_ to=any_name
+;
+
+rename_index:
+    RENAME_ (INDEX_ | KEY_) from=any_name TO_ to=any_name
+;
+
+rename_table:
+    RENAME_ (TO_ | AS_)? table_name
+;
+
+// IF EXISTS is supported only by MariaDB.
+change_column:
+    CHANGE_ COLUMN_? (IF_ EXISTS_)? from=any_name to=any_name definition?
+;
+
+update_stmt:
+    UPDATE_ LOW_PRIORITY_? IGNORE_? table_refs SET_ assignment (COMMA assignment)* where_clause? (
+        ORDER_ BY_ expr (COMMA expr)*
+    )? (LIMIT_ expr (COMMA expr)?)?
+;
+
+assignment:
+    column_name ASSIGN expr
+;
+
+where_clause:
+    WHERE_ expr
+;
+
+create_view_stmt:
+    CREATE_ (OR_ REPLACE_)? (ALGORITHM_ ASSIGN any_name)? (DEFINER_ ASSIGN user_name)? (
+        SQL_ SECURITY_ any_name
+    )? VIEW_ table_name (OPEN_PAR tokens CLOSE_PAR)? AS_ (select_stmt | tokens)
+;
+
+// Only simple queries are analyzed. i.e., a SELECT statement with a
+// FROM clause that is optionally followed by other clauses.
+select_stmt:
+    SELECT_ expr (COMMA expr)* FROM_ table_refs (clause_keyword tokens?)?
+;
+
+table_refs:
+    table_ref ((COMMA | join_operator) table_ref join_constraint?)*
+;
+
+table_ref:
+    table_name (PARTITION_ OPEN_PAR tokens CLOSE_PAR)? table_alias? (index_hint (COMMA index_hint)*)?
+    | OPEN_PAR tokens CLOSE_PAR table_alias?
+;
+
+table_alias:
+    AS_? any_name
+;
+
+index_hint:
+    (USE_ | IGNORE_ | FORCE_) (INDEX_ | KEY_) (FOR_ (JOIN_ | ORDER_ BY_ | GROUP_ BY_))? OPEN_PAR tokens? CLOSE_PAR
+;
+
+join_operator:
+    (INNER_ | CROSS_ | LEFT_ | RIGHT_ | NATURAL_ | OUTER_)* (JOIN_ | STRAIGHT_JOIN_)
+;
+
+join_constraint:
+    ON_ (word | OPEN_PAR tokens? CLOSE_PAR)+
+    | USING_ OPEN_PAR tokens CLOSE_PAR
+;
+
+any_stmt:
+    (token | COMMA | SCOL | OPEN_PAR | CLOSE_PAR)+
+;
+
+// An expression, or any other sequence of tokens, that ends before a top-level comma.
+expr:
+    (word | join_keyword | OPEN_PAR tokens? CLOSE_PAR)+
+;
+
+// A column or a table option of an ALTER TABLE statement.
+definition:
+    (token | OPEN_PAR tokens? CLOSE_PAR)+
+;
+
+tokens:
+    (token | COMMA | OPEN_PAR tokens? CLOSE_PAR)+
+;
+
+table_name:
+    any_name (DOT any_name)?
+;
+
+column_name:
+    any_name (DOT any_name)*
+;
+
+user_name:
+    (any_name | STRING_LITERAL) (VARIABLE | OPEN_PAR CLOSE_PAR)?
+;
+
+// Identifiers and non-reserved keywords.
+any_name:
+    IDENTIFIER
+    | QUOTED_IDENTIFIER
+    | ALGORITHM_
+    | DEFINER_
+    | NOWAIT_
+    | ONLINE_
+    | SECURITY_
+    | TABLES_
+    | VIEW_
+    | WAIT_
+;
+
+// Keywords that end a list of table references.
+clause_keyword:
+    EXCEPT_
+    | FOR_
+    | FROM_
+    | GROUP_
+    | HAVING_
+    | INTERSECT_
+    | INTO_
+    | LIMIT_
+    | LOCK_
+    | ORDER_
+    | SET_
+    | UNION_
+    | WHERE_
+    | WINDOW_
+    | WITH_
+;
+
+join_keyword:
+    CROSS_
+    | INNER_
+    | JOIN_
+    | LEFT_
+    | NATURAL_
+    | OUTER_
+    | RIGHT_
+    | STRAIGHT_JOIN_
+;
+
+// Any token, except for semicolons, commas and parentheses.
+token:
+    word
+    | clause_keyword
+    | join_keyword
+;
+
+word:
+    DOT
+    | ASSIGN
+    | OP
+    | ALGORITHM_
+    | ALTER_
+    | AS_
+    | BY_
+    | CHANGE_
+    | COLUMN_
+    | CREATE_
+    | DEFAULT_
+    | DEFINER_
+    | EXISTS_
+    | FORCE_
+    | IF_
+    | IGNORE_
+    | INDEX_
+    | IS_
+    | KEY_
+    | LOW_PRIORITY_
+    | NOWAIT_
+    | NULL_
+    | ON_
+    | ONLINE_
+    | OR_
+    | PARTITION_
+    | RENAME_
+    | REPLACE_
+    | SECURITY_
+    | SELECT_
+    | SQL_
+    | TABLE_
+    | TABLES_
+    | TO_
+    | UPDATE_
+    | USE_
+    | USING_
+    | VIEW_
+    | WAIT_
+    | NUMERIC_LITERAL
+    | IDENTIFIER
+    | QUOTED_IDENTIFIER
+    | STRING_LITERAL
+    | VARIABLE
+;
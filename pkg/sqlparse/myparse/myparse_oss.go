@@ -7,26 +7,482 @@
 package myparse
 
 import (
-	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/veiloq/atlas/pkg/sqlparse/parseutil"
 	"github.com/veiloq/atlas/sql/migrate"
 	"github.com/veiloq/atlas/sql/schema"
+
+	"github.com/antlr4-go/antlr/v4"
+)
+
+type (
+	// tableName is a table name, optionally qualified with its schema.
+	tableName struct {
+		Schema, Name string
+	}
+
+	// tableRef is a table referenced by a statement, and its alias.
+	tableRef struct {
+		*tableName
+		Alias string
+	}
+
+	// renameTableStmt describes a RENAME TABLE statement.
+	renameTableStmt struct {
+		Renames []*parseutil.Rename
+	}
+
+	// alterTableStmt describes an ALTER TABLE statement, and the renames it contains.
+	alterTableStmt struct {
+		Table   *tableName
+		Rename  *tableName // RENAME [TO|AS] clause.
+		Columns []*parseutil.Rename
+		Indexes []*parseutil.Rename
+	}
+
+	// updateStmt describes an UPDATE statement.
+	updateStmt struct {
+		Tables []*tableRef
+		Set    []*assignment
+		Where  []antlr.Token // nil if there is no WHERE clause.
+	}
+
+	// assignment describes a "col = value" assignment of an UPDATE statement.
+	assignment struct {
+		Qualifier string // Table name or alias, if qualified.
+		Column    string
+		Value     []antlr.Token
+	}
+
+	// createViewStmt describes a CREATE VIEW statement.
+	createViewStmt struct {
+		Name *tableName
+		From []*tableRef // Tables selected by the view. Empty for complex queries.
+	}
 )
 
 // Parser for fixing linting changes.
 type FileParser struct{}
 
 // FixChange fixes the changes according to the given statement.
-func (*FileParser) FixChange(migrate.Driver, string, schema.Changes) (schema.Changes, error) {
-	return nil, errors.New("unimplemented")
+func (*FileParser) FixChange(d migrate.Driver, s string, changes schema.Changes) (schema.Changes, error) {
+	stmt, err := parse(s, isMaria(d))
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return changes, nil
+	}
+	switch stmt := stmt.(type) {
+	case *alterTableStmt:
+		name := stmt.Table.Name
+		if stmt.Rename != nil {
+			if changes, err = renameTable(d, stmt, changes); err != nil {
+				return nil, err
+			}
+			name = stmt.Rename.Name
+		}
+		modify, ok := modifyTable(changes, name)
+		if !ok {
+			return changes, nil
+		}
+		for _, r := range stmt.Columns {
+			parseutil.RenameColumn(modify, r)
+		}
+		for _, r := range stmt.Indexes {
+			parseutil.RenameIndex(modify, r)
+		}
+	case *renameTableStmt:
+		for _, r := range stmt.Renames {
+			changes = parseutil.RenameTable(changes, r)
+		}
+	}
+	return changes, nil
 }
 
 // ColumnFilledBefore checks if the column was filled with values before the given position in the file.
-func (*FileParser) ColumnFilledBefore([]*migrate.Stmt, *schema.Table, *schema.Column, int) (bool, error) {
-	return false, errors.New("unimplemented")
+func (*FileParser) ColumnFilledBefore(stmts []*migrate.Stmt, t *schema.Table, c *schema.Column, pos int) (bool, error) {
+	return parseutil.MatchStmtBefore(stmts, pos, func(s *migrate.Stmt) (bool, error) {
+		u, err := parseUpdate(s, t)
+		if u == nil || err != nil {
+			return false, err
+		}
+		// Accept UPDATE that fills all rows or those with NULL values as we cannot
+		// determine if NULL values were filled in case there is a custom filtering.
+		return (u.Where == nil || u.isNull(t, c)) && u.fills(t, c), nil
+	})
+}
+
+// ColumnFilledAfter checks if the column with the given value was filled after the given position in the file.
+func (*FileParser) ColumnFilledAfter(stmts []*migrate.Stmt, t *schema.Table, c *schema.Column, pos int, match any) (bool, error) {
+	return parseutil.MatchStmtAfter(stmts, pos, func(s *migrate.Stmt) (bool, error) {
+		u, err := parseUpdate(s, t)
+		if u == nil || err != nil {
+			return false, err
+		}
+		// Accept UPDATE that fills all rows or those with the given value as we cannot
+		// determine if these values were filled in case there is a custom filtering.
+		return (u.Where == nil || u.equals(t, c, fmt.Sprint(match))) && u.fills(t, c), nil
+	})
 }
 
 // CreateViewAfter checks if a view was created after the position with the given name to a table.
-func (*FileParser) CreateViewAfter([]*migrate.Stmt, string, string, int) (bool, error) {
-	return false, errors.New("unimplemented")
+func (*FileParser) CreateViewAfter(stmts []*migrate.Stmt, old, new string, pos int) (bool, error) {
+	return parseutil.MatchStmtAfter(stmts, pos, func(s *migrate.Stmt) (bool, error) {
+		stmt, err := parse(s.Text, false)
+		if err != nil {
+			return false, err
+		}
+		// A view with the old name that selects from the renamed table.
+		v, ok := stmt.(*createViewStmt)
+		if !ok || v.Name.Name != old || len(v.From) != 1 {
+			return false, nil
+		}
+		return v.From[0].Name == new, nil
+	})
+}
+
+// isMaria reports if the given driver is connected to a MariaDB database.
+func isMaria(d migrate.Driver) bool {
+	m, ok := d.(interface{ Maria() bool })
+	return ok && m.Maria()
+}
+
+// renameTable fixes the changes of an ALTER TABLE command with a RENAME clause. The
+// table is reported by the diff as dropped and added, and therefore, it is replaced
+// with a RenameTable change and the modifications that were made to the table.
+func renameTable(d migrate.Driver, s *alterTableStmt, changes schema.Changes) (schema.Changes, error) {
+	i, j := changes.LastIndexDropTable(s.Table.Name), changes.LastIndexAddTable(s.Rename.Name)
+	if i == -1 || j == -1 {
+		return changes, nil
+	}
+	rename := &schema.RenameTable{
+		From: changes[i].(*schema.DropTable).T,
+		To:   changes[j].(*schema.AddTable).T,
+	}
+	// Diff the table after renaming it, to get the rest of the changes.
+	renamed := *rename.From
+	renamed.Name = rename.To.Name
+	modified, err := d.TableDiff(&renamed, rename.To)
+	if err != nil {
+		return nil, err
+	}
+	changes[max(i, j)] = rename
+	changes.RemoveIndex(min(i, j))
+	if len(modified) > 0 {
+		changes = append(changes, &schema.ModifyTable{T: rename.To, Changes: modified})
+	}
+	return changes, nil
+}
+
+// modifyTable returns the ModifyTable change of the given table.
+func modifyTable(changes schema.Changes, name string) (*schema.ModifyTable, bool) {
+	for _, c := range changes {
+		if m, ok := c.(*schema.ModifyTable); ok && m.T.Name == name {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// parseUpdate parses the given statement and returns
+// it if it is an UPDATE statement of the given table.
+func parseUpdate(s *migrate.Stmt, t *schema.Table) (*updateStmt, error) {
+	stmt, err := parse(s.Text, false)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := stmt.(*updateStmt)
+	if !ok || u.ref(t) == nil {
+		return nil, nil
+	}
+	return u, nil
+}
+
+// ref returns the reference of the given table in the statement.
+func (u *updateStmt) ref(t *schema.Table) *tableRef {
+	for _, r := range u.Tables {
+		if r.Name == t.Name && (r.Schema == "" || t.Schema == nil || r.Schema == t.Schema.Name) {
+			return r
+		}
+	}
+	return nil
+}
+
+// column reports if the (optionally qualified) column refers to the column of the given table.
+func (u *updateStmt) column(t *schema.Table, c *schema.Column, qualifier, name string) bool {
+	if name != c.Name {
+		return false
+	}
+	// Unqualified columns are accepted only in single-table updates.
+	if qualifier == "" {
+		return len(u.Tables) == 1
+	}
+	r := u.ref(t)
+	return qualifier == r.Alias || r.Alias == "" && qualifier == r.Name
+}
+
+// fills reports if the statement sets the column with a value.
+func (u *updateStmt) fills(t *schema.Table, c *schema.Column) bool {
+	for _, a := range u.Set {
+		if !u.column(t, c, a.Qualifier, a.Column) {
+			continue
+		}
+		// Setting the column to its default value or to NULL does not fill it.
+		if len(a.Value) == 1 && (a.Value[0].GetTokenType() == ParserDEFAULT_ || a.Value[0].GetTokenType() == ParserNULL_) {
+			return false
+		}
+		return true
+	}
+	return false
+}
+
+// isNull reports if the WHERE clause of the statement is "<column> IS NULL".
+func (u *updateStmt) isNull(t *schema.Table, c *schema.Column) bool {
+	qualifier, name, rest := u.whereColumn()
+	return u.column(t, c, qualifier, name) && len(rest) == 2 && rest[0].GetTokenType() == ParserIS_ && rest[1].GetTokenType() == ParserNULL_
+}
+
+// equals reports if the WHERE clause of the statement is "<column> = <value>".
+func (u *updateStmt) equals(t *schema.Table, c *schema.Column, v string) bool {
+	qualifier, name, rest := u.whereColumn()
+	if !u.column(t, c, qualifier, name) || len(rest) != 2 || rest[0].GetTokenType() != ParserASSIGN {
+		return false
+	}
+	switch x := rest[1]; x.GetTokenType() {
+	case ParserSTRING_LITERAL:
+		return unquote(x) == strings.Trim(v, `"'`)
+	case ParserNUMERIC_LITERAL, ParserIDENTIFIER:
+		return strings.EqualFold(x.GetText(), v)
+	}
+	return false
+}
+
+// whereColumn splits the WHERE clause into its leading column reference and the rest of its tokens.
+func (u *updateStmt) whereColumn() (qualifier, name string, rest []antlr.Token) {
+	var names []string
+	for i := 0; i < len(u.Where); i++ {
+		if t := u.Where[i]; t.GetTokenType() != ParserIDENTIFIER && t.GetTokenType() != ParserQUOTED_IDENTIFIER {
+			break
+		}
+		names = append(names, unquote(u.Where[i]))
+		// Qualified names, e.g. "t.c".
+		if i+1 < len(u.Where) && u.Where[i+1].GetTokenType() == ParserDOT {
+			i++
+			continue
+		}
+		rest = u.Where[i+1:]
+		break
+	}
+	switch len(names) {
+	case 0:
+		return "", "", nil
+	case 1:
+		return "", names[0], rest
+	default:
+		return names[len(names)-2], names[len(names)-1], rest
+	}
+}
+
+// parse the given statement and returns its description, or nil if the
+// statement is not relevant for analyzing migration files. The maria flag
+// indicates if the statement is parsed according to the MariaDB dialect,
+// which differs from MySQL only in ALTER TABLE statements.
+func parse(s string, maria bool) (any, error) {
+	var (
+		errs  = &errorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+		lexer = NewLexer(antlr.NewInputStream(s))
+	)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errs)
+	p := NewParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errs)
+	stmt := p.Parse().Sql_stmt()
+	switch {
+	case errs.err != nil:
+		return nil, errs.err
+	case stmt == nil:
+		return nil, nil
+	case stmt.Rename_table_stmt() != nil:
+		return newRenameTableStmt(stmt.Rename_table_stmt()), nil
+	case stmt.Alter_table_stmt() != nil:
+		if !maria && mariaOnly(stmt.Alter_table_stmt()) {
+			return nil, nil
+		}
+		return newAlterTableStmt(stmt.Alter_table_stmt()), nil
+	case stmt.Update_stmt() != nil:
+		return newUpdateStmt(stmt.Update_stmt()), nil
+	case stmt.Create_view_stmt() != nil:
+		return newCreateViewStmt(stmt.Create_view_stmt()), nil
+	}
+	return nil, nil
+}
+
+// mariaOnly reports if the ALTER TABLE statement uses syntax that is supported only by MariaDB.
+func mariaOnly(c IAlter_table_stmtContext) bool {
+	if c.ONLINE_() != nil || c.IGNORE_() != nil || c.IF_() != nil || c.WAIT_() != nil || c.NOWAIT_() != nil {
+		return true
+	}
+	for _, s := range c.AllAlter_spec() {
+		if s.Change_column() != nil && s.Change_column().IF_() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// newRenameTableStmt returns the description of a RENAME TABLE statement.
+func newRenameTableStmt(c IRename_table_stmtContext) *renameTableStmt {
+	stmt := &renameTableStmt{}
+	for _, p := range c.AllRename_pair() {
+		stmt.Renames = append(stmt.Renames, &parseutil.Rename{
+			From: newTableName(p.GetFrom()).Name,
+			To:   newTableName(p.GetTo()).Name,
+		})
+	}
+	return stmt
+}
+
+// newAlterTableStmt returns the description of an ALTER TABLE statement.
+// Alter specifications that do not rename anything are ignored.
+func newAlterTableStmt(c IAlter_table_stmtContext) *alterTableStmt {
+	stmt := &alterTableStmt{Table: newTableName(c.Table_name())}
+	for _, s := range c.AllAlter_spec() {
+		switch {
+		case s.Rename_column() != nil:
+			r := s.Rename_column()
+			stmt.Columns = append(stmt.Columns, &parseutil.Rename{From: name(r.GetFrom()), To: name(r.GetTo())})
+		case s.Rename_index() != nil:
+			r := s.Rename_index()
+			stmt.Indexes = append(stmt.Indexes, &parseutil.Rename{From: name(r.GetFrom()), To: name(r.GetTo())})
+		case s.Rename_table() != nil:
+			stmt.Rename = newTableName(s.Rename_table().Table_name())
+		case s.Change_column() != nil:
+			if r := s.Change_column(); name(r.GetFrom()) != name(r.GetTo()) {
+				stmt.Columns = append(stmt.Columns, &parseutil.Rename{From: name(r.GetFrom()), To: name(r.GetTo())})
+			}
+		}
+	}
+	return stmt
+}
+
+// newUpdateStmt returns the description of an UPDATE statement.
+func newUpdateStmt(c IUpdate_stmtContext) *updateStmt {
+	stmt := &updateStmt{Tables: newTableRefs(c.Table_refs())}
+	for _, a := range c.AllAssignment() {
+		names := a.Column_name().AllAny_name()
+		set := &assignment{Column: name(names[len(names)-1]), Value: terminals(a.Expr())}
+		if len(names) > 1 {
+			set.Qualifier = name(names[len(names)-2])
+		}
+		stmt.Set = append(stmt.Set, set)
+	}
+	if w := c.Where_clause(); w != nil {
+		stmt.Where = terminals(w.Expr())
+	}
+	return stmt
+}
+
+// newCreateViewStmt returns the description of a CREATE VIEW statement.
+func newCreateViewStmt(c ICreate_view_stmtContext) *createViewStmt {
+	stmt := &createViewStmt{Name: newTableName(c.Table_name())}
+	if s := c.Select_stmt(); s != nil {
+		stmt.From = newTableRefs(s.Table_refs())
+	}
+	return stmt
+}
+
+// newTableRefs returns the tables referenced by the given list.
+// Derived tables (subqueries) are skipped.
+func newTableRefs(c ITable_refsContext) []*tableRef {
+	var refs []*tableRef
+	for _, r := range c.AllTable_ref() {
+		if r.Table_name() == nil {
+			continue
+		}
+		ref := &tableRef{tableName: newTableName(r.Table_name())}
+		if a := r.Table_alias(); a != nil {
+			ref.Alias = name(a.Any_name())
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// newTableName returns the table name, optionally qualified with its schema.
+func newTableName(c ITable_nameContext) *tableName {
+	names := c.AllAny_name()
+	t := &tableName{Name: name(names[len(names)-1])}
+	if len(names) > 1 {
+		t.Schema = name(names[0])
+	}
+	return t
+}
+
+// name returns the unquoted value of the given identifier.
+func name(c IAny_nameContext) string {
+	return unquote(c.GetStart())
+}
+
+// terminals returns the tokens of the given parse tree.
+func terminals(t antlr.Tree) []antlr.Token {
+	if n, ok := t.(antlr.TerminalNode); ok {
+		return []antlr.Token{n.GetSymbol()}
+	}
+	var tokens []antlr.Token
+	for _, c := range t.GetChildren() {
+		tokens = append(tokens, terminals(c)...)
+	}
+	return tokens
+}
+
+// unquote returns the value of the given token. Quoted identifiers and
+// strings are unquoted, and the text of other tokens is returned as-is.
+func unquote(t antlr.Token) string {
+	s := t.GetText()
+	switch t.GetTokenType() {
+	case ParserQUOTED_IDENTIFIER:
+		return strings.ReplaceAll(s[1:len(s)-1], "``", "`")
+	case ParserSTRING_LITERAL:
+		q := s[:1]
+		s = strings.ReplaceAll(s[1:len(s)-1], q+q, q)
+		var b strings.Builder
+		for i := 0; i < len(s); i++ {
+			if s[i] != '\\' || i == len(s)-1 {
+				b.WriteByte(s[i])
+				continue
+			}
+			i++
+			switch s[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '0':
+				b.WriteByte(0)
+			default:
+				b.WriteByte(s[i])
+			}
+		}
+		return b.String()
+	}
+	return s
+}
+
+// errorListener records the first error reported by the lexer or the parser.
+type errorListener struct {
+	*antlr.DefaultErrorListener
+	err error
+}
+
+// SyntaxError implements the antlr.ErrorListener interface.
+func (l *errorListener) SyntaxError(_ antlr.Recognizer, _ any, line, column int, msg string, _ antlr.RecognitionException) {
+	if l.err == nil {
+		l.err = fmt.Errorf("myparse: syntax error at line %d:%d: %s", line, column, msg)
+	}
 }
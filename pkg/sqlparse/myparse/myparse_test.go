@@ -0,0 +1,440 @@
+// Copyright 2021-present The Atlas Authors. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package myparse_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/veiloq/atlas/pkg/sqlparse/myparse"
+	"github.com/veiloq/atlas/sql/migrate"
+	"github.com/veiloq/atlas/sql/mysql"
+	"github.com/veiloq/atlas/sql/schema"
+	"github.com/veiloq/atlas/sql/sqlcheck"
+	"github.com/veiloq/atlas/sql/sqlcheck/incompatible"
+	"github.com/veiloq/atlas/sql/sqlclient"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixChange_RenameColumns(t *testing.T) {
+	var p myparse.FileParser
+	_, err := p.FixChange(nil, "ALTER TABLE t RENAME COLUMN `c1` TO `c2", nil)
+	require.Error(t, err)
+
+	changes, err := p.FixChange(nil, "ALTER TABLE t RENAME COLUMN c1 TO c2", nil)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	changes, err = p.FixChange(
+		nil,
+		"ALTER TABLE t RENAME COLUMN c1 TO c2",
+		schema.Changes{&schema.AddTable{}},
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+
+	tbl := schema.NewTable("t")
+	changes, err = p.FixChange(
+		nil,
+		"ALTER TABLE `t` RENAME COLUMN `c1` TO `c2`, CHANGE COLUMN `c3` c4 int NOT NULL, ADD COLUMN `c5` int",
+		schema.Changes{
+			&schema.ModifyTable{
+				T: tbl,
+				Changes: schema.Changes{
+					&schema.DropColumn{C: schema.NewColumn("c1")},
+					&schema.AddColumn{C: schema.NewColumn("c2")},
+					&schema.DropColumn{C: schema.NewColumn("c3")},
+					&schema.AddColumn{C: schema.NewColumn("c4")},
+					&schema.AddColumn{C: schema.NewColumn("c5")},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		schema.Changes{
+			&schema.ModifyTable{
+				T: tbl,
+				Changes: schema.Changes{
+					&schema.RenameColumn{From: schema.NewColumn("c1"), To: schema.NewColumn("c2")},
+					&schema.RenameColumn{From: schema.NewColumn("c3"), To: schema.NewColumn("c4")},
+					&schema.AddColumn{C: schema.NewColumn("c5")},
+				},
+			},
+		},
+		changes,
+	)
+}
+
+func TestFixChange_RenameIndexes(t *testing.T) {
+	var (
+		p   myparse.FileParser
+		tbl = schema.NewTable("t")
+	)
+	changes, err := p.FixChange(
+		nil,
+		"ALTER TABLE t RENAME INDEX i1 TO i2, RENAME KEY i3 TO i4",
+		schema.Changes{
+			&schema.ModifyTable{
+				T: tbl,
+				Changes: schema.Changes{
+					&schema.DropIndex{I: schema.NewIndex("i1")},
+					&schema.AddIndex{I: schema.NewIndex("i2")},
+					&schema.DropIndex{I: schema.NewIndex("i3")},
+					&schema.AddIndex{I: schema.NewIndex("i4")},
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		schema.Changes{
+			&schema.ModifyTable{
+				T: tbl,
+				Changes: schema.Changes{
+					&schema.RenameIndex{From: schema.NewIndex("i1"), To: schema.NewIndex("i2")},
+					&schema.RenameIndex{From: schema.NewIndex("i3"), To: schema.NewIndex("i4")},
+				},
+			},
+		},
+		changes,
+	)
+}
+
+func TestFixChange_RenameTable(t *testing.T) {
+	var p myparse.FileParser
+	changes, err := p.FixChange(
+		nil,
+		"RENAME TABLE `s`.`t1` TO `s`.`t2`, t3 TO t4",
+		schema.Changes{
+			&schema.DropTable{T: schema.NewTable("t1")},
+			&schema.AddTable{T: schema.NewTable("t2")},
+			&schema.DropTable{T: schema.NewTable("t3")},
+			&schema.AddTable{T: schema.NewTable("t4")},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		schema.Changes{
+			&schema.RenameTable{From: schema.NewTable("t1"), To: schema.NewTable("t2")},
+			&schema.RenameTable{From: schema.NewTable("t3"), To: schema.NewTable("t4")},
+		},
+		changes,
+	)
+
+	// ALTER TABLE with a RENAME clause.
+	var (
+		from = schema.NewTable("t1").SetSchema(schema.New("s")).AddColumns(schema.NewIntColumn("a", "int"), schema.NewIntColumn("b", "int"))
+		to   = schema.NewTable("t2").SetSchema(schema.New("s")).AddColumns(schema.NewIntColumn("a", "int"), schema.NewIntColumn("c", "int"))
+	)
+	changes, err = p.FixChange(
+		differ{},
+		"ALTER TABLE t1 RENAME TO t2, RENAME COLUMN b TO c",
+		schema.Changes{
+			&schema.DropTable{T: from},
+			&schema.AddTable{T: to},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, &schema.RenameTable{From: from, To: to}, changes[0])
+	modify, ok := changes[1].(*schema.ModifyTable)
+	require.True(t, ok)
+	require.Equal(t, to, modify.T)
+	require.Equal(t, schema.Changes{&schema.RenameColumn{From: from.Columns[1], To: to.Columns[1]}}, schema.Changes(modify.Changes))
+}
+
+func TestFixChange_AlterMaria(t *testing.T) {
+	var (
+		p       myparse.FileParser
+		changes = func() schema.Changes {
+			return schema.Changes{
+				&schema.ModifyTable{
+					T: schema.NewTable("t"),
+					Changes: schema.Changes{
+						&schema.DropColumn{C: schema.NewColumn("a")},
+						&schema.AddColumn{C: schema.NewColumn("b")},
+						&schema.DropColumn{C: schema.NewColumn("c")},
+						&schema.AddColumn{C: schema.NewColumn("d")},
+					},
+				},
+			}
+		}
+	)
+	// IF EXISTS, ONLINE and IGNORE are not supported by MySQL.
+	for _, s := range []string{
+		"ALTER TABLE IF EXISTS t RENAME COLUMN a TO b",
+		"ALTER ONLINE TABLE t RENAME COLUMN a TO b",
+		"ALTER IGNORE TABLE t RENAME COLUMN a TO b",
+		"ALTER ONLINE IGNORE TABLE t RENAME COLUMN a TO b",
+	} {
+		fixed, err := p.FixChange(nil, s, changes())
+		require.NoError(t, err)
+		require.Equal(t, changes(), fixed, s)
+	}
+	for _, s := range []string{
+		"ALTER TABLE IF EXISTS t RENAME COLUMN a TO b, CHANGE COLUMN IF EXISTS c d int",
+		"ALTER ONLINE IGNORE TABLE IF EXISTS `t` WAIT 10 RENAME COLUMN a TO b, CHANGE c d int",
+		"ALTER IGNORE TABLE t NOWAIT RENAME COLUMN a TO b, CHANGE COLUMN c d int",
+	} {
+		fixed, err := p.FixChange(maria{}, s, changes())
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			schema.Changes{
+				&schema.ModifyTable{
+					T: schema.NewTable("t"),
+					Changes: schema.Changes{
+						&schema.RenameColumn{From: schema.NewColumn("a"), To: schema.NewColumn("b")},
+						&schema.RenameColumn{From: schema.NewColumn("c"), To: schema.NewColumn("d")},
+					},
+				},
+			},
+			fixed,
+			s,
+		)
+	}
+}
+
+func TestColumnFilledBefore(t *testing.T) {
+	for i, tt := range []struct {
+		file       string
+		pos        int
+		wantFilled bool
+		wantErr    bool
+	}{
+		{
+			file: `UPDATE t SET c = 1;`,
+			pos:  100,
+		},
+		{
+			file: `UPDATE users SET c = 1;`,
+			pos:  100,
+		},
+		{
+			file:       `UPDATE users SET a = 1;`,
+			pos:        100,
+			wantFilled: true,
+		},
+		{
+			file:       "UPDATE `users` SET `a` = 'x' WHERE `a` IS NULL;",
+			pos:        100,
+			wantFilled: true,
+		},
+		{
+			file:       "UPDATE LOW_PRIORITY `public`.`users` AS u SET u.a = b + 1 WHERE u.a IS NULL;",
+			pos:        100,
+			wantFilled: true,
+		},
+		{
+			file:       "UPDATE users u JOIN pets p ON p.owner_id = u.id SET u.a = p.name;",
+			pos:        100,
+			wantFilled: true,
+		},
+		{
+			// Ambiguous column in multi-table update.
+			file: "UPDATE users u JOIN pets p ON p.owner_id = u.id SET a = p.name;",
+			pos:  100,
+		},
+		{
+			// Update is after the given position.
+			file: `UPDATE users SET a = 1;`,
+			pos:  0,
+		},
+		{
+			file: `UPDATE users SET a = DEFAULT;`,
+			pos:  100,
+		},
+		{
+			file: `UPDATE users SET a = NULL;`,
+			pos:  100,
+		},
+		{
+			// Custom filtering.
+			file: `UPDATE users SET a = 1 WHERE b IS NULL;`,
+			pos:  100,
+		},
+		{
+			file: `UPDATE users SET a = 1 WHERE a IS NOT NULL;`,
+			pos:  100,
+		},
+		{
+			file:       `UPDATE users SET b = 2, a = (SELECT MAX(id) FROM pets WHERE pets.a = 1) WHERE a IS NULL ORDER BY id LIMIT 10;`,
+			pos:        100,
+			wantFilled: true,
+		},
+		{
+			file:    `UPDATE users SET a = 'unclosed;`,
+			pos:     100,
+			wantErr: true,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var (
+				p     myparse.FileParser
+				stmts = []*migrate.Stmt{{Text: tt.file}}
+				tbl   = schema.NewTable("users").SetSchema(schema.New("public")).AddColumns(schema.NewIntColumn("a", "int"))
+			)
+			filled, err := p.ColumnFilledBefore(stmts, tbl, tbl.Columns[0], tt.pos)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.wantFilled, filled)
+		})
+	}
+}
+
+func TestColumnFilledAfter(t *testing.T) {
+	for i, tt := range []struct {
+		file       string
+		match      any
+		wantFilled bool
+	}{
+		{
+			file:       `UPDATE users SET a = 1;`,
+			wantFilled: true,
+		},
+		{
+			file:       `UPDATE users SET a = 1 WHERE a = 0;`,
+			match:      0,
+			wantFilled: true,
+		},
+		{
+			file:  `UPDATE users SET a = 1 WHERE a = 1;`,
+			match: 0,
+		},
+		{
+			file:       `UPDATE users SET a = 'foo' WHERE a = "";`,
+			match:      `""`,
+			wantFilled: true,
+		},
+		{
+			file:  `UPDATE users SET a = 1 WHERE a IS NULL;`,
+			match: 0,
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var (
+				p     myparse.FileParser
+				stmts = []*migrate.Stmt{{Text: "ALTER TABLE users ADD COLUMN a int NOT NULL;"}, {Pos: 50, Text: tt.file}}
+				tbl   = schema.NewTable("users").AddColumns(schema.NewIntColumn("a", "int"))
+			)
+			filled, err := p.ColumnFilledAfter(stmts, tbl, tbl.Columns[0], 0, tt.match)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFilled, filled)
+		})
+	}
+}
+
+func TestCreateViewAfter(t *testing.T) {
+	for i, tt := range []struct {
+		file        string
+		wantCreated bool
+	}{
+		{
+			file: "CREATE TABLE users (id int)",
+		},
+		{
+			file:        "CREATE VIEW users AS SELECT * FROM Users",
+			wantCreated: true,
+		},
+		{
+			file:        "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`localhost` SQL SECURITY INVOKER VIEW `users` (`id`) AS SELECT `id` FROM `Users` u WHERE u.id > 0",
+			wantCreated: true,
+		},
+		{
+			file: "CREATE VIEW users AS SELECT * FROM Users JOIN pets ON pets.owner_id = Users.id",
+		},
+		{
+			file: "CREATE VIEW users AS SELECT * FROM Users, pets",
+		},
+		{
+			file: "CREATE VIEW users AS SELECT * FROM pets",
+		},
+		{
+			file: "CREATE VIEW pets AS SELECT * FROM Users",
+		},
+		{
+			file: "CREATE VIEW users AS SELECT (SELECT 1 FROM Users)",
+		},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			var (
+				p     myparse.FileParser
+				stmts = []*migrate.Stmt{{Text: "ALTER TABLE users RENAME TO Users"}, {Pos: 50, Text: tt.file}}
+			)
+			created, err := p.CreateViewAfter(stmts, "users", "Users", 0)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCreated, created)
+		})
+	}
+}
+
+func TestCreateViewAfter_RenameTable(t *testing.T) {
+	var (
+		report *sqlcheck.Report
+		rename = func(from, to string, pos int) *sqlcheck.Change {
+			return &sqlcheck.Change{
+				Stmt: &migrate.Stmt{Pos: pos, Text: fmt.Sprintf("ALTER TABLE `%s` RENAME TO `%s`", from, to)},
+				Changes: schema.Changes{
+					&schema.RenameTable{
+						From: schema.NewTable(from).SetSchema(schema.New("test")),
+						To:   schema.NewTable(to).SetSchema(schema.New("test")),
+					},
+				},
+			}
+		}
+		file = migrate.NewLocalFile("1.sql", []byte(strings.Join([]string{
+			"ALTER TABLE `users` RENAME TO `Users`;",
+			"CREATE VIEW `users` AS SELECT * FROM `Users`;",
+			"ALTER TABLE `pets` RENAME TO `Pets`;",
+		}, "\n")))
+		pass = &sqlcheck.Pass{
+			Dev: &sqlclient.Client{},
+			File: &sqlcheck.File{
+				File:   file,
+				Parser: &myparse.FileParser{},
+				Changes: []*sqlcheck.Change{
+					rename("users", "Users", 0),
+					rename("pets", "Pets", 85),
+				},
+			},
+			Reporter: sqlcheck.ReportWriterFunc(func(r sqlcheck.Report) {
+				report = &r
+			}),
+		}
+	)
+	stmts, err := file.StmtDecls()
+	require.NoError(t, err)
+	require.Len(t, stmts, 3)
+	require.Equal(t, 85, stmts[2].Pos)
+	require.True(t, incompatible.ViewForRenamedT(pass, "users", "Users", 0))
+	require.False(t, incompatible.ViewForRenamedT(pass, "pets", "Pets", 85))
+
+	az, err := incompatible.New(nil)
+	require.NoError(t, err)
+	require.NoError(t, az.Analyze(context.Background(), pass))
+	require.NotNil(t, report)
+	require.Len(t, report.Diagnostics, 1)
+	require.Equal(t, "BC101", report.Diagnostics[0].Code)
+	require.Equal(t, `Renaming table "pets" to "Pets"`, report.Diagnostics[0].Text)
+}
+
+// differ is a migrate.Driver that supports only table diffing.
+type differ struct{ migrate.Driver }
+
+func (differ) TableDiff(from, to *schema.Table, opts ...schema.DiffOption) ([]schema.Change, error) {
+	return mysql.DefaultDiff.TableDiff(from, to, opts...)
+}
+
+// maria is a migrate.Driver that is connected to a MariaDB database.
+type maria struct{ migrate.Driver }
+
+func (maria) Maria() bool { return true }
@@ -426,6 +426,113 @@ func View(spec *sqlspec.View, parent *schema.Schema, convertC ConvertViewColumnF
 	return v, nil
 }
 
+// Func converts a sqlspec.Func to a schema.Func.
+func Func(spec *sqlspec.Func, parent *schema.Schema, conv ConvertTypeFunc) (*schema.Func, error) {
+	f := &schema.Func{
+		Name:   spec.Name,
+		Schema: parent,
+	}
+	schemahcl.AppendPos(&f.Attrs, spec.Range)
+	var err error
+	if f.Lang, f.Body, f.Args, err = funcDef(typeFunction, spec, conv); err != nil {
+		return nil, err
+	}
+	if r, ok := spec.Extra.Attr("return"); ok {
+		t, err := retType(r)
+		if err != nil {
+			return nil, fmt.Errorf("expect type definition for attribute function.%s.return: %w", spec.Name, err)
+		}
+		if f.Ret, err = conv(&sqlspec.Column{Type: t}); err != nil {
+			return nil, fmt.Errorf("convert return type of function %q: %w", spec.Name, err)
+		}
+	}
+	if err := convertCommentFromSpec(spec, &f.Attrs); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Proc converts a sqlspec.Func to a schema.Proc.
+func Proc(spec *sqlspec.Func, parent *schema.Schema, conv ConvertTypeFunc) (*schema.Proc, error) {
+	p := &schema.Proc{
+		Name:   spec.Name,
+		Schema: parent,
+	}
+	schemahcl.AppendPos(&p.Attrs, spec.Range)
+	var err error
+	if p.Lang, p.Body, p.Args, err = funcDef(typeProcedure, spec, conv); err != nil {
+		return nil, err
+	}
+	if err := convertCommentFromSpec(spec, &p.Attrs); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// funcDef extracts the language, body and arguments of a function or procedure spec.
+func funcDef(typ string, spec *sqlspec.Func, conv ConvertTypeFunc) (lang, body string, args []*schema.FuncArg, err error) {
+	switch l := spec.Lang; {
+	case l.IsNull():
+	case l.Type() == cty.String:
+		lang = l.AsString()
+	default:
+		return "", "", nil, fmt.Errorf("expect string value for attribute %s.%s.lang", typ, spec.Name)
+	}
+	as, ok := spec.Extra.Attr("as")
+	if !ok {
+		return "", "", nil, fmt.Errorf("missing 'as' definition for %s %q", typ, spec.Name)
+	}
+	if body, err = as.String(); err != nil {
+		return "", "", nil, fmt.Errorf("expect string definition for attribute %s.%s.as: %w", typ, spec.Name, err)
+	}
+	for _, a := range spec.Args {
+		arg := &schema.FuncArg{
+			Name: a.Name,
+			Mode: schema.FuncArgModeIn,
+		}
+		if a.Type == nil {
+			return "", "", nil, fmt.Errorf("missing type for argument %q of %s %q", a.Name, typ, spec.Name)
+		}
+		if arg.Type, err = conv(&sqlspec.Column{Type: a.Type}); err != nil {
+			return "", "", nil, fmt.Errorf("convert type of argument %q of %s %q: %w", a.Name, typ, spec.Name, err)
+		}
+		if arg.Default, err = Default(a.Default); err != nil {
+			return "", "", nil, fmt.Errorf("convert default of argument %q of %s %q: %w", a.Name, typ, spec.Name, err)
+		}
+		if m, ok := a.Extra.Attr("mode"); ok {
+			s, err := m.String()
+			if err != nil {
+				return "", "", nil, fmt.Errorf("expect string value for attribute %s.%s.arg.%s.mode: %w", typ, spec.Name, a.Name, err)
+			}
+			arg.Mode = schema.FuncArgMode(strings.ToUpper(s))
+		}
+		schemahcl.AppendPos(&arg.Attrs, a.Range)
+		args = append(args, arg)
+	}
+	return lang, body, args, nil
+}
+
+// retType extracts the return type of a function. Similar to column types,
+// it can be defined as a raw expression (e.g., sql("SETOF int")) or a reference.
+func retType(a *schemahcl.Attr) (*schemahcl.Type, error) {
+	switch {
+	case a.IsRawExpr():
+		x, err := a.RawExpr()
+		if err != nil {
+			return nil, err
+		}
+		return &schemahcl.Type{T: x.X}, nil
+	case a.IsRef():
+		r, err := a.Ref()
+		if err != nil {
+			return nil, err
+		}
+		return &schemahcl.Type{T: r, IsRef: true}, nil
+	default:
+		return a.Type()
+	}
+}
+
 // Column converts a sqlspec.Column into a schema.Column.
 func Column(spec *sqlspec.Column, conv ConvertTypeFunc) (*schema.Column, error) {
 	out := &schema.Column{
@@ -813,6 +920,73 @@ func FromView(v *schema.View, colFn ViewColumnSpecFunc, idxFn IndexSpecFunc) (*s
 	return spec, nil
 }
 
+// FromFunc converts a schema.Func to a sqlspec.Func.
+func FromFunc(f *schema.Func, typeFn ColumnTypeSpecFunc) (*sqlspec.Func, error) {
+	spec, err := fromFuncDef(f.Name, f.Lang, f.Body, f.Args, typeFn)
+	if err != nil {
+		return nil, err
+	}
+	if f.Ret != nil {
+		t, err := typeFn(f.Ret)
+		if err != nil {
+			return nil, fmt.Errorf("convert return type of function %q: %w", f.Name, err)
+		}
+		// Return type and definition are appended after the arguments.
+		spec.Extra.Attrs = append([]*schemahcl.Attr{TypeAttr("return", t.Type)}, spec.Extra.Attrs...)
+	}
+	if deps, ok := dependsOn(f.Schema.Realm, f.Deps); ok {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, deps)
+	}
+	convertCommentFromSchema(f.Attrs, &spec.Extra.Attrs)
+	return spec, nil
+}
+
+// FromProc converts a schema.Proc to a sqlspec.Func.
+func FromProc(p *schema.Proc, typeFn ColumnTypeSpecFunc) (*sqlspec.Func, error) {
+	spec, err := fromFuncDef(p.Name, p.Lang, p.Body, p.Args, typeFn)
+	if err != nil {
+		return nil, err
+	}
+	if deps, ok := dependsOn(p.Schema.Realm, p.Deps); ok {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, deps)
+	}
+	convertCommentFromSchema(p.Attrs, &spec.Extra.Attrs)
+	return spec, nil
+}
+
+// fromFuncDef converts the definition of a function or procedure to a sqlspec.Func.
+func fromFuncDef(name, lang, body string, args []*schema.FuncArg, typeFn ColumnTypeSpecFunc) (*sqlspec.Func, error) {
+	spec := &sqlspec.Func{
+		Name: name,
+	}
+	if lang != "" {
+		spec.Lang = cty.StringVal(lang)
+	}
+	for _, a := range args {
+		t, err := typeFn(a.Type)
+		if err != nil {
+			return nil, fmt.Errorf("convert type of argument %q of %q: %w", a.Name, name, err)
+		}
+		arg := &sqlspec.FuncArg{
+			Name: a.Name,
+			Type: t.Type,
+		}
+		if a.Default != nil {
+			if arg.Default, err = ColumnDefault(&schema.Column{Type: &schema.ColumnType{Type: a.Type}, Default: a.Default}); err != nil {
+				return nil, err
+			}
+		}
+		if a.Mode != "" && a.Mode != schema.FuncArgModeIn {
+			arg.Extra.Attrs = append(arg.Extra.Attrs, VarAttr("mode", string(a.Mode)))
+		}
+		spec.Args = append(spec.Args, arg)
+	}
+	// In case the definition is multi-line,
+	// format it as indented heredoc with two spaces.
+	spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("as", sqlspec.MightHeredoc(body)))
+	return spec, nil
+}
+
 // dependsOn returns the depends_on attribute for the given objects.
 func dependsOn(realm *schema.Realm, objects []schema.Object) (*schemahcl.Attr, bool) {
 	var (
@@ -1292,6 +1466,55 @@ func findT[T schema.Object](sch *schema.Schema, qualifier, name string, findT fu
 	return
 }
 
+// ObjectByRef returns the table, view, function or procedure referenced by ref.
+// Unqualified references are searched in all schemas of the realm.
+func ObjectByRef(r *schema.Realm, ref *schemahcl.Ref) (schema.Object, error) {
+	path, err := ref.Path()
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty reference %q", ref.V)
+	}
+	typ := path[0].T
+	q, n, err := RefName(ref, typ)
+	if err != nil {
+		return nil, err
+	}
+	var find func(*schema.Schema) (schema.Object, bool)
+	switch typ {
+	case typeTable:
+		find = func(s *schema.Schema) (schema.Object, bool) { return s.Table(n) }
+	case typeView:
+		find = func(s *schema.Schema) (schema.Object, bool) { return s.View(n) }
+	case typeMaterialized:
+		find = func(s *schema.Schema) (schema.Object, bool) { return s.Materialized(n) }
+	case typeFunction:
+		find = func(s *schema.Schema) (schema.Object, bool) { return s.Func(n) }
+	case typeProcedure:
+		find = func(s *schema.Schema) (schema.Object, bool) { return s.Proc(n) }
+	default:
+		return nil, fmt.Errorf("unexpected reference type %q", typ)
+	}
+	var matches []schema.Object
+	for _, s := range r.Schemas {
+		if q != "" && s.Name != q {
+			continue
+		}
+		if o, ok := find(s); ok {
+			matches = append(matches, o)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("referenced %s %q not found", typ, n)
+	default:
+		return nil, fmt.Errorf("multiple reference %ss found for %q", typ, n)
+	}
+}
+
 // TableName returns the qualifier and name from a reference to a table.
 func TableName(ref *schemahcl.Ref) (string, string, error) {
 	return RefName(ref, typeTable)
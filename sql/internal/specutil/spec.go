@@ -261,6 +261,21 @@ func ViewSpecRef(v *schema.View) *schemahcl.Ref {
 	return schemahcl.BuildRef([]schemahcl.PathIndex{idx})
 }
 
+// FuncSpecRef returns a reference to the function in the spec. In case there is more
+// than one function with the same name, the reference will be qualified with the schema name.
+func FuncSpecRef(f *schema.Func) *schemahcl.Ref {
+	typ, name := typeFunction, f.Name
+	idx := schemahcl.PathIndex{T: typ, V: []string{name}}
+	if s := f.Schema; s != nil && s.Realm != nil && len(s.Realm.Schemas) > 1 && slices.ContainsFunc(s.Realm.Schemas, func(s1 *schema.Schema) bool {
+		return s1 != s && slices.ContainsFunc(s1.Funcs, func(f1 *schema.Func) bool {
+			return f.Name == f1.Name
+		})
+	}) {
+		idx.V = append([]string{s.Name}, idx.V...)
+	}
+	return schemahcl.BuildRef([]schemahcl.PathIndex{idx})
+}
+
 // HCLBytesFunc returns a helper that evaluates an HCL document from a byte slice instead
 // of from an hclparse.Parser instance.
 func HCLBytesFunc(ev schemahcl.Evaluator) func(b []byte, v any, inp map[string]cty.Value) error {
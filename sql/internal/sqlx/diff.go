@@ -104,6 +104,13 @@ type (
 		AnnotateChanges([]schema.Change, *schema.DiffOptions) error
 	}
 
+	// TableChangeAnnotator is an optional interface allows DiffDriver to annotate
+	// the modification of a table with information that exists only in its current
+	// state. For example, the views that depend on the table in the database.
+	TableChangeAnnotator interface {
+		AnnotateTableChange(from *schema.Table, m *schema.ModifyTable)
+	}
+
 	// ProcFuncsDiffer is an optional interface allows DiffDriver to diff
 	// functions and procedures.
 	ProcFuncsDiffer interface {
@@ -225,7 +232,11 @@ func (d *Diff) schemaDiff(from, to *schema.Schema, opts *schema.DiffOptions) ([]
 			if change, err := d.tableDiff(t1, t2, opts); err != nil {
 				return nil, err
 			} else if len(change) > 0 {
-				changes = opts.AddOrSkip(changes, &schema.ModifyTable{T: t2, Changes: change})
+				m := &schema.ModifyTable{T: t2, Changes: change}
+				if a, ok := d.DiffDriver.(TableChangeAnnotator); ok {
+					a.AnnotateTableChange(t1, m)
+				}
+				changes = opts.AddOrSkip(changes, m)
 			}
 			if change, err := d.triggerDiff(t1, t2, t1.Triggers, t2.Triggers, opts); err != nil {
 				return nil, err
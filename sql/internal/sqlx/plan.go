@@ -160,10 +160,10 @@ func detachReferences(changes []schema.Change) []schema.Change {
 				}
 			}
 			if len(fks) > 0 {
-				deferred = append(deferred, &schema.ModifyTable{T: change.T, Changes: fks})
+				deferred = append(deferred, &schema.ModifyTable{T: change.T, Changes: fks, Extra: change.Extra})
 			}
 			if len(rest) > 0 {
-				planned = append(planned, &schema.ModifyTable{T: change.T, Changes: rest})
+				planned = append(planned, &schema.ModifyTable{T: change.T, Changes: rest, Extra: change.Extra})
 			}
 		default:
 			planned = append(planned, change)
@@ -17,10 +17,46 @@ func sortViewChanges(changes []schema.Change) ([]schema.Change, error) {
 	return changes, nil // unimplemented.
 }
 
-func (*Diff) triggerDiff(_, _ interface {
+// triggerDiff returns the changes for migrating the triggers of a table or a view.
+func (d *Diff) triggerDiff(from, to interface {
 	Trigger(string) (*schema.Trigger, bool)
-}, _, _ []*schema.Trigger, _ *schema.DiffOptions) ([]schema.Change, error) {
-	return nil, nil // unimplemented.
+}, fromT, toT []*schema.Trigger, opts *schema.DiffOptions) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify triggers.
+	for _, t1 := range fromT {
+		t2, ok := to.Trigger(t1.Name)
+		if !ok {
+			changes = opts.AddOrSkip(changes, &schema.DropTrigger{T: t1})
+			continue
+		}
+		td, ok := d.DiffDriver.(TriggerDiffer)
+		if !ok {
+			continue
+		}
+		change, err := td.TriggerDiff(t1, t2)
+		if err != nil {
+			return nil, err
+		}
+		changes = opts.AddOrSkip(changes, change...)
+	}
+	// Add triggers.
+	for _, t1 := range toT {
+		if _, ok := from.Trigger(t1.Name); !ok {
+			changes = opts.AddOrSkip(changes, &schema.AddTrigger{T: t1})
+		}
+	}
+	return changes, nil
+}
+
+// triggerOnSame reports if the two triggers are defined on the same table or view.
+func triggerOnSame(t1, t2 *schema.Trigger) bool {
+	switch {
+	case t1.Table != nil && t2.Table != nil:
+		return SameTable(t1.Table, t2.Table)
+	case t1.View != nil && t2.View != nil:
+		return SameView(t1.View, t2.View)
+	}
+	return false
 }
 
 // funcDep returns true if f1 depends on f2.
@@ -95,6 +131,14 @@ func dependsOn(c1, c2 schema.Change, _ SortOptions) bool {
 			}) {
 				return true
 			}
+		case *schema.ModifyObject:
+			// Columns might rely on the modified type (e.g., a new enum value).
+			t, ok := c2.To.(schema.Type)
+			if ok && slices.ContainsFunc(c1.T.Columns, func(c *schema.Column) bool {
+				return c.Type != nil && schema.IsType(c.Type.Type, t)
+			}) {
+				return true
+			}
 		}
 		return depOfAdd(c1.T.Deps, c2)
 	case *schema.DropTable:
@@ -173,8 +217,84 @@ func dependsOn(c1, c2 schema.Change, _ SortOptions) bool {
 			}) {
 				return true
 			}
+		case *schema.ModifyObject:
+			t, ok := c2.To.(schema.Type)
+			if ok && slices.ContainsFunc(c1.Changes, func(c schema.Change) bool {
+				switch c := c.(type) {
+				case *schema.AddColumn:
+					return c.C.Type != nil && schema.IsType(c.C.Type.Type, t)
+				case *schema.ModifyColumn:
+					return c.To.Type != nil && schema.IsType(c.To.Type.Type, t)
+				default:
+					return false
+				}
+			}) {
+				return true
+			}
+		}
+		// Views that depend on the table are dropped before it is modified.
+		if c2, ok := c2.(*schema.DropView); ok && slices.ContainsFunc(c2.V.Deps, func(o schema.Object) bool {
+			t, ok := o.(*schema.Table)
+			return ok && SameTable(c1.T, t)
+		}) {
+			return true
+		}
+		return depOfAdd(c1.T.Deps, c2)
+	case *schema.AddView:
+		switch c2 := c2.(type) {
+		case *schema.AddSchema:
+			return c1.V.Schema != nil && c1.V.Schema.Name == c2.S.Name
+		case *schema.DropView:
+			// View recreation.
+			return SameView(c1.V, c2.V)
+		}
+		return depOfAdd(c1.V.Deps, c2)
+	case *schema.ModifyView:
+		return depOfAdd(c1.To.Deps, c2)
+	case *schema.DropView:
+		return depOfDrop(c1.V, c2)
+	case *schema.AddFunc:
+		switch c2 := c2.(type) {
+		case *schema.AddSchema:
+			return c1.F.Schema != nil && c1.F.Schema.Name == c2.S.Name
+		case *schema.DropFunc:
+			// Function recreation.
+			return c1.F.Name == c2.F.Name && SameSchema(c1.F.Schema, c2.F.Schema)
+		}
+		return depOfAdd(c1.F.Deps, c2)
+	case *schema.ModifyFunc:
+		return depOfAdd(c1.To.Deps, c2)
+	case *schema.DropFunc:
+		return depOfDrop(c1.F, c2)
+	case *schema.AddProc:
+		switch c2 := c2.(type) {
+		case *schema.AddSchema:
+			return c1.P.Schema != nil && c1.P.Schema.Name == c2.S.Name
+		case *schema.DropProc:
+			// Procedure recreation.
+			return c1.P.Name == c2.P.Name && SameSchema(c1.P.Schema, c2.P.Schema)
+		}
+		return depOfAdd(c1.P.Deps, c2)
+	case *schema.ModifyProc:
+		return depOfAdd(c1.To.Deps, c2)
+	case *schema.DropProc:
+		return depOfDrop(c1.P, c2)
+	case *schema.AddTrigger:
+		switch c2 := c2.(type) {
+		case *schema.AddTable:
+			return c1.T.Table != nil && SameTable(c1.T.Table, c2.T)
+		case *schema.ModifyTable:
+			// Trigger might rely on columns added to the table.
+			return c1.T.Table != nil && SameTable(c1.T.Table, c2.T)
+		case *schema.AddView:
+			return c1.T.View != nil && SameView(c1.T.View, c2.V)
+		case *schema.DropTrigger:
+			// Trigger recreation.
+			return c1.T.Name == c2.T.Name && triggerOnSame(c1.T, c2.T)
 		}
 		return depOfAdd(c1.T.Deps, c2)
+	case *schema.ModifyTrigger:
+		return depOfAdd(c1.To.Deps, c2)
 	case *schema.DropObject:
 		t, ok := c1.O.(schema.Type)
 		if !ok {
@@ -196,8 +316,15 @@ func dependsOn(c1, c2 schema.Change, _ SortOptions) bool {
 			}
 		case *schema.ModifyTable:
 			return slices.ContainsFunc(c2.Changes, func(c schema.Change) bool {
-				d, ok := c.(*schema.DropColumn)
-				return ok && schema.IsType(d.C.Type.Type, t)
+				switch c := c.(type) {
+				case *schema.DropColumn:
+					return schema.IsType(c.C.Type.Type, t)
+				case *schema.ModifyColumn:
+					// Column type was changed from the dropped type.
+					return c.From.Type != nil && schema.IsType(c.From.Type.Type, t)
+				default:
+					return false
+				}
 			})
 		}
 	}
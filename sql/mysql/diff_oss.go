@@ -14,6 +14,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/veiloq/atlas/schemahcl"
 	"github.com/veiloq/atlas/sql/internal/sqlx"
 	"github.com/veiloq/atlas/sql/schema"
 )
@@ -71,8 +72,99 @@ func (*diff) RealmObjectDiff(_, _ *schema.Realm) ([]schema.Change, error) {
 }
 
 // SchemaObjectDiff returns a changeset for migrating schema objects from
-// one state to the other.
-func (*diff) SchemaObjectDiff(_, _ *schema.Schema, _ *schema.DiffOptions) ([]schema.Change, error) {
+// one state to the other. For example, scheduled events.
+func (*diff) SchemaObjectDiff(from, to *schema.Schema, _ *schema.DiffOptions) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify events.
+	for _, o1 := range from.Objects {
+		e1, ok := o1.(*Event)
+		if !ok {
+			continue // Unsupported object type.
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			e2, ok := o.(*Event)
+			return ok && strings.EqualFold(e1.Name, e2.Name)
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: e1})
+			continue
+		}
+		if e2 := o2.(*Event); eventChanged(e1, e2) || sqlx.CommentDiff(e1.Attrs, e2.Attrs) != nil {
+			changes = append(changes, &schema.ModifyObject{From: e1, To: e2})
+		}
+	}
+	// Add events.
+	for _, o1 := range to.Objects {
+		e1, ok := o1.(*Event)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			e2, ok := o.(*Event)
+			return ok && strings.EqualFold(e1.Name, e2.Name)
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: e1})
+		}
+	}
+	return changes, nil
+}
+
+// ProcFuncsDiff implements the sqlx.ProcFuncsDiffer interface and returns a changeset
+// for migrating functions and procedures from one schema state to the other. Stored
+// routines are matched by their names, as MySQL does not support overloading.
+func (d *diff) ProcFuncsDiff(from, to *schema.Schema, opts *schema.DiffOptions) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify functions.
+	for _, f1 := range from.Funcs {
+		f2, ok := to.Func(f1.Name)
+		if !ok {
+			changes = opts.AddOrSkip(changes, &schema.DropFunc{F: f1})
+			continue
+		}
+		changed, err := d.funcChanged(f1, f2)
+		if err != nil {
+			return nil, err
+		}
+		if change := d.routineAttrChanges(f1.Attrs, f2.Attrs); changed || len(change) > 0 {
+			changes = opts.AddOrSkip(changes, &schema.ModifyFunc{From: f1, To: f2, Changes: change})
+		}
+	}
+	// Add new functions.
+	for _, f1 := range to.Funcs {
+		if _, ok := from.Func(f1.Name); !ok {
+			changes = opts.AddOrSkip(changes, &schema.AddFunc{F: f1})
+		}
+	}
+	// Drop or modify procedures.
+	for _, p1 := range from.Procs {
+		p2, ok := to.Proc(p1.Name)
+		if !ok {
+			changes = opts.AddOrSkip(changes, &schema.DropProc{P: p1})
+			continue
+		}
+		changed, err := d.procChanged(p1, p2)
+		if err != nil {
+			return nil, err
+		}
+		if change := d.routineAttrChanges(p1.Attrs, p2.Attrs); changed || len(change) > 0 {
+			changes = opts.AddOrSkip(changes, &schema.ModifyProc{From: p1, To: p2, Changes: change})
+		}
+	}
+	// Add new procedures.
+	for _, p1 := range to.Procs {
+		if _, ok := from.Proc(p1.Name); !ok {
+			changes = opts.AddOrSkip(changes, &schema.AddProc{P: p1})
+		}
+	}
+	return changes, nil
+}
+
+// TriggerDiff implements the sqlx.TriggerDiffer interface and returns a changeset
+// for migrating triggers from one state to the other.
+func (*diff) TriggerDiff(from, to *schema.Trigger) ([]schema.Change, error) {
+	if triggerChanged(from, to) {
+		return []schema.Change{&schema.ModifyTrigger{From: from, To: to}}, nil
+	}
 	return nil, nil
 }
 
@@ -97,6 +189,9 @@ func (d *diff) TableAttrDiff(from, to *schema.Table, opts *schema.DiffOptions) (
 	if change := d.systemVerChange(from.Attrs, to.Attrs); change != noChange {
 		changes = append(changes, change)
 	}
+	if change := d.partitionChange(from.Attrs, to.Attrs); change != noChange {
+		changes = append(changes, change)
+	}
 	if !d.SupportsCheck() && sqlx.Has(to.Attrs, &schema.Check{}) {
 		return nil, fmt.Errorf("version %q does not support CHECK constraints", d.V)
 	}
@@ -235,6 +330,41 @@ func (*diff) ForeignKeyAttrChanged(_, _ []schema.Attr) bool {
 	return false
 }
 
+// DiffOptions defines MySQL specific schema diffing process.
+type DiffOptions struct {
+	// Algorithm configures the classification of table modifications
+	// by their ALTER TABLE algorithm (INSTANT, INPLACE or COPY).
+	Algorithm *struct {
+		Emit  bool `spec:"emit"`
+		Split bool `spec:"split"`
+	} `spec:"algorithm"`
+}
+
+// AnnotateChanges implements the sqlx.ChangeAnnotator interface.
+func (d *diff) AnnotateChanges(changes []schema.Change, opts *schema.DiffOptions) error {
+	var extra DiffOptions
+	switch ex := opts.Extra.(type) {
+	case nil:
+		return nil
+	case schemahcl.DefaultExtension:
+		if err := ex.Extra.As(&extra); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("mysql: unexpected DiffOptions.Extra type %T", opts.Extra)
+	}
+	// TiDB does not support the ALGORITHM clause.
+	if extra.Algorithm == nil || d.TiDB() {
+		return nil
+	}
+	for _, c := range changes {
+		if m, ok := c.(*schema.ModifyTable); ok {
+			m.Extra = append(m.Extra, &AlterAlgorithm{Emit: extra.Algorithm.Emit, Split: extra.Algorithm.Split})
+		}
+	}
+	return nil
+}
+
 // Normalize implements the sqlx.Normalizer interface.
 func (d *diff) Normalize(from, to *schema.Table, opts *schema.DiffOptions) error {
 	if opts.Mode.Is(schema.DiffModeNormalized) {
@@ -376,6 +506,98 @@ func (d *diff) systemVerChange(from, to []schema.Attr) schema.Change {
 	}
 }
 
+// partitionChange returns the schema change for migrating the table
+// partitioning if it was changed.
+func (*diff) partitionChange(from, to []schema.Attr) schema.Change {
+	var fromP, toP Partition
+	switch fromHas, toHas := sqlx.Has(from, &fromP), sqlx.Has(to, &toP); {
+	case fromHas && !toHas:
+		return &schema.DropAttr{A: &fromP}
+	case !fromHas && toHas:
+		return &schema.AddAttr{A: &toP}
+	case fromHas && toHas && partitionChanged(&fromP, &toP):
+		return &schema.ModifyAttr{From: &fromP, To: &toP}
+	default:
+		return noChange
+	}
+}
+
+// partitionChanged reports if the partitioning scheme was changed.
+func partitionChanged(from, to *Partition) bool {
+	if partitionKeyChanged(from, to) || partitionCount(from.T, from.Count) != partitionCount(to.T, to.Count) || len(from.Defs) != len(to.Defs) {
+		return true
+	}
+	for i := range from.Defs {
+		if partitionDefChanged(from.Defs[i], to.Defs[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionKeyChanged reports if the partitioning type, its key
+// or its subpartitioning were changed. Such changes require
+// repartitioning the table.
+func partitionKeyChanged(from, to *Partition) bool {
+	switch fs, ts := from.Sub, to.Sub; {
+	case !strings.EqualFold(from.T, to.T) || from.Linear != to.Linear || partitionPartsChanged(from.Parts, to.Parts):
+		return true
+	case fs == nil || ts == nil:
+		return fs != ts
+	default:
+		return !strings.EqualFold(fs.T, ts.T) || fs.Linear != ts.Linear || partitionPartsChanged(fs.Parts, ts.Parts) ||
+			partitionCount(fs.T, fs.Count) != partitionCount(ts.T, ts.Count)
+	}
+}
+
+// partitionPartsChanged reports if the partitioning key parts were changed.
+func partitionPartsChanged(from, to []*PartitionPart) bool {
+	if len(from) != len(to) {
+		return true
+	}
+	for i := range from {
+		if partitionPart(from[i]) != partitionPart(to[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionDefChanged reports if the RANGE or LIST partition definition was changed.
+func partitionDefChanged(from, to *PartitionDef) bool {
+	var fromC, toC schema.Comment
+	sqlx.Has(from.Attrs, &fromC)
+	sqlx.Has(to.Attrs, &toC)
+	return from.Name != to.Name || fromC.Text != toC.Text ||
+		strings.Join(strings.Fields(from.Values), "") != strings.Join(strings.Fields(to.Values), "")
+}
+
+// partitionPart returns the normalized representation of a partitioning
+// key part, as MySQL lowercases and quotes the identifiers in expressions.
+func partitionPart(p *PartitionPart) string {
+	switch {
+	case p.C != nil:
+		return p.C.Name
+	case p.X != nil:
+		x, _ := p.X.(*schema.RawExpr)
+		if x == nil {
+			return ""
+		}
+		return strings.ToLower(strings.Join(strings.Fields(strings.ReplaceAll(x.X, "`", "")), ""))
+	default:
+		return ""
+	}
+}
+
+// partitionCount returns the number of HASH or KEY partitions,
+// which defaults to 1 if it was not set explicitly.
+func partitionCount(t string, n int) int {
+	if (t == PartitionTypeHash || t == PartitionTypeKey) && n < 1 {
+		return 1
+	}
+	return n
+}
+
 // charsetChange returns the schema change for migrating the collation if
 // it was changed, and it is not the default attribute inherited from its parent.
 func (*diff) charsetChange(from, top, to []schema.Attr) schema.Change {
@@ -735,6 +957,192 @@ func (d *diff) defaultCharset(attrs *[]schema.Attr) error {
 	return nil
 }
 
-func (*diff) ViewAttrChanges(_, _ *schema.View) []schema.Change {
-	return nil // Not implemented.
+// ViewAttrChanges returns the changes between the two view attributes.
+func (*diff) ViewAttrChanges(from, to *schema.View) []schema.Change {
+	var changes []schema.Change
+	if c1, c2 := viewCheckOption(from), viewCheckOption(to); c1.V != c2.V {
+		changes = append(changes, &schema.ModifyAttr{From: c1, To: c2})
+	}
+	if a1, a2 := viewAlgorithm(from), viewAlgorithm(to); a1.V != a2.V {
+		changes = append(changes, &schema.ModifyAttr{From: a1, To: a2})
+	}
+	if s1, s2 := viewSecurity(from), viewSecurity(to); s1.V != s2.V {
+		changes = append(changes, &schema.ModifyAttr{From: s1, To: s2})
+	}
+	// The definer is compared only if it was set explicitly
+	// on the desired view, as it defaults to the current user.
+	var d1, d2 Definer
+	if sqlx.Has(to.Attrs, &d2) && (!sqlx.Has(from.Attrs, &d1) || !strings.EqualFold(d1.V, d2.V)) {
+		changes = append(changes, &schema.ModifyAttr{From: &d1, To: &d2})
+	}
+	return changes
+}
+
+// viewCheckOption returns the check option of the view, or NONE if it was not set.
+func viewCheckOption(v *schema.View) *schema.ViewCheckOption {
+	c := &schema.ViewCheckOption{V: schema.ViewCheckOptionNone}
+	if sqlx.Has(v.Attrs, c) && c.V == "" {
+		c.V = schema.ViewCheckOptionNone
+	}
+	c.V = strings.ToUpper(c.V)
+	return c
+}
+
+// viewAlgorithm returns the algorithm of the view, or UNDEFINED if it was not set.
+func viewAlgorithm(v *schema.View) *ViewAlgorithm {
+	a := &ViewAlgorithm{V: ViewAlgorithmUndefined}
+	if sqlx.Has(v.Attrs, a) && a.V == "" {
+		a.V = ViewAlgorithmUndefined
+	}
+	a.V = strings.ToUpper(a.V)
+	return a
+}
+
+// viewSecurity returns the security characteristic of the view, or DEFINER if it was not set.
+func viewSecurity(v *schema.View) *ViewSecurity {
+	s := &ViewSecurity{V: ViewSecurityDefiner}
+	if sqlx.Has(v.Attrs, s) && s.V == "" {
+		s.V = ViewSecurityDefiner
+	}
+	s.V = strings.ToUpper(s.V)
+	return s
+}
+
+// funcChanged reports if the definition of the function was changed.
+// Changes to its comment or security characteristic are reported by
+// routineAttrChanges, as they can be applied using ALTER FUNCTION.
+func (d *diff) funcChanged(from, to *schema.Func) (bool, error) {
+	if from.Ret == nil || to.Ret == nil {
+		if from.Ret != to.Ret {
+			return true, nil
+		}
+	} else if changed, err := d.typeChanged(&schema.Column{Name: from.Name, Type: &schema.ColumnType{Type: from.Ret}}, &schema.Column{Type: &schema.ColumnType{Type: to.Ret}}); err != nil || changed {
+		return changed, err
+	}
+	return d.routineChanged(from.Args, to.Args, from.Body, to.Body, from.Attrs, to.Attrs)
+}
+
+// procChanged reports if the definition of the procedure was changed.
+func (d *diff) procChanged(from, to *schema.Proc) (bool, error) {
+	return d.routineChanged(from.Args, to.Args, from.Body, to.Body, from.Attrs, to.Attrs)
+}
+
+// routineChanged reports if the arguments, the body or the characteristics
+// that cannot be altered (e.g., DETERMINISTIC) of a routine were changed.
+func (d *diff) routineChanged(fromA, toA []*schema.FuncArg, fromB, toB string, fromX, toX []schema.Attr) (bool, error) {
+	if len(fromA) != len(toA) {
+		return true, nil
+	}
+	for i := range fromA {
+		a1, a2 := fromA[i], toA[i]
+		if !strings.EqualFold(a1.Name, a2.Name) || funcArgMode(a1) != funcArgMode(a2) {
+			return true, nil
+		}
+		if a1.Type == nil || a2.Type == nil {
+			return false, fmt.Errorf("mysql: missing type information for argument %q", a1.Name)
+		}
+		if changed, err := d.typeChanged(&schema.Column{Name: a1.Name, Type: &schema.ColumnType{Type: a1.Type}}, &schema.Column{Type: &schema.ColumnType{Type: a2.Type}}); err != nil || changed {
+			return changed, err
+		}
+	}
+	return sqlx.BodyDefChanged(programBody(fromB), programBody(toB)) ||
+		sqlx.Has(fromX, &Deterministic{}) != sqlx.Has(toX, &Deterministic{}) ||
+		definerChanged(fromX, toX), nil
+}
+
+// routineAttrChanges returns the changes of the routine attributes
+// that can be applied using the ALTER FUNCTION/PROCEDURE statements.
+func (*diff) routineAttrChanges(from, to []schema.Attr) []schema.Change {
+	var changes []schema.Change
+	if s1, s2 := funcSecurity(from), funcSecurity(to); s1.V != s2.V {
+		changes = append(changes, &schema.ModifyAttr{From: s1, To: s2})
+	}
+	if change := sqlx.CommentDiff(from, to); change != nil {
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// triggerChanged reports if the definition of the trigger was changed.
+func triggerChanged(from, to *schema.Trigger) bool {
+	if !strings.EqualFold(string(from.ActionTime), string(to.ActionTime)) || len(from.Events) != len(to.Events) {
+		return true
+	}
+	for i := range from.Events {
+		if !strings.EqualFold(from.Events[i].Name, to.Events[i].Name) {
+			return true
+		}
+	}
+	return sqlx.BodyDefChanged(programBody(from.Body), programBody(to.Body)) || definerChanged(from.Attrs, to.Attrs)
+}
+
+// eventChanged reports if the definition of the event was changed.
+func eventChanged(from, to *Event) bool {
+	switch {
+	case !strings.EqualFold(strings.Join(strings.Fields(from.Every), " "), strings.Join(strings.Fields(to.Every), " ")),
+		eventExprChanged(from.At, to.At), eventExprChanged(from.Ends, to.Ends),
+		// The start time of recurring events defaults to their creation
+		// time. Hence, it is compared only if it was set explicitly.
+		to.Starts != nil && eventExprChanged(from.Starts, to.Starts),
+		from.Preserve != to.Preserve, eventStatus(from) != eventStatus(to):
+		return true
+	}
+	return sqlx.BodyDefChanged(programBody(from.Body), programBody(to.Body)) || definerChanged(from.Attrs, to.Attrs)
+}
+
+// eventExprChanged reports if the schedule expression of an event was changed.
+// Expressions that are evaluated by the database (e.g., CURRENT_TIMESTAMP + INTERVAL
+// 1 DAY) are stored as timestamps, and therefore, they are not compared to literals.
+func eventExprChanged(from, to schema.Expr) bool {
+	if from == nil || to == nil {
+		return from != to
+	}
+	switch x1 := from.(type) {
+	case *schema.Literal:
+		x2, ok := to.(*schema.Literal)
+		return ok && x1.V != x2.V
+	case *schema.RawExpr:
+		x2, ok := to.(*schema.RawExpr)
+		return ok && !strings.EqualFold(x1.X, x2.X)
+	}
+	return false
+}
+
+// eventStatus returns the status of the event, or ENABLE if it was not set.
+func eventStatus(e *Event) string {
+	if e.Status == "" {
+		return EventStatusEnable
+	}
+	return strings.ToUpper(e.Status)
+}
+
+// definerChanged reports if the definer of a view or a stored program was changed. The
+// definer is compared only if it was set explicitly, as it defaults to the current user.
+func definerChanged(from, to []schema.Attr) bool {
+	var d1, d2 Definer
+	return sqlx.Has(to, &d2) && (!sqlx.Has(from, &d1) || !strings.EqualFold(d1.V, d2.V))
+}
+
+// funcSecurity returns the security characteristic of the routine, or DEFINER if it was not set.
+func funcSecurity(attrs []schema.Attr) *FuncSecurity {
+	s := &FuncSecurity{}
+	if !sqlx.Has(attrs, s) || s.V == "" {
+		s.V = FuncSecurityDefiner
+	}
+	s.V = strings.ToUpper(s.V)
+	return s
+}
+
+// funcArgMode returns the mode of the argument, or IN if it was not set.
+func funcArgMode(a *schema.FuncArg) schema.FuncArgMode {
+	if a.Mode == "" {
+		return schema.FuncArgModeIn
+	}
+	return schema.FuncArgMode(strings.ToUpper(string(a.Mode)))
+}
+
+// programBody returns the body of a stored program without
+// its surrounding spaces and its terminating semicolon.
+func programBody(body string) string {
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(body), ";"))
 }
@@ -7,8 +7,10 @@
 package mysql
 
 import (
+	"context"
 	"testing"
 
+	"github.com/veiloq/atlas/schemahcl"
 	"github.com/veiloq/atlas/sql/schema"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -596,6 +598,43 @@ func TestDiff_TableDiff(t *testing.T) {
 				},
 			}
 		}(),
+		// Partitioning expressions are compared in their normalized form.
+		{
+			name: "no partition changes",
+			from: &schema.Table{Name: "logs", Schema: &schema.Schema{Name: "public"}, Attrs: []schema.Attr{
+				&Partition{T: PartitionTypeRange, Parts: []*PartitionPart{{X: &schema.RawExpr{X: "year(`created`)"}}}, Defs: []*PartitionDef{{Name: "p0", Values: "1,2"}}},
+			}},
+			to: &schema.Table{Name: "logs", Schema: &schema.Schema{Name: "public"}, Attrs: []schema.Attr{
+				&Partition{T: PartitionTypeRange, Parts: []*PartitionPart{{X: &schema.RawExpr{X: "YEAR(created)"}}}, Defs: []*PartitionDef{{Name: "p0", Values: "1, 2"}}},
+			}},
+		},
+		{
+			name: "modify partitions",
+			from: &schema.Table{Name: "logs", Schema: &schema.Schema{Name: "public"}, Attrs: []schema.Attr{
+				&Partition{T: PartitionTypeKey, Count: 2},
+			}},
+			to: &schema.Table{Name: "logs", Schema: &schema.Schema{Name: "public"}, Attrs: []schema.Attr{
+				&Partition{T: PartitionTypeKey, Count: 4},
+			}},
+			wantChanges: []schema.Change{
+				&schema.ModifyAttr{
+					From: &Partition{T: PartitionTypeKey, Count: 2},
+					To:   &Partition{T: PartitionTypeKey, Count: 4},
+				},
+			},
+		},
+		{
+			name: "drop partitioning",
+			from: &schema.Table{Name: "logs", Schema: &schema.Schema{Name: "public"}, Attrs: []schema.Attr{
+				&Partition{T: PartitionTypeHash, Parts: []*PartitionPart{{X: &schema.RawExpr{X: "id"}}}},
+			}},
+			to: &schema.Table{Name: "logs", Schema: &schema.Schema{Name: "public"}},
+			wantChanges: []schema.Change{
+				&schema.DropAttr{
+					A: &Partition{T: PartitionTypeHash, Parts: []*PartitionPart{{X: &schema.RawExpr{X: "id"}}}},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		db, m, err := sqlmock.New()
@@ -677,6 +716,107 @@ func TestDiff_SchemaDiff(t *testing.T) {
 	}, changes)
 }
 
+func TestDiff_ViewDiff(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mock{m}.version("8.0.31")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	from := schema.New("test").AddViews(
+		schema.NewView("v1", "select 1"),
+		schema.NewView("v2", "select 2").AddAttrs(&Definer{V: "root@%"}),
+		schema.NewView("v3", "select 3").AddAttrs(&ViewAlgorithm{V: ViewAlgorithmMerge}),
+		schema.NewView("v4", "select 4"),
+	)
+	to := schema.New("test").AddViews(
+		// Definers are compared only if they are set on the desired state.
+		schema.NewView("v1", "select 1"),
+		schema.NewView("v2", "select 2"),
+		schema.NewView("v3", "select 3").AddAttrs(&ViewSecurity{V: ViewSecurityInvoker}).SetCheckOption(schema.ViewCheckOptionCascaded),
+		schema.NewView("v4", "select 4").AddAttrs(&Definer{V: "admin@%"}),
+	)
+	changes, err := drv.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.Equal(t, []schema.Change{
+		&schema.ModifyView{
+			From: from.Views[2],
+			To:   to.Views[2],
+			Changes: []schema.Change{
+				&schema.ModifyAttr{From: &schema.ViewCheckOption{V: schema.ViewCheckOptionNone}, To: &schema.ViewCheckOption{V: schema.ViewCheckOptionCascaded}},
+				&schema.ModifyAttr{From: &ViewAlgorithm{V: ViewAlgorithmMerge}, To: &ViewAlgorithm{V: ViewAlgorithmUndefined}},
+				&schema.ModifyAttr{From: &ViewSecurity{V: ViewSecurityDefiner}, To: &ViewSecurity{V: ViewSecurityInvoker}},
+			},
+		},
+		&schema.ModifyView{
+			From: from.Views[3],
+			To:   to.Views[3],
+			Changes: []schema.Change{
+				&schema.ModifyAttr{From: &Definer{}, To: &Definer{V: "admin@%"}},
+			},
+		},
+	}, changes)
+}
+
+func TestDiff_ProgramsDiff(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mock{m}.version("8.0.31")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	var (
+		intT  = &schema.IntegerType{T: TypeInt}
+		users = func(at schema.TriggerTime, body string) *schema.Table {
+			t := schema.NewTable("users").AddColumns(schema.NewIntColumn("id", TypeInt))
+			t.Triggers = []*schema.Trigger{{Name: "t1", Table: t, ActionTime: at, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, For: schema.TriggerForRow, Body: body}}
+			return t
+		}
+		from = schema.New("test").
+			AddTables(users(schema.TriggerTimeBefore, "SET NEW.id = 1")).
+			AddFuncs(
+				&schema.Func{Name: "f1", Ret: intT, Body: "RETURN 1", Attrs: []schema.Attr{&Definer{V: "root@%"}}},
+				&schema.Func{Name: "f2", Ret: intT, Body: "RETURN 2"},
+			).
+			AddProcs(
+				&schema.Proc{Name: "p1", Args: []*schema.FuncArg{{Name: "a", Type: intT}}, Body: "SELECT a"},
+			).
+			AddObjects(
+				&Event{Name: "e1", Every: "1 DAY", Starts: &schema.Literal{V: "2024-01-01 00:00:00"}, Body: "DELETE FROM users"},
+			)
+		to = schema.New("test").
+			AddTables(users(schema.TriggerTimeAfter, "SET @n = 1;")).
+			AddFuncs(
+				// Definers are compared only if they are set on the desired state.
+				&schema.Func{Name: "f1", Ret: intT, Body: "RETURN 1;", Attrs: []schema.Attr{&FuncSecurity{V: FuncSecurityInvoker}}},
+				&schema.Func{Name: "f3", Ret: intT, Body: "RETURN 3"},
+			).
+			AddProcs(
+				&schema.Proc{Name: "p1", Args: []*schema.FuncArg{{Name: "a", Type: intT, Mode: schema.FuncArgModeInOut}}, Body: "SELECT a"},
+			).
+			AddObjects(
+				// The start time is compared only if it is set on the desired state.
+				&Event{Name: "e1", Every: "1 day", Body: "DELETE FROM users", Status: EventStatusDisable},
+				&Event{Name: "e2", At: &schema.RawExpr{X: "CURRENT_TIMESTAMP + INTERVAL 1 HOUR"}, Body: "DELETE FROM users"},
+			)
+	)
+	changes, err := drv.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.Equal(t, []schema.Change{
+		&schema.ModifyObject{From: from.Objects[0], To: to.Objects[0]},
+		&schema.AddObject{O: to.Objects[1]},
+		&schema.ModifyTrigger{From: from.Tables[0].Triggers[0], To: to.Tables[0].Triggers[0]},
+		&schema.ModifyFunc{
+			From: from.Funcs[0],
+			To:   to.Funcs[0],
+			Changes: []schema.Change{
+				&schema.ModifyAttr{From: &FuncSecurity{V: FuncSecurityDefiner}, To: &FuncSecurity{V: FuncSecurityInvoker}},
+			},
+		},
+		&schema.DropFunc{F: from.Funcs[1]},
+		&schema.AddFunc{F: to.Funcs[1]},
+		&schema.ModifyProc{From: from.Procs[0], To: to.Procs[0]},
+	}, changes)
+}
+
 func TestDiff_LowerCaseMode(t *testing.T) {
 	db, m, err := sqlmock.New()
 	require.NoError(t, err)
@@ -839,6 +979,47 @@ func TestDefaultDiff(t *testing.T) {
 	require.Empty(t, changes)
 }
 
+func TestDiff_AnnotateChanges(t *testing.T) {
+	diff := func(hcl string) []schema.Change {
+		var cfg struct {
+			schemahcl.DefaultExtension
+		}
+		require.NoError(t, schemahcl.New().EvalBytes([]byte(hcl), &cfg, nil))
+		from := schema.New("public").AddTables(
+			schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "int")),
+		)
+		to := schema.New("public").AddTables(
+			schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "bigint"), schema.NewNullIntColumn("a", "int")),
+		)
+		changes, err := DefaultDiff.SchemaDiff(from, to, func(opts *schema.DiffOptions) { opts.Extra = cfg.DefaultExtension })
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		return changes
+	}
+
+	// language=hcl
+	plan, err := DefaultPlan.PlanChanges(context.Background(), "changes", diff(`
+algorithm {}
+`))
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 1)
+	require.Equal(t, "ALTER TABLE `public`.`users` MODIFY COLUMN `id` bigint NOT NULL, ADD COLUMN `a` int NULL", plan.Changes[0].Cmd)
+	require.Equal(t, `modify "users" table (ALGORITHM=COPY)`, plan.Changes[0].Comment)
+
+	// language=hcl
+	plan, err = DefaultPlan.PlanChanges(context.Background(), "changes", diff(`
+algorithm {
+  emit  = true
+  split = true
+}
+`))
+	require.NoError(t, err)
+	require.Len(t, plan.Changes, 2)
+	require.Equal(t, "ALTER TABLE `public`.`users` ADD COLUMN `a` int NULL, ALGORITHM=INSTANT", plan.Changes[0].Cmd)
+	require.Equal(t, "ALTER TABLE `public`.`users` MODIFY COLUMN `id` bigint NOT NULL, ALGORITHM=COPY", plan.Changes[1].Cmd)
+	require.Equal(t, `modify "users" table`, plan.Changes[1].Comment)
+}
+
 func TestSkipChanges(t *testing.T) {
 	t.Run("DropSchema", func(t *testing.T) {
 		from, to := schema.NewRealm(schema.New("public")), schema.NewRealm()
@@ -19,7 +19,6 @@ import (
 	"github.com/veiloq/atlas/sql/mysql/internal/mysqlversion"
 	"github.com/veiloq/atlas/sql/schema"
 	"github.com/veiloq/atlas/sql/sqlclient"
-	"github.com/veiloq/atlas/sql/sqlspec"
 )
 
 type (
@@ -260,6 +259,14 @@ func (d *Driver) Version() string {
 	return string(d.conn.V)
 }
 
+// Algorithm returns the strongest ALTER TABLE algorithm (INSTANT, INPLACE or COPY)
+// the connected database can use for applying the table modification in a single
+// statement. An empty string is returned if the database does not support the
+// ALGORITHM clause (e.g., TiDB).
+func (d *Driver) Algorithm(m *schema.ModifyTable) string {
+	return d.conn.algorithm(m.T, m.Changes)
+}
+
 // FormatType converts schema type to its column form in the database.
 func (*Driver) FormatType(t schema.Type) (string, error) {
 	return FormatType(t)
@@ -473,10 +480,38 @@ const (
 	EngineCSV    = "CSV"
 	EngineNDB    = "NDB" // NDBCLUSTER
 
+	ViewAlgorithmUndefined = "UNDEFINED"
+	ViewAlgorithmMerge     = "MERGE"
+	ViewAlgorithmTempTable = "TEMPTABLE"
+
+	ViewSecurityDefiner = "DEFINER"
+	ViewSecurityInvoker = "INVOKER"
+
+	FuncSecurityDefiner = "DEFINER"
+	FuncSecurityInvoker = "INVOKER"
+
+	EventStatusEnable         = "ENABLE"
+	EventStatusDisable        = "DISABLE"
+	EventStatusDisableOnSlave = "DISABLE ON SLAVE"
+
+	PartitionTypeRange        = "RANGE"
+	PartitionTypeRangeColumns = "RANGE COLUMNS"
+	PartitionTypeList         = "LIST"
+	PartitionTypeListColumns  = "LIST COLUMNS"
+	PartitionTypeHash         = "HASH"
+	PartitionTypeKey          = "KEY"
+
+	AlgorithmInstant = "INSTANT"
+	AlgorithmInplace = "INPLACE"
+	AlgorithmCopy    = "COPY"
+
 	currentTS     = "current_timestamp"
 	defaultGen    = "default_generated"
 	autoIncrement = "auto_increment"
 
+	// Delimiter used by plans that contain compound statements.
+	programDelimiter = "//"
+
 	virtual    = "VIRTUAL"
 	stored     = "STORED"
 	persistent = "PERSISTENT"
@@ -490,14 +525,6 @@ func (*inspect) tablesQueryArgs(context.Context) string {
 	return tablesQueryArgs
 }
 
-func viewSpec(*schema.View) (*sqlspec.View, error) {
-	return nil, nil // unimplemented.
-}
-
-func convertView(*sqlspec.View, *schema.Schema) (*schema.View, error) {
-	return nil, nil // unimplemented.
-}
-
 func verifyChanges(context.Context, []schema.Change) error {
 	return nil // unimplemented.
 }
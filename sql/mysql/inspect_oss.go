@@ -11,9 +11,12 @@ import (
 	"database/sql"
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/veiloq/atlas/schemahcl"
+	"github.com/veiloq/atlas/sql/internal/specutil"
 	"github.com/veiloq/atlas/sql/internal/sqlx"
 	"github.com/veiloq/atlas/sql/schema"
 )
@@ -43,6 +46,14 @@ func (i *inspect) InspectRealm(ctx context.Context, opts *schema.InspectRealmOpt
 			}
 			sqlx.LinkSchemaTables(schemas)
 		}
+		if mode.Is(schema.InspectViews) {
+			if err := i.inspectViews(ctx, r, nil); err != nil {
+				return nil, err
+			}
+		}
+		if err := i.inspectPrograms(ctx, r, mode); err != nil {
+			return nil, err
+		}
 	}
 	return schema.ExcludeRealm(r, opts.Exclude)
 }
@@ -73,9 +84,100 @@ func (i *inspect) InspectSchema(ctx context.Context, name string, opts *schema.I
 		}
 		sqlx.LinkSchemaTables(schemas)
 	}
+	if mode.Is(schema.InspectViews) {
+		if err := i.inspectViews(ctx, r, opts); err != nil {
+			return nil, err
+		}
+		// View definitions are qualified with their schema name. Since the inspected
+		// schema is also the default database of its views, the qualifier is trimmed
+		// to make the definitions comparable with schemas that have different names
+		// (e.g., a dev database).
+		for _, v := range r.Schemas[0].Views {
+			v.Def = trimQualifier(v.Def, r.Schemas[0].Name)
+		}
+	}
+	if err := i.inspectPrograms(ctx, r, mode); err != nil {
+		return nil, err
+	}
 	return schema.ExcludeSchema(r.Schemas[0], opts.Exclude)
 }
 
+// trimQualifier removes the given schema qualifier from the identifiers
+// of a view definition. String literals, comments, and identifiers that
+// are not in the schema position (e.g., a table or a column with the same
+// name) are kept as is.
+func trimQualifier(def, name string) string {
+	var (
+		b      strings.Builder
+		quoted = "`" + strings.ReplaceAll(name, "`", "``") + "`"
+		// Qualified identifiers follow a dot,
+		// and are not considered schema names.
+		qualified bool
+	)
+	for i := 0; i < len(def); {
+		switch c := def[i]; {
+		case c == '\'', c == '"':
+			j := i + 1
+			for ; j < len(def); j++ {
+				if def[j] == '\\' {
+					j++
+				} else if def[j] == c {
+					// Quotes are escaped by doubling them.
+					if j+1 < len(def) && def[j+1] == c {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			j = min(j+1, len(def))
+			b.WriteString(def[i:j])
+			i, qualified = j, false
+		case c == '`':
+			j := i + 1
+			for ; j < len(def); j++ {
+				if def[j] == '`' {
+					if j+1 < len(def) && def[j+1] == '`' {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			j = min(j+1, len(def))
+			if !qualified && def[i:j] == quoted && j < len(def) && def[j] == '.' {
+				// Skip the qualifier and its dot.
+				i, qualified = j+1, true
+				continue
+			}
+			b.WriteString(def[i:j])
+			i, qualified = j, false
+		case strings.HasPrefix(def[i:], "/*"):
+			j := strings.Index(def[i+2:], "*/")
+			if j == -1 {
+				j = len(def)
+			} else {
+				j += i + 4
+			}
+			b.WriteString(def[i:j])
+			i = j
+		case strings.HasPrefix(def[i:], "-- "), c == '#':
+			j := strings.IndexByte(def[i:], '\n')
+			if j == -1 {
+				j = len(def)
+			} else {
+				j += i
+			}
+			b.WriteString(def[i:j])
+			i = j
+		default:
+			b.WriteByte(c)
+			i, qualified = i+1, c == '.'
+		}
+	}
+	return b.String()
+}
+
 func (i *inspect) inspectTables(ctx context.Context, r *schema.Realm, opts *schema.InspectOptions) error {
 	if err := i.tables(ctx, r, opts); err != nil {
 		return err
@@ -99,10 +201,439 @@ func (i *inspect) inspectTables(ctx context.Context, r *schema.Realm, opts *sche
 		if err := i.showCreate(ctx, s); err != nil {
 			return err
 		}
+		if err := i.partitions(ctx, s); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// inspectViews queries and appends the views of the given realm, including their
+// columns and the tables and views they depend on.
+func (i *inspect) inspectViews(ctx context.Context, r *schema.Realm, opts *schema.InspectOptions) error {
+	var (
+		args      []any
+		algorithm = "NULL"
+	)
+	// Unlike MariaDB, MySQL does not expose the view
+	// algorithm in the INFORMATION_SCHEMA.VIEWS table.
+	if i.Maria() {
+		algorithm = "`ALGORITHM`"
+	}
+	query := fmt.Sprintf(viewsQuery, algorithm, nArgs(len(r.Schemas)))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	if opts != nil && len(opts.Tables) > 0 {
+		for _, t := range opts.Tables {
+			args = append(args, t)
+		}
+		query = fmt.Sprintf(viewsQueryArgs, algorithm, nArgs(len(r.Schemas)), nArgs(len(opts.Tables)))
+	}
+	rows, err := i.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("mysql: querying views: %w", err)
+	}
+	if err := i.addViews(r, rows); err != nil {
+		return err
+	}
+	for _, s := range r.Schemas {
+		if len(s.Views) == 0 {
+			continue
+		}
+		if err := i.viewColumns(ctx, s); err != nil {
+			return err
+		}
+		if !i.Maria() {
+			if err := i.viewAlgorithms(ctx, s); err != nil {
+				return err
+			}
+		}
+	}
+	return i.viewDeps(ctx, r)
+}
+
+// addViews scans the rows and adds the views to their schemas.
+func (i *inspect) addViews(r *schema.Realm, rows *sql.Rows) error {
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			defaultDefiner                                             sql.NullBool
+			vSchema, name, def, checkOpt, security, definer, algorithm sql.NullString
+		)
+		if err := rows.Scan(&vSchema, &name, &def, &checkOpt, &security, &definer, &defaultDefiner, &algorithm); err != nil {
+			return fmt.Errorf("mysql: scan view information: %w", err)
+		}
+		if !sqlx.ValidString(vSchema) || !sqlx.ValidString(name) {
+			return fmt.Errorf("mysql: invalid schema or view name: %q.%q", vSchema.String, name.String)
+		}
+		s, ok := r.Schema(vSchema.String)
+		if !ok {
+			return fmt.Errorf("mysql: schema %q for view %q was not found in realm", vSchema.String, name.String)
+		}
+		v := schema.NewView(name.String, sqlx.TrimViewExtra(def.String))
+		s.AddViews(v)
+		if sqlx.ValidString(checkOpt) && checkOpt.String != schema.ViewCheckOptionNone {
+			v.SetCheckOption(checkOpt.String)
+		}
+		if sqlx.ValidString(algorithm) && !strings.EqualFold(algorithm.String, ViewAlgorithmUndefined) {
+			v.AddAttrs(&ViewAlgorithm{V: strings.ToUpper(algorithm.String)})
+		}
+		if sqlx.ValidString(security) && !strings.EqualFold(security.String, ViewSecurityDefiner) {
+			v.AddAttrs(&ViewSecurity{V: strings.ToUpper(security.String)})
+		}
+		// The definer is recorded only if it is not the current user,
+		// as views are created by default with the current user.
+		if sqlx.ValidString(definer) && !defaultDefiner.Bool {
+			v.AddAttrs(&Definer{V: definer.String})
+		}
+	}
+	return rows.Close()
+}
+
+// viewColumns queries and appends the columns of the views in the given schema.
+func (i *inspect) viewColumns(ctx context.Context, s *schema.Schema) error {
+	args := []any{s.Name}
+	for _, v := range s.Views {
+		args = append(args, v.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(viewColumnsQuery, nArgs(len(s.Views))), args...)
+	if err != nil {
+		return fmt.Errorf("mysql: query schema %q view columns: %w", s.Name, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var view, name, typ, nullable sql.NullString
+		if err := rows.Scan(&view, &name, &typ, &nullable); err != nil {
+			return fmt.Errorf("mysql: scan view column: %w", err)
+		}
+		v, ok := s.View(view.String)
+		if !ok {
+			return fmt.Errorf("mysql: view %q was not found in schema %q", view.String, s.Name)
+		}
+		t, err := ParseType(typ.String)
+		if err != nil {
+			return fmt.Errorf("mysql: parse %q.%q type %q: %w", v.Name, name.String, typ.String, err)
+		}
+		v.AddColumns(&schema.Column{
+			Name: name.String,
+			Type: &schema.ColumnType{Type: t, Raw: typ.String, Null: nullable.String == "YES"},
+		})
+	}
+	return rows.Err()
+}
+
+// reViewAlgorithm matches the algorithm from the 'SHOW CREATE VIEW' output.
+var reViewAlgorithm = regexp.MustCompile(`(?i)^CREATE\s+ALGORITHM\s*=\s*(\w+)`)
+
+// viewAlgorithms sets the algorithm of the views in the given schema
+// from the output of the 'SHOW CREATE VIEW' command.
+func (i *inspect) viewAlgorithms(ctx context.Context, s *schema.Schema) error {
+	for _, v := range s.Views {
+		var (
+			stmt string
+			b    = &sqlx.Builder{QuoteOpening: '`', QuoteClosing: '`'}
+		)
+		rows, err := i.QueryContext(ctx, b.P("SHOW CREATE VIEW").View(v).String())
+		if err != nil {
+			return fmt.Errorf("mysql: query CREATE VIEW %q: %w", v.Name, err)
+		}
+		if err := sqlx.ScanOne(rows, &sql.NullString{}, &stmt, &sql.NullString{}, &sql.NullString{}); err != nil {
+			return fmt.Errorf("mysql: scan CREATE VIEW %q: %w", v.Name, err)
+		}
+		if m := reViewAlgorithm.FindStringSubmatch(stmt); len(m) == 2 && !strings.EqualFold(m[1], ViewAlgorithmUndefined) {
+			v.AddAttrs(&ViewAlgorithm{V: strings.ToUpper(m[1])})
+		}
+	}
+	return nil
+}
+
+// viewDeps queries the tables and views that are used by the inspected
+// views, and attaches them as dependencies to the views.
+func (i *inspect) viewDeps(ctx context.Context, r *schema.Realm) error {
+	var args []any
+	for _, s := range r.Schemas {
+		if len(s.Views) > 0 {
+			args = append(args, s.Name)
+		}
+	}
+	if len(args) == 0 || !i.SupportsViewUsage() {
+		return nil
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(viewDepsQuery, nArgs(len(args))), args...)
+	if err != nil {
+		return fmt.Errorf("mysql: querying view dependencies: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var vSchema, vName, tSchema, tName string
+		if err := rows.Scan(&vSchema, &vName, &tSchema, &tName); err != nil {
+			return fmt.Errorf("mysql: scan view dependency: %w", err)
+		}
+		s1, ok := r.Schema(vSchema)
+		if !ok {
+			continue
+		}
+		v, ok := s1.View(vName)
+		if !ok {
+			continue
+		}
+		// Skip dependencies on objects that were not inspected.
+		s2, ok := r.Schema(tSchema)
+		if !ok {
+			continue
+		}
+		if t, ok := s2.Table(tName); ok {
+			v.AddDeps(t)
+		} else if dv, ok := s2.View(tName); ok {
+			v.AddDeps(dv)
+		}
+	}
+	return rows.Close()
+}
+
+// inspectPrograms inspects the stored programs of the realm: functions and
+// procedures, triggers of the inspected tables and scheduled events.
+func (i *inspect) inspectPrograms(ctx context.Context, r *schema.Realm, mode schema.InspectMode) error {
+	// Stored programs are not supported by TiDB.
+	if i.TiDB() {
+		return nil
+	}
+	if mode.Is(schema.InspectFuncs) {
+		if err := i.inspectFuncs(ctx, r); err != nil {
+			return err
+		}
+	}
+	if mode.Is(schema.InspectTriggers) {
+		if err := i.inspectTriggers(ctx, r); err != nil {
+			return err
+		}
+	}
+	if mode.Is(schema.InspectObjects) {
+		if err := i.inspectEvents(ctx, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// inspectFuncs queries and appends the stored functions
+// and procedures of the given realm, including their arguments.
+func (i *inspect) inspectFuncs(ctx context.Context, r *schema.Realm) error {
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(routinesQuery, nArgs(len(args))), args...)
+	if err != nil {
+		return fmt.Errorf("mysql: querying routines: %w", err)
+	}
+	if err := i.addRoutines(r, rows); err != nil {
+		return err
+	}
+	return i.routineArgs(ctx, r)
+}
+
+// addRoutines scans the rows and adds the functions and procedures to their schemas.
+func (i *inspect) addRoutines(r *schema.Realm, rows *sql.Rows) error {
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			defaultDefiner                                                           sql.NullBool
+			rSchema, name, typ, ret, body, deterministic, security, definer, comment sql.NullString
+		)
+		if err := rows.Scan(&rSchema, &name, &typ, &ret, &body, &deterministic, &security, &definer, &defaultDefiner, &comment); err != nil {
+			return fmt.Errorf("mysql: scan routine information: %w", err)
+		}
+		s, ok := r.Schema(rSchema.String)
+		if !ok {
+			return fmt.Errorf("mysql: schema %q for routine %q was not found in realm", rSchema.String, name.String)
+		}
+		var attrs []schema.Attr
+		if deterministic.String == "YES" {
+			attrs = append(attrs, &Deterministic{})
+		}
+		if sqlx.ValidString(security) && !strings.EqualFold(security.String, FuncSecurityDefiner) {
+			attrs = append(attrs, &FuncSecurity{V: strings.ToUpper(security.String)})
+		}
+		if sqlx.ValidString(definer) && !defaultDefiner.Bool {
+			attrs = append(attrs, &Definer{V: definer.String})
+		}
+		if sqlx.ValidString(comment) {
+			attrs = append(attrs, &schema.Comment{Text: comment.String})
+		}
+		switch strings.ToUpper(typ.String) {
+		case "FUNCTION":
+			t, err := ParseType(ret.String)
+			if err != nil {
+				return fmt.Errorf("mysql: parse return type %q of function %q: %w", ret.String, name.String, err)
+			}
+			s.AddFuncs(&schema.Func{Name: name.String, Ret: t, Body: body.String, Attrs: attrs})
+		case "PROCEDURE":
+			s.AddProcs(&schema.Proc{Name: name.String, Body: body.String, Attrs: attrs})
+		default:
+			return fmt.Errorf("mysql: unexpected type %q for routine %q", typ.String, name.String)
+		}
+	}
+	return rows.Close()
+}
+
+// routineArgs queries and appends the arguments of the inspected routines.
+func (i *inspect) routineArgs(ctx context.Context, r *schema.Realm) error {
+	var args []any
+	for _, s := range r.Schemas {
+		if len(s.Funcs) > 0 || len(s.Procs) > 0 {
+			args = append(args, s.Name)
+		}
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(routineArgsQuery, nArgs(len(args))), args...)
+	if err != nil {
+		return fmt.Errorf("mysql: querying routine arguments: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var rSchema, rName, rType, name, mode, typ sql.NullString
+		if err := rows.Scan(&rSchema, &rName, &rType, &name, &mode, &typ); err != nil {
+			return fmt.Errorf("mysql: scan routine argument: %w", err)
+		}
+		s, ok := r.Schema(rSchema.String)
+		if !ok {
+			continue
+		}
+		t, err := ParseType(typ.String)
+		if err != nil {
+			return fmt.Errorf("mysql: parse type %q of argument %q of routine %q: %w", typ.String, name.String, rName.String, err)
+		}
+		// Function arguments are always IN arguments, and
+		// their mode is not reported by the information schema.
+		a := &schema.FuncArg{Name: name.String, Type: t, Mode: schema.FuncArgModeIn}
+		if sqlx.ValidString(mode) {
+			a.Mode = schema.FuncArgMode(strings.ToUpper(mode.String))
+		}
+		switch strings.ToUpper(rType.String) {
+		case "FUNCTION":
+			if f, ok := s.Func(rName.String); ok {
+				f.Args = append(f.Args, a)
+			}
+		case "PROCEDURE":
+			if p, ok := s.Proc(rName.String); ok {
+				p.Args = append(p.Args, a)
+			}
+		}
+	}
+	return rows.Close()
+}
+
+// inspectTriggers queries and appends the triggers of the inspected tables.
+func (i *inspect) inspectTriggers(ctx context.Context, r *schema.Realm) error {
+	var args []any
+	for _, s := range r.Schemas {
+		if len(s.Tables) > 0 {
+			args = append(args, s.Name)
+		}
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(triggersQuery, nArgs(len(args))), args...)
+	if err != nil {
+		return fmt.Errorf("mysql: querying triggers: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			defaultDefiner                                     sql.NullBool
+			tSchema, name, table, timing, event, body, definer sql.NullString
+		)
+		if err := rows.Scan(&tSchema, &name, &table, &timing, &event, &body, &definer, &defaultDefiner); err != nil {
+			return fmt.Errorf("mysql: scan trigger information: %w", err)
+		}
+		s, ok := r.Schema(tSchema.String)
+		if !ok {
+			continue
+		}
+		// Skip triggers of tables that were not inspected.
+		t, ok := s.Table(table.String)
+		if !ok {
+			continue
+		}
+		tr := &schema.Trigger{
+			Name:       name.String,
+			Table:      t,
+			ActionTime: schema.TriggerTime(strings.ToUpper(timing.String)),
+			Events:     []schema.TriggerEvent{{Name: strings.ToUpper(event.String)}},
+			For:        schema.TriggerForRow,
+			Body:       body.String,
+		}
+		if sqlx.ValidString(definer) && !defaultDefiner.Bool {
+			tr.Attrs = append(tr.Attrs, &Definer{V: definer.String})
+		}
+		t.Triggers = append(t.Triggers, tr)
+	}
+	return rows.Close()
+}
+
+// inspectEvents queries and appends the scheduled events of the given realm.
+func (i *inspect) inspectEvents(ctx context.Context, r *schema.Realm) error {
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(eventsQuery, nArgs(len(args))), args...)
+	if err != nil {
+		return fmt.Errorf("mysql: querying events: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			defaultDefiner                                                                                 sql.NullBool
+			eSchema, name, body, typ, at, value, field, starts, ends, completion, status, definer, comment sql.NullString
+		)
+		if err := rows.Scan(&eSchema, &name, &body, &typ, &at, &value, &field, &starts, &ends, &completion, &status, &definer, &defaultDefiner, &comment); err != nil {
+			return fmt.Errorf("mysql: scan event information: %w", err)
+		}
+		s, ok := r.Schema(eSchema.String)
+		if !ok {
+			return fmt.Errorf("mysql: schema %q for event %q was not found in realm", eSchema.String, name.String)
+		}
+		e := &Event{Name: name.String, Schema: s, Body: body.String, Preserve: completion.String == "PRESERVE"}
+		switch {
+		case sqlx.ValidString(at):
+			e.At = &schema.Literal{V: at.String}
+		default:
+			v := value.String
+			// Composite intervals, such as '1:30' HOUR_MINUTE, are quoted.
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				v = quote(v)
+			}
+			e.Every = v + " " + field.String
+			if sqlx.ValidString(starts) {
+				e.Starts = &schema.Literal{V: starts.String}
+			}
+			if sqlx.ValidString(ends) {
+				e.Ends = &schema.Literal{V: ends.String}
+			}
+		}
+		switch strings.ToUpper(status.String) {
+		case "DISABLED":
+			e.Status = EventStatusDisable
+		case "SLAVESIDE_DISABLED":
+			e.Status = EventStatusDisableOnSlave
+		}
+		if sqlx.ValidString(definer) && !defaultDefiner.Bool {
+			e.Attrs = append(e.Attrs, &Definer{V: definer.String})
+		}
+		if sqlx.ValidString(comment) {
+			e.Attrs = append(e.Attrs, &schema.Comment{Text: comment.String})
+		}
+		s.AddObjects(e)
+	}
+	return rows.Close()
+}
+
 // schemas returns the list of the schemas in the database.
 func (i *inspect) schemas(ctx context.Context, opts *schema.InspectRealmOption) ([]*schema.Schema, error) {
 	var (
@@ -545,6 +1076,131 @@ func (i *inspect) createStmt(ctx context.Context, t *schema.Table) (*CreateStmt,
 	return c, nil
 }
 
+// partitions queries and appends the partitioning scheme of the schema
+// tables. Only tables that are marked as "partitioned" are queried.
+func (i *inspect) partitions(ctx context.Context, s *schema.Schema) error {
+	args := []any{s.Name}
+	for _, t := range s.Tables {
+		if partitioned(t) {
+			args = append(args, t.Name)
+		}
+	}
+	if len(args) == 1 {
+		return nil
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(partitionsQuery, nArgs(len(args)-1)), args...)
+	if err != nil {
+		return fmt.Errorf("mysql: querying schema %q partitions: %w", s.Name, err)
+	}
+	defer rows.Close()
+	type state struct {
+		p           *Partition
+		first, last string
+	}
+	parts := make(map[string]*state)
+	for rows.Next() {
+		var table, name, subName, method, subMethod, expr, subExpr, desc, comment sql.NullString
+		if err := rows.Scan(&table, &name, &subName, &method, &subMethod, &expr, &subExpr, &desc, &comment); err != nil {
+			return fmt.Errorf("mysql: %w", err)
+		}
+		t, ok := s.Table(table.String)
+		if !ok {
+			return fmt.Errorf("table %q was not found in schema", table.String)
+		}
+		st, ok := parts[t.Name]
+		if !ok {
+			p := &Partition{}
+			p.T, p.Linear = partitionMethod(method.String)
+			p.Parts = partitionParts(t, p.T, expr.String)
+			if sqlx.ValidString(subMethod) {
+				p.Sub = &SubPartition{}
+				p.Sub.T, p.Sub.Linear = partitionMethod(subMethod.String)
+				p.Sub.Parts = partitionParts(t, p.Sub.T, subExpr.String)
+			}
+			st = &state{p: p, first: name.String}
+			parts[t.Name] = st
+			t.Attrs = append(t.Attrs, p)
+		}
+		// Subpartitions are defined equally for all partitions.
+		if st.p.Sub != nil && name.String == st.first {
+			st.p.Sub.Count++
+		}
+		// Skip subpartition rows of the current partition.
+		if name.String == st.last {
+			continue
+		}
+		st.last = name.String
+		switch st.p.T {
+		case PartitionTypeHash, PartitionTypeKey:
+			st.p.Count++
+		default:
+			d := &PartitionDef{Name: name.String, Values: desc.String}
+			if sqlx.ValidString(comment) {
+				d.Attrs = append(d.Attrs, &schema.Comment{Text: comment.String})
+			}
+			st.p.Defs = append(st.p.Defs, d)
+		}
+	}
+	return rows.Err()
+}
+
+// partitioned reports if the table was marked as partitioned in its
+// CREATE_OPTIONS, and removes this option as it is not a valid table
+// option, and it is represented by the Partition attribute instead.
+func partitioned(t *schema.Table) bool {
+	for i, a := range t.Attrs {
+		o, ok := a.(*CreateOptions)
+		if !ok {
+			continue
+		}
+		opts := strings.Fields(o.V)
+		if j := slices.IndexFunc(opts, func(s string) bool { return strings.EqualFold(s, "partitioned") }); j != -1 {
+			if opts = slices.Delete(opts, j, j+1); len(opts) > 0 {
+				o.V = strings.Join(opts, " ")
+			} else {
+				t.Attrs = slices.Delete(t.Attrs, i, i+1)
+			}
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// partitionMethod parses the partitioning method returned
+// by INFORMATION_SCHEMA, e.g. "LINEAR HASH" or "RANGE COLUMNS".
+func partitionMethod(m string) (string, bool) {
+	m = strings.ToUpper(strings.TrimSpace(m))
+	if t, ok := strings.CutPrefix(m, "LINEAR "); ok {
+		return t, true
+	}
+	return m, false
+}
+
+// partitionParts parses the partitioning expression returned by INFORMATION_SCHEMA.
+func partitionParts(t *schema.Table, typ, x string) []*PartitionPart {
+	if x = strings.TrimSpace(x); x == "" {
+		return nil
+	}
+	// Only KEY and COLUMNS partitioning accept a list of columns.
+	if typ != PartitionTypeKey && !strings.HasSuffix(typ, " COLUMNS") {
+		if c, ok := t.Column(strings.Trim(x, "`")); ok {
+			return []*PartitionPart{{C: c}}
+		}
+		return []*PartitionPart{{X: &schema.RawExpr{X: x}}}
+	}
+	var parts []*PartitionPart
+	for _, n := range strings.Split(x, ",") {
+		n = strings.Trim(strings.TrimSpace(n), "`")
+		if c, ok := t.Column(n); ok {
+			parts = append(parts, &PartitionPart{C: c})
+		} else {
+			parts = append(parts, &PartitionPart{X: &schema.RawExpr{X: n}})
+		}
+	}
+	return parts
+}
+
 var reCurrTimestamp = regexp.MustCompile(`(?i)^current_timestamp(?:\(\d?\))?$`)
 
 // myDefaultExpr returns the correct schema.Expr based on the column attributes for MySQL.
@@ -731,6 +1387,130 @@ WHERE
 ORDER BY
 	TABLE_SCHEMA, TABLE_NAME`
 
+	// Query to list the views of the schemas. The first argument is
+	// the expression used for the ALGORITHM column (MariaDB only).
+	viewsQuery = `
+SELECT
+	TABLE_SCHEMA,
+	TABLE_NAME,
+	VIEW_DEFINITION,
+	CHECK_OPTION,
+	SECURITY_TYPE,
+	DEFINER,
+	DEFINER = CURRENT_USER() AS DEFAULT_DEFINER,
+	%s AS ALGORITHM
+FROM
+	INFORMATION_SCHEMA.VIEWS
+WHERE
+	TABLE_SCHEMA IN (%s)
+ORDER BY
+	TABLE_SCHEMA, TABLE_NAME`
+
+	// Query to list specific views of the schemas.
+	viewsQueryArgs = `
+SELECT
+	TABLE_SCHEMA,
+	TABLE_NAME,
+	VIEW_DEFINITION,
+	CHECK_OPTION,
+	SECURITY_TYPE,
+	DEFINER,
+	DEFINER = CURRENT_USER() AS DEFAULT_DEFINER,
+	%s AS ALGORITHM
+FROM
+	INFORMATION_SCHEMA.VIEWS
+WHERE
+	TABLE_SCHEMA IN (%s)
+	AND TABLE_NAME IN (%s)
+ORDER BY
+	TABLE_SCHEMA, TABLE_NAME`
+
+	// Query to list view columns.
+	viewColumnsQuery = "SELECT `TABLE_NAME`, `COLUMN_NAME`, `COLUMN_TYPE`, `IS_NULLABLE` FROM `INFORMATION_SCHEMA`.`COLUMNS` WHERE `TABLE_SCHEMA` = ? AND `TABLE_NAME` IN (%s) ORDER BY `TABLE_NAME`, `ORDINAL_POSITION`"
+
+	// Query to list the tables and views used by views.
+	viewDepsQuery = "SELECT `VIEW_SCHEMA`, `VIEW_NAME`, `TABLE_SCHEMA`, `TABLE_NAME` FROM `INFORMATION_SCHEMA`.`VIEW_TABLE_USAGE` WHERE `VIEW_SCHEMA` IN (%s) ORDER BY `VIEW_SCHEMA`, `VIEW_NAME`, `TABLE_SCHEMA`, `TABLE_NAME`"
+
+	// Query to list the stored functions and procedures of the schemas.
+	routinesQuery = `
+SELECT
+	ROUTINE_SCHEMA,
+	ROUTINE_NAME,
+	ROUTINE_TYPE,
+	DTD_IDENTIFIER,
+	ROUTINE_DEFINITION,
+	IS_DETERMINISTIC,
+	SECURITY_TYPE,
+	DEFINER,
+	DEFINER = CURRENT_USER() AS DEFAULT_DEFINER,
+	ROUTINE_COMMENT
+FROM
+	INFORMATION_SCHEMA.ROUTINES
+WHERE
+	ROUTINE_SCHEMA IN (%s)
+ORDER BY
+	ROUTINE_SCHEMA, ROUTINE_NAME`
+
+	// Query to list the arguments of stored routines. The return
+	// values of functions are reported at ordinal position 0.
+	routineArgsQuery = `
+SELECT
+	SPECIFIC_SCHEMA,
+	SPECIFIC_NAME,
+	ROUTINE_TYPE,
+	PARAMETER_NAME,
+	PARAMETER_MODE,
+	DTD_IDENTIFIER
+FROM
+	INFORMATION_SCHEMA.PARAMETERS
+WHERE
+	SPECIFIC_SCHEMA IN (%s)
+	AND ORDINAL_POSITION > 0
+ORDER BY
+	SPECIFIC_SCHEMA, SPECIFIC_NAME, ORDINAL_POSITION`
+
+	// Query to list the triggers of the schemas, by their activation order.
+	triggersQuery = `
+SELECT
+	TRIGGER_SCHEMA,
+	TRIGGER_NAME,
+	EVENT_OBJECT_TABLE,
+	ACTION_TIMING,
+	EVENT_MANIPULATION,
+	ACTION_STATEMENT,
+	DEFINER,
+	DEFINER = CURRENT_USER() AS DEFAULT_DEFINER
+FROM
+	INFORMATION_SCHEMA.TRIGGERS
+WHERE
+	TRIGGER_SCHEMA IN (%s)
+ORDER BY
+	TRIGGER_SCHEMA, EVENT_OBJECT_TABLE, ACTION_ORDER`
+
+	// Query to list the scheduled events of the schemas.
+	eventsQuery = `
+SELECT
+	EVENT_SCHEMA,
+	EVENT_NAME,
+	EVENT_DEFINITION,
+	EVENT_TYPE,
+	CAST(EXECUTE_AT AS CHAR) AS EXECUTE_AT,
+	INTERVAL_VALUE,
+	INTERVAL_FIELD,
+	CAST(STARTS AS CHAR) AS STARTS,
+	CAST(ENDS AS CHAR) AS ENDS,
+	ON_COMPLETION,
+	STATUS,
+	DEFINER,
+	DEFINER = CURRENT_USER() AS DEFAULT_DEFINER,
+	EVENT_COMMENT
+FROM
+	INFORMATION_SCHEMA.EVENTS
+WHERE
+	EVENT_SCHEMA IN (%s)
+ORDER BY
+	EVENT_SCHEMA, EVENT_NAME`
+
 	// Query to list table check constraints.
 	myChecksQuery = `
 SELECT
@@ -764,6 +1544,27 @@ WHERE
 	AND TABLE_NAME IN (%s)
 ORDER BY
 	TABLE_NAME, CONSTRAINT_NAME
+`
+	// Query to list the partitions and subpartitions of tables.
+	partitionsQuery = `
+SELECT
+	TABLE_NAME,
+	PARTITION_NAME,
+	SUBPARTITION_NAME,
+	PARTITION_METHOD,
+	SUBPARTITION_METHOD,
+	PARTITION_EXPRESSION,
+	SUBPARTITION_EXPRESSION,
+	PARTITION_DESCRIPTION,
+	PARTITION_COMMENT
+FROM
+	INFORMATION_SCHEMA.PARTITIONS
+WHERE
+	TABLE_SCHEMA = ?
+	AND TABLE_NAME IN (%s)
+	AND PARTITION_NAME IS NOT NULL
+ORDER BY
+	TABLE_NAME, PARTITION_ORDINAL_POSITION, SUBPARTITION_ORDINAL_POSITION
 `
 	// Query to list table foreign keys.
 	fksQuery = `
@@ -825,6 +1626,112 @@ type (
 		schema.Attr
 	}
 
+	// Partition describes the partitioning scheme of a table.
+	// See: https://dev.mysql.com/doc/refman/8.0/en/partitioning.html
+	Partition struct {
+		schema.Attr
+		// T defines the partitioning type. Can be one of:
+		// RANGE, RANGE COLUMNS, LIST, LIST COLUMNS, HASH or KEY.
+		T      string
+		Linear bool // LINEAR HASH or LINEAR KEY.
+		// Partition parts. A part can be either an expression
+		// or a column. KEY partitioning with no parts uses the
+		// primary key of the table.
+		Parts []*PartitionPart
+		// Count holds the number of partitions for HASH and KEY
+		// partitioning. RANGE and LIST partitions are defined in Defs.
+		Count int
+		Defs  []*PartitionDef
+		Sub   *SubPartition
+	}
+
+	// PartitionPart represents a partitioning key part that
+	// can be either an expression or a column.
+	PartitionPart struct {
+		X schema.Expr
+		C *schema.Column
+	}
+
+	// SubPartition describes the subpartitioning of RANGE and LIST partitions.
+	SubPartition struct {
+		T      string // HASH or KEY.
+		Linear bool
+		Parts  []*PartitionPart
+		Count  int // Number of subpartitions in each partition.
+	}
+
+	// PartitionDef describes a single RANGE or LIST partition.
+	PartitionDef struct {
+		Name string
+		// Values holds the partition bound as it is defined in the VALUES
+		// clause, without the wrapping parentheses. e.g. "100", "MAXVALUE"
+		// or "1,2,3".
+		Values string
+		Attrs  []schema.Attr // e.g. schema.Comment.
+	}
+
+	// AlterAlgorithm describes a clause that instructs the planner to classify
+	// table modifications by the ALGORITHM (INSTANT, INPLACE or COPY) that the
+	// database can use for executing them. The algorithm is annotated on the
+	// planned changes, or emitted on the ALTER TABLE statements.
+	AlterAlgorithm struct {
+		schema.Clause
+		Emit  bool // Emit the ALGORITHM clause on the statements.
+		Split bool // Execute INSTANT changes in separate statements.
+	}
+
+	// Definer describes the DEFINER clause of views and stored programs.
+	// It is set on inspection only if the definer is not the current user.
+	Definer struct {
+		schema.Attr
+		V string // user@host
+	}
+
+	// ViewAlgorithm describes the ALGORITHM clause of a view.
+	ViewAlgorithm struct {
+		schema.Attr
+		V string // UNDEFINED, MERGE or TEMPTABLE.
+	}
+
+	// ViewSecurity describes the SQL SECURITY characteristic of a view.
+	ViewSecurity struct {
+		schema.Attr
+		V string // DEFINER or INVOKER.
+	}
+
+	// FuncSecurity describes the SQL SECURITY characteristic of stored routines.
+	FuncSecurity struct {
+		schema.Attr
+		V string // DEFINER or INVOKER.
+	}
+
+	// Deterministic is an attribute attached to stored
+	// routines that were declared as DETERMINISTIC.
+	Deterministic struct {
+		schema.Attr
+	}
+
+	// Event describes a scheduled event. Events are executed either once at
+	// a specific time (AT), or periodically every interval (EVERY).
+	// See: https://dev.mysql.com/doc/refman/8.0/en/create-event.html
+	Event struct {
+		schema.Object
+		Name   string
+		Schema *schema.Schema
+		// At holds the execution time of a one-time event, and Every holds the
+		// interval of a recurring event, e.g. "1 DAY" or "'1:30' HOUR_MINUTE".
+		At    schema.Expr
+		Every string
+		// Optional start and end times of a recurring event.
+		Starts, Ends schema.Expr
+		// Preserve reports if the event is kept after its completion.
+		Preserve bool
+		// Status of the event: ENABLE (default), DISABLE or DISABLE ON SLAVE.
+		Status string
+		Body   string        // Event body only.
+		Attrs  []schema.Attr // Definer and comment.
+	}
+
 	// OnUpdate attribute for columns with "ON UPDATE CURRENT_TIMESTAMP" as a default.
 	OnUpdate struct {
 		schema.Attr
@@ -900,6 +1807,23 @@ type (
 	}
 )
 
+var _ specutil.RefNamer = (*Event)(nil)
+
+// Ref returns a reference to the event.
+func (e *Event) Ref() *schemahcl.Ref {
+	return specutil.ObjectRef(e.Schema, e)
+}
+
+// SpecType returns the type of the event.
+func (*Event) SpecType() string {
+	return "event"
+}
+
+// SpecName returns the name of the event.
+func (e *Event) SpecName() string {
+	return e.Name
+}
+
 // addIndex adds an index to the list of indexes
 // that needs further processing.
 func (s *showTable) addFullText(idx *schema.Index) {
@@ -745,6 +745,119 @@ func TestDriver_InspectTable(t *testing.T) {
 				}, t.Attrs)
 			},
 		},
+		{
+			name: "range partitions",
+			before: func(m mock) {
+				m.ExpectQuery(queryTable).
+					WithArgs("public").
+					WillReturnRows(sqltest.Rows(`
++--------------+--------------+--------------------+--------------------+----------------+---------------+----------------+------------------+------------------+------------------+
+| TABLE_SCHEMA | TABLE_NAME   | CHARACTER_SET_NAME | TABLE_COLLATION    | AUTO_INCREMENT | TABLE_COMMENT | CREATE_OPTIONS |      ENGINE      |  DEFAULT_ENGINE  |  TABLE_TYPE      |
++--------------+--------------+--------------------+--------------------+----------------+---------------+----------------+------------------+------------------+------------------+
+| public       | logs         | utf8mb4            | utf8mb4_0900_ai_ci | nil            |               | partitioned    |       InnoDB     |       1          |                  |
++--------------+--------------+--------------------+--------------------+----------------+---------------+----------------+------------------+------------------+------------------+
+`))
+				m.ExpectQuery(queryColumns).
+					WithArgs("public", "logs").
+					WillReturnRows(sqltest.Rows(`
++------------+-------------+-------------+----------------+-------------+------------+----------------+-------+--------------------+----------------+-----------------------+
+| table_name | column_name | column_type | column_comment | is_nullable | column_key | column_default | extra | character_set_name | collation_name | generation_expression |
++------------+-------------+-------------+----------------+-------------+------------+----------------+-------+--------------------+----------------+-----------------------+
+| logs       | id          | int         |                | NO          |            | NULL           |       | NULL               | NULL           | NULL                  |
+| logs       | created     | date        |                | NO          |            | NULL           |       | NULL               | NULL           | NULL                  |
++------------+-------------+-------------+----------------+-------------+------------+----------------+-------+--------------------+----------------+-----------------------+
+`))
+				m.ExpectQuery(queryIndexesExpr).
+					WithArgs("public", "logs").
+					WillReturnRows(sqlmock.NewRows([]string{"table_name", "index_name", "column_name", "non_unique", "key_part", "expression"}))
+				m.noFKs()
+				m.ExpectQuery(sqltest.Escape(fmt.Sprintf(partitionsQuery, "?"))).
+					WithArgs("public", "logs").
+					WillReturnRows(sqltest.Rows(`
++------------+----------------+-------------------+------------------+---------------------+----------------------+-------------------------+-----------------------+-------------------+
+| TABLE_NAME | PARTITION_NAME | SUBPARTITION_NAME | PARTITION_METHOD | SUBPARTITION_METHOD | PARTITION_EXPRESSION | SUBPARTITION_EXPRESSION | PARTITION_DESCRIPTION | PARTITION_COMMENT |
++------------+----------------+-------------------+------------------+---------------------+----------------------+-------------------------+-----------------------+-------------------+
+| logs       | p0             | p0sp0             | RANGE            | HASH                | year(` + "`created`" + `)      | ` + "`id`" + `                    | 2020                  | archived          |
+| logs       | p0             | p0sp1             | RANGE            | HASH                | year(` + "`created`" + `)      | ` + "`id`" + `                    | 2020                  | archived          |
+| logs       | p1             | p1sp0             | RANGE            | HASH                | year(` + "`created`" + `)      | ` + "`id`" + `                    | MAXVALUE              |                   |
+| logs       | p1             | p1sp1             | RANGE            | HASH                | year(` + "`created`" + `)      | ` + "`id`" + `                    | MAXVALUE              |                   |
++------------+----------------+-------------------+------------------+---------------------+----------------------+-------------------------+-----------------------+-------------------+
+`))
+			},
+			expect: func(require *require.Assertions, t *schema.Table, err error) {
+				require.NoError(err)
+				require.Equal("logs", t.Name)
+				require.EqualValues([]schema.Attr{
+					&schema.Charset{V: "utf8mb4"},
+					&schema.Collation{V: "utf8mb4_0900_ai_ci"},
+					&Engine{V: "InnoDB", Default: true},
+					&Partition{
+						T:     PartitionTypeRange,
+						Parts: []*PartitionPart{{X: &schema.RawExpr{X: "year(`created`)"}}},
+						Sub: &SubPartition{
+							T:     PartitionTypeHash,
+							Parts: []*PartitionPart{{C: t.Columns[0]}},
+							Count: 2,
+						},
+						Defs: []*PartitionDef{
+							{Name: "p0", Values: "2020", Attrs: []schema.Attr{&schema.Comment{Text: "archived"}}},
+							{Name: "p1", Values: "MAXVALUE"},
+						},
+					},
+				}, t.Attrs)
+			},
+		},
+		{
+			name: "key partitions",
+			before: func(m mock) {
+				m.ExpectQuery(queryTable).
+					WithArgs("public").
+					WillReturnRows(sqltest.Rows(`
++--------------+--------------+--------------------+--------------------+----------------+---------------+----------------------------------+------------------+------------------+------------------+
+| TABLE_SCHEMA | TABLE_NAME   | CHARACTER_SET_NAME | TABLE_COLLATION    | AUTO_INCREMENT | TABLE_COMMENT | CREATE_OPTIONS                   |      ENGINE      |  DEFAULT_ENGINE  |  TABLE_TYPE      |
++--------------+--------------+--------------------+--------------------+----------------+---------------+----------------------------------+------------------+------------------+------------------+
+| public       | users        | nil                | nil                | nil            |               | row_format=COMPACT partitioned   |       nil        |       nil        |                  |
++--------------+--------------+--------------------+--------------------+----------------+---------------+----------------------------------+------------------+------------------+------------------+
+`))
+				m.ExpectQuery(queryColumns).
+					WithArgs("public", "users").
+					WillReturnRows(sqltest.Rows(`
++------------+-------------+-------------+----------------+-------------+------------+----------------+-------+--------------------+----------------+-----------------------+
+| table_name | column_name | column_type | column_comment | is_nullable | column_key | column_default | extra | character_set_name | collation_name | generation_expression |
++------------+-------------+-------------+----------------+-------------+------------+----------------+-------+--------------------+----------------+-----------------------+
+| users      | id          | int         |                | NO          |            | NULL           |       | NULL               | NULL           | NULL                  |
+| users      | tenant      | int         |                | NO          |            | NULL           |       | NULL               | NULL           | NULL                  |
++------------+-------------+-------------+----------------+-------------+------------+----------------+-------+--------------------+----------------+-----------------------+
+`))
+				m.ExpectQuery(queryIndexesExpr).
+					WithArgs("public", "users").
+					WillReturnRows(sqlmock.NewRows([]string{"table_name", "index_name", "column_name", "non_unique", "key_part", "expression"}))
+				m.noFKs()
+				m.ExpectQuery(sqltest.Escape(fmt.Sprintf(partitionsQuery, "?"))).
+					WithArgs("public", "users").
+					WillReturnRows(sqltest.Rows(`
++------------+----------------+-------------------+------------------+---------------------+----------------------+-------------------------+-----------------------+-------------------+
+| TABLE_NAME | PARTITION_NAME | SUBPARTITION_NAME | PARTITION_METHOD | SUBPARTITION_METHOD | PARTITION_EXPRESSION | SUBPARTITION_EXPRESSION | PARTITION_DESCRIPTION | PARTITION_COMMENT |
++------------+----------------+-------------------+------------------+---------------------+----------------------+-------------------------+-----------------------+-------------------+
+| users      | p0             | NULL              | LINEAR KEY       | NULL                | ` + "`tenant`,`id`" + `        | NULL                    | NULL                  |                   |
+| users      | p1             | NULL              | LINEAR KEY       | NULL                | ` + "`tenant`,`id`" + `        | NULL                    | NULL                  |                   |
+| users      | p2             | NULL              | LINEAR KEY       | NULL                | ` + "`tenant`,`id`" + `        | NULL                    | NULL                  |                   |
++------------+----------------+-------------------+------------------+---------------------+----------------------+-------------------------+-----------------------+-------------------+
+`))
+			},
+			expect: func(require *require.Assertions, t *schema.Table, err error) {
+				require.NoError(err)
+				require.EqualValues([]schema.Attr{
+					&CreateOptions{V: "row_format=COMPACT"},
+					&Partition{
+						T:      PartitionTypeKey,
+						Linear: true,
+						Parts:  []*PartitionPart{{C: t.Columns[1]}, {C: t.Columns[0]}},
+						Count:  3,
+					},
+				}, t.Attrs)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -768,7 +881,7 @@ func TestDriver_InspectTable(t *testing.T) {
 			drv, err := Open(db)
 			require.NoError(t, err)
 			s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
-				Mode: ^schema.InspectViews,
+				Mode: schema.InspectSchemas | schema.InspectTables,
 			})
 			require.NoError(t, err)
 			require.NotNil(t, s)
@@ -906,7 +1019,7 @@ func TestDriver_InspectSchema(t *testing.T) {
 			drv, err := Open(db)
 			require.NoError(t, err)
 			tables, err := drv.InspectSchema(context.Background(), tt.schema, &schema.InspectOptions{
-				Mode: ^schema.InspectViews,
+				Mode: schema.InspectSchemas | schema.InspectTables,
 			})
 			tt.expect(require.New(t), tables, err)
 		})
@@ -930,7 +1043,7 @@ func TestDriver_Realm(t *testing.T) {
 	drv, err := Open(db)
 	require.NoError(t, err)
 	realm, err := drv.InspectRealm(context.Background(), &schema.InspectRealmOption{
-		Mode: ^schema.InspectViews,
+		Mode: schema.InspectSchemas | schema.InspectTables,
 	})
 	require.NoError(t, err)
 	require.EqualValues(t, func() *schema.Realm {
@@ -972,7 +1085,7 @@ func TestDriver_Realm(t *testing.T) {
 		WithArgs("test", "public").
 		WillReturnRows(sqlmock.NewRows([]string{"schema", "table", "charset", "collate", "inc", "comment", "options"}))
 	realm, err = drv.InspectRealm(context.Background(), &schema.InspectRealmOption{
-		Mode:    ^schema.InspectViews,
+		Mode:    schema.InspectSchemas | schema.InspectTables,
 		Schemas: []string{"test", "public"},
 	})
 	require.NoError(t, err)
@@ -1010,6 +1123,287 @@ func TestDriver_Realm(t *testing.T) {
 	}(), realm)
 }
 
+func TestDriver_InspectViews(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("8.0.31")
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= ?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++-------------+----------------------------+------------------------+
+| SCHEMA_NAME | DEFAULT_CHARACTER_SET_NAME | DEFAULT_COLLATION_NAME |
++-------------+----------------------------+------------------------+
+| test        | utf8mb4                    | utf8mb4_unicode_ci     |
++-------------+----------------------------+------------------------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewsQuery, "NULL", "?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++--------------+------------+---------------------------------------------------------------------+--------------+---------------+----------------+-----------------+-----------+
+| TABLE_SCHEMA | TABLE_NAME | VIEW_DEFINITION                                                     | CHECK_OPTION | SECURITY_TYPE | DEFINER        | DEFAULT_DEFINER | ALGORITHM |
++--------------+------------+---------------------------------------------------------------------+--------------+---------------+----------------+-----------------+-----------+
+| test         | v1         | select ` + "`test`.`users`.`id` AS `id` from `test`.`users`" + `           | NONE         | DEFINER       | root@%         | 1               | NULL      |
+| test         | v2         | select ` + "`v1`.`id` AS `id` from `test`.`v1` where (`v1`.`id` > 1)" + ` | LOCAL        | INVOKER       | admin@localhost | 0               | NULL      |
++--------------+------------+---------------------------------------------------------------------+--------------+---------------+----------------+-----------------+-----------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewColumnsQuery, "?, ?"))).
+		WithArgs("test", "v1", "v2").
+		WillReturnRows(sqltest.Rows(`
++------------+-------------+-------------+-------------+
+| TABLE_NAME | COLUMN_NAME | COLUMN_TYPE | IS_NULLABLE |
++------------+-------------+-------------+-------------+
+| v1         | id          | int         | NO          |
+| v2         | id          | int         | NO          |
++------------+-------------+-------------+-------------+
+`))
+	mk.ExpectQuery(sqltest.Escape("SHOW CREATE VIEW `test`.`v1`")).
+		WillReturnRows(sqlmock.NewRows([]string{"View", "Create View", "character_set_client", "collation_connection"}).
+			AddRow("v1", "CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`%` SQL SECURITY DEFINER VIEW `v1` AS select `users`.`id` AS `id` from `users`", "utf8mb4", "utf8mb4_0900_ai_ci"))
+	mk.ExpectQuery(sqltest.Escape("SHOW CREATE VIEW `test`.`v2`")).
+		WillReturnRows(sqlmock.NewRows([]string{"View", "Create View", "character_set_client", "collation_connection"}).
+			AddRow("v2", "CREATE ALGORITHM=MERGE DEFINER=`admin`@`localhost` SQL SECURITY INVOKER VIEW `v2` AS select `v1`.`id` AS `id` from `v1` where (`v1`.`id` > 1) WITH LOCAL CHECK OPTION", "utf8mb4", "utf8mb4_0900_ai_ci"))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewDepsQuery, "?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++-------------+-----------+--------------+------------+
+| VIEW_SCHEMA | VIEW_NAME | TABLE_SCHEMA | TABLE_NAME |
++-------------+-----------+--------------+------------+
+| test        | v1        | test         | users      |
+| test        | v2        | test         | v1         |
++-------------+-----------+--------------+------------+
+`))
+	drv, err := Open(db)
+	require.NoError(t, err)
+	s, err := drv.InspectSchema(context.Background(), "test", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectViews,
+	})
+	require.NoError(t, err)
+	require.Len(t, s.Views, 2)
+	v1, v2 := s.Views[0], s.Views[1]
+	// Schema qualifiers are trimmed from the definitions.
+	require.Equal(t, "select `users`.`id` AS `id` from `users`", v1.Def)
+	require.Empty(t, v1.Attrs)
+	require.Equal(t, []*schema.Column{{Name: "id", Type: &schema.ColumnType{Type: &schema.IntegerType{T: "int"}, Raw: "int"}}}, v1.Columns)
+	// Dependencies on objects that were not inspected are skipped.
+	require.Empty(t, v1.Deps)
+	require.Equal(t, "select `v1`.`id` AS `id` from `v1` where (`v1`.`id` > 1)", v2.Def)
+	require.Equal(t, []schema.Attr{
+		&schema.ViewCheckOption{V: schema.ViewCheckOptionLocal},
+		&ViewSecurity{V: ViewSecurityInvoker},
+		&Definer{V: "admin@localhost"},
+		&ViewAlgorithm{V: ViewAlgorithmMerge},
+	}, v2.Attrs)
+	require.Equal(t, []schema.Object{v1}, v2.Deps)
+
+	// MariaDB exposes the algorithm in INFORMATION_SCHEMA,
+	// but does not support the VIEW_TABLE_USAGE table.
+	db, m, err = sqlmock.New()
+	require.NoError(t, err)
+	mk = mock{m}
+	mk.version("10.7.1-MariaDB")
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= ?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++-------------+----------------------------+------------------------+
+| SCHEMA_NAME | DEFAULT_CHARACTER_SET_NAME | DEFAULT_COLLATION_NAME |
++-------------+----------------------------+------------------------+
+| test        | utf8mb4                    | utf8mb4_unicode_ci     |
++-------------+----------------------------+------------------------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewsQuery, "`ALGORITHM`", "?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++--------------+------------+-------------------+--------------+---------------+---------+-----------------+-----------+
+| TABLE_SCHEMA | TABLE_NAME | VIEW_DEFINITION   | CHECK_OPTION | SECURITY_TYPE | DEFINER | DEFAULT_DEFINER | ALGORITHM |
++--------------+------------+-------------------+--------------+---------------+---------+-----------------+-----------+
+| test         | v1         | select 1 AS ` + "`one`" + `  | NONE         | DEFINER       | root@%  | 1               | TEMPTABLE |
++--------------+------------+-------------------+--------------+---------------+---------+-----------------+-----------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewColumnsQuery, "?"))).
+		WithArgs("test", "v1").
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME", "COLUMN_NAME", "COLUMN_TYPE", "IS_NULLABLE"}).AddRow("v1", "one", "int(1)", "NO"))
+	drv, err = Open(db)
+	require.NoError(t, err)
+	s, err = drv.InspectSchema(context.Background(), "test", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectViews,
+	})
+	require.NoError(t, err)
+	require.Len(t, s.Views, 1)
+	require.Equal(t, "select 1 AS `one`", s.Views[0].Def)
+	require.Equal(t, []schema.Attr{&ViewAlgorithm{V: ViewAlgorithmTempTable}}, s.Views[0].Attrs)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestTrimQualifier(t *testing.T) {
+	for _, tt := range []struct {
+		def, name, want string
+	}{
+		{
+			def:  "select `test`.`users`.`id` AS `id` from `test`.`users`",
+			name: "test",
+			want: "select `users`.`id` AS `id` from `users`",
+		},
+		// Other schemas are kept.
+		{
+			def:  "select `other`.`t`.`id` AS `id` from `other`.`t`",
+			name: "test",
+			want: "select `other`.`t`.`id` AS `id` from `other`.`t`",
+		},
+		// Tables and columns with the schema name.
+		{
+			def:  "select `test`.`test`.`test` AS `test` from `test`.`test`",
+			name: "test",
+			want: "select `test`.`test` AS `test` from `test`",
+		},
+		{
+			def:  "select `other`.`test`.`id` AS `id` from `other`.`test`",
+			name: "test",
+			want: "select `other`.`test`.`id` AS `id` from `other`.`test`",
+		},
+		// String literals and comments.
+		{
+			def:  "select '`test`.' AS `a`,_utf8mb4'it''s `test`.\\' `test`.x' AS `b`,\"`test`.\" AS `c` from `test`.`t` /* `test`.t */",
+			name: "test",
+			want: "select '`test`.' AS `a`,_utf8mb4'it''s `test`.\\' `test`.x' AS `b`,\"`test`.\" AS `c` from `t` /* `test`.t */",
+		},
+		// Quoted identifiers with backticks.
+		{
+			def:  "select `a``b`.`t`.`c` AS `c` from `a``b`.`t`",
+			name: "a`b",
+			want: "select `t`.`c` AS `c` from `t`",
+		},
+	} {
+		require.Equal(t, tt.want, trimQualifier(tt.def, tt.name))
+	}
+}
+
+func TestDriver_InspectPrograms(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("8.0.13")
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= ?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++-------------+----------------------------+------------------------+
+| SCHEMA_NAME | DEFAULT_CHARACTER_SET_NAME | DEFAULT_COLLATION_NAME |
++-------------+----------------------------+------------------------+
+| test        | utf8mb4                    | utf8mb4_unicode_ci     |
++-------------+----------------------------+------------------------+
+`))
+	mk.tables("test", "users")
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(columnsExprQuery, "?"))).
+		WithArgs("test", "users").
+		WillReturnRows(sqltest.Rows(`
++-------------+-------------+--------------+----------------+-------------+------------+----------------+----------------+--------------------+--------------------+---------------------------+
+| TABLE_NAME  | COLUMN_NAME | COLUMN_TYPE  | COLUMN_COMMENT | IS_NULLABLE | COLUMN_KEY | COLUMN_DEFAULT | EXTRA          | CHARACTER_SET_NAME | COLLATION_NAME     | GENERATION_EXPRESSION     |
++-------------+-------------+--------------+----------------+-------------+------------+----------------+----------------+--------------------+--------------------+---------------------------+
+| users       | id          | int          |                | NO          | PRI        | NULL           |                | NULL               | NULL               | NULL                      |
++-------------+-------------+--------------+----------------+-------------+------------+----------------+----------------+--------------------+--------------------+---------------------------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(indexesExprQuery, "?"))).
+		WillReturnRows(sqlmock.NewRows([]string{"table_name", "index_name", "column_name", "non_unique", "key_part", "expression"}))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(fksQuery, "?"))).
+		WillReturnRows(sqlmock.NewRows([]string{"TABLE_NAME", "CONSTRAINT_NAME", "TABLE_NAME", "COLUMN_NAME", "REFERENCED_TABLE_NAME", "REFERENCED_COLUMN_NAME", "REFERENCED_TABLE_SCHEMA", "UPDATE_RULE", "DELETE_RULE"}))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(routinesQuery, "?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++----------------+--------------+--------------+----------------+----------------------------------+------------------+---------------+-----------------+-----------------+-----------------+
+| ROUTINE_SCHEMA | ROUTINE_NAME | ROUTINE_TYPE | DTD_IDENTIFIER | ROUTINE_DEFINITION               | IS_DETERMINISTIC | SECURITY_TYPE | DEFINER         | DEFAULT_DEFINER | ROUTINE_COMMENT |
++----------------+--------------+--------------+----------------+----------------------------------+------------------+---------------+-----------------+-----------------+-----------------+
+| test           | add_one      | FUNCTION     | int            | RETURN a + 1                     | YES              | DEFINER       | root@%          | 1               | increment       |
+| test           | cleanup      | PROCEDURE    | NULL           | DELETE FROM users WHERE id > max | NO               | INVOKER       | admin@localhost | 0               |                 |
++----------------+--------------+--------------+----------------+----------------------------------+------------------+---------------+-----------------+-----------------+-----------------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(routineArgsQuery, "?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++-----------------+---------------+--------------+----------------+----------------+----------------+
+| SPECIFIC_SCHEMA | SPECIFIC_NAME | ROUTINE_TYPE | PARAMETER_NAME | PARAMETER_MODE | DTD_IDENTIFIER |
++-----------------+---------------+--------------+----------------+----------------+----------------+
+| test            | add_one       | FUNCTION     | a              | NULL           | int            |
+| test            | cleanup       | PROCEDURE    | max            | IN             | bigint         |
+| test            | cleanup       | PROCEDURE    | n              | OUT            | int            |
++-----------------+---------------+--------------+----------------+----------------+----------------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(triggersQuery, "?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++----------------+--------------+--------------------+---------------+--------------------+------------------------+---------+-----------------+
+| TRIGGER_SCHEMA | TRIGGER_NAME | EVENT_OBJECT_TABLE | ACTION_TIMING | EVENT_MANIPULATION | ACTION_STATEMENT       | DEFINER | DEFAULT_DEFINER |
++----------------+--------------+--------------------+---------------+--------------------+------------------------+---------+-----------------+
+| test           | users_bi     | users              | BEFORE        | INSERT             | SET NEW.id = NEW.id + 1 | root@%  | 1               |
+| test           | other_ai     | other              | AFTER         | INSERT             | SET @n = 1             | root@%  | 1               |
++----------------+--------------+--------------------+---------------+--------------------+------------------------+---------+-----------------+
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(eventsQuery, "?"))).
+		WithArgs("test").
+		WillReturnRows(sqltest.Rows(`
++--------------+------------+------------------+------------+---------------------+----------------+----------------+---------------------+------+---------------+----------+---------+-----------------+---------------+
+| EVENT_SCHEMA | EVENT_NAME | EVENT_DEFINITION | EVENT_TYPE | EXECUTE_AT          | INTERVAL_VALUE | INTERVAL_FIELD | STARTS              | ENDS | ON_COMPLETION | STATUS   | DEFINER | DEFAULT_DEFINER | EVENT_COMMENT |
++--------------+------------+------------------+------------+---------------------+----------------+----------------+---------------------+------+---------------+----------+---------+-----------------+---------------+
+| test         | e1         | CALL cleanup(1)  | RECURRING  | NULL                | 1              | DAY            | 2024-01-01 00:00:00 | NULL | PRESERVE      | DISABLED | root@%  | 1               | nightly       |
+| test         | e2         | CALL cleanup(2)  | ONE TIME   | 2024-06-01 00:00:00 | NULL           | NULL           | NULL                | NULL | NOT PRESERVE  | ENABLED  | root@%  | 1               |               |
++--------------+------------+------------------+------------+---------------------+----------------+----------------+---------------------+------+---------------+----------+---------+-----------------+---------------+
+`))
+	drv, err := Open(db)
+	require.NoError(t, err)
+	s, err := drv.InspectSchema(context.Background(), "test", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectTables | schema.InspectFuncs | schema.InspectTriggers | schema.InspectObjects,
+	})
+	require.NoError(t, err)
+	require.NoError(t, m.ExpectationsWereMet())
+
+	require.Len(t, s.Funcs, 1)
+	f := s.Funcs[0]
+	require.Equal(t, "add_one", f.Name)
+	require.Equal(t, "RETURN a + 1", f.Body)
+	require.Equal(t, &schema.IntegerType{T: TypeInt}, f.Ret)
+	require.Equal(t, []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: TypeInt}, Mode: schema.FuncArgModeIn}}, f.Args)
+	require.Equal(t, []schema.Attr{&Deterministic{}, &schema.Comment{Text: "increment"}}, f.Attrs)
+	require.Len(t, s.Procs, 1)
+	p := s.Procs[0]
+	require.Equal(t, "cleanup", p.Name)
+	require.Equal(t, []*schema.FuncArg{
+		{Name: "max", Type: &schema.IntegerType{T: TypeBigInt}, Mode: schema.FuncArgModeIn},
+		{Name: "n", Type: &schema.IntegerType{T: TypeInt}, Mode: schema.FuncArgModeOut},
+	}, p.Args)
+	require.Equal(t, []schema.Attr{&FuncSecurity{V: FuncSecurityInvoker}, &Definer{V: "admin@localhost"}}, p.Attrs)
+
+	// Triggers of tables that were not inspected are skipped.
+	users, ok := s.Table("users")
+	require.True(t, ok)
+	require.Len(t, users.Triggers, 1)
+	tr := users.Triggers[0]
+	require.Equal(t, "users_bi", tr.Name)
+	require.Equal(t, users, tr.Table)
+	require.Equal(t, schema.TriggerTimeBefore, tr.ActionTime)
+	require.Equal(t, []schema.TriggerEvent{schema.TriggerEventInsert}, tr.Events)
+	require.Equal(t, schema.TriggerForRow, tr.For)
+	require.Equal(t, "SET NEW.id = NEW.id + 1", tr.Body)
+	require.Empty(t, tr.Attrs)
+
+	require.Len(t, s.Objects, 2)
+	e1, e2 := s.Objects[0].(*Event), s.Objects[1].(*Event)
+	require.Equal(t, &Event{
+		Name:     "e1",
+		Schema:   s,
+		Every:    "1 DAY",
+		Starts:   &schema.Literal{V: "2024-01-01 00:00:00"},
+		Preserve: true,
+		Status:   EventStatusDisable,
+		Body:     "CALL cleanup(1)",
+		Attrs:    []schema.Attr{&schema.Comment{Text: "nightly"}},
+	}, e1)
+	require.Equal(t, &Event{
+		Name:   "e2",
+		Schema: s,
+		At:     &schema.Literal{V: "2024-06-01 00:00:00"},
+		Body:   "CALL cleanup(2)",
+	}, e2)
+}
+
 func TestInspectMode_InspectRealm(t *testing.T) {
 	db, m, err := sqlmock.New()
 	require.NoError(t, err)
@@ -80,6 +80,47 @@ func (v V) SupportsRenameColumn() bool {
 	return v.GTE(u)
 }
 
+// SupportsAlgorithmInplace reports if the version supports
+// the ALGORITHM=INPLACE clause (online DDL) on ALTER TABLE.
+func (v V) SupportsAlgorithmInplace() bool {
+	u := "5.6"
+	if v.Maria() {
+		u = "10.0"
+	}
+	return v.GTE(u)
+}
+
+// SupportsAlgorithmInstant reports if the version supports the
+// ALGORITHM=INSTANT clause on ALTER TABLE, including adding
+// columns instantly at the end of the table.
+func (v V) SupportsAlgorithmInstant() bool {
+	u := "8.0.12"
+	if v.Maria() {
+		u = "10.3.2"
+	}
+	return v.GTE(u)
+}
+
+// SupportsInstantDropColumn reports if the version
+// supports dropping columns with ALGORITHM=INSTANT.
+func (v V) SupportsInstantDropColumn() bool {
+	u := "8.0.29"
+	if v.Maria() {
+		u = "10.4"
+	}
+	return v.GTE(u)
+}
+
+// SupportsInstantRenameColumn reports if the version
+// supports renaming columns with ALGORITHM=INSTANT.
+func (v V) SupportsInstantRenameColumn() bool {
+	u := "8.0.28"
+	if v.Maria() {
+		u = "10.5.2"
+	}
+	return v.GTE(u)
+}
+
 // SupportsIndexComment reports if the version
 // supports comments on indexes.
 func (v V) SupportsIndexComment() bool {
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -100,6 +101,38 @@ func (s *state) plan(changes []schema.Change) error {
 			err = s.modifyTable(c)
 		case *schema.RenameTable:
 			s.renameTable(c)
+		case *schema.AddView:
+			err = s.addView(c)
+		case *schema.DropView:
+			err = s.dropView(c)
+		case *schema.ModifyView:
+			err = s.modifyView(c)
+		case *schema.RenameView:
+			s.renameView(c)
+		case *schema.AddFunc:
+			err = s.addFunc(c)
+		case *schema.DropFunc:
+			err = s.dropFunc(c)
+		case *schema.ModifyFunc:
+			err = s.modifyFunc(c)
+		case *schema.AddProc:
+			err = s.addProc(c)
+		case *schema.DropProc:
+			err = s.dropProc(c)
+		case *schema.ModifyProc:
+			err = s.modifyProc(c)
+		case *schema.AddTrigger:
+			err = s.addTrigger(c)
+		case *schema.DropTrigger:
+			err = s.dropTrigger(c)
+		case *schema.ModifyTrigger:
+			err = s.modifyTrigger(c)
+		case *schema.AddObject:
+			err = s.addObject(c)
+		case *schema.DropObject:
+			err = s.dropObject(c)
+		case *schema.ModifyObject:
+			err = s.modifyObject(c)
 		default:
 			err = fmt.Errorf("unsupported change %T", c)
 		}
@@ -266,6 +299,13 @@ func (s *state) addTable(add *schema.AddTable) error {
 		return fmt.Errorf("create table %q: %s", add.T.Name, strings.Join(errs, ", "))
 	}
 	s.tableAttrs(b, add, add.T.Attrs...)
+	if p := (Partition{}); sqlx.Has(add.T.Attrs, &p) {
+		x, err := formatPartition(p)
+		if err != nil {
+			return fmt.Errorf("create table %q: %w", add.T.Name, err)
+		}
+		b.P(x)
+	}
 	s.append(&migrate.Change{
 		Cmd:     b.String(),
 		Source:  add,
@@ -299,7 +339,10 @@ func (s *state) dropTable(drop *schema.DropTable) error {
 // modifyTable builds and appends the migration changes for
 // bringing the table into its modified state.
 func (s *state) modifyTable(modify *schema.ModifyTable) error {
-	var changes [2][]schema.Change
+	var (
+		changes   [2][]schema.Change
+		partition schema.Change
+	)
 	if len(modify.T.Columns) == 0 {
 		return fmt.Errorf("table %q has no columns; drop the table instead", modify.T.Name)
 	}
@@ -334,22 +377,279 @@ func (s *state) modifyTable(modify *schema.ModifyTable) error {
 				I: change.To,
 			})
 		default:
-			changes[1] = append(changes[1], change)
+			// Partitioning operations cannot be combined
+			// with other alterations on the ALTER TABLE command.
+			if isPartitionChange(change) {
+				partition = change
+			} else {
+				changes[1] = append(changes[1], change)
+			}
 		}
 	}
+	// Partitioning is removed before the table is altered, as the alteration
+	// might drop columns that are part of the partitioning key. Otherwise, it
+	// is added or modified after the table is altered, as it might depend on
+	// new columns or keys.
+	if _, ok := partition.(*schema.DropAttr); ok {
+		if err := s.alterPartition(modify.T, partition); err != nil {
+			return err
+		}
+		partition = nil
+	}
+	var alg *AlterAlgorithm
+	if a := (AlterAlgorithm{}); sqlx.Has(modify.Extra, &a) {
+		alg = &a
+	}
 	for i := range changes {
-		if len(changes[i]) > 0 {
-			if err := s.alterTable(modify.T, changes[i]); err != nil {
+		for _, group := range s.splitAlgorithm(modify.T, changes[i], alg) {
+			if err := s.alterTable(modify.T, group, alg); err != nil {
 				return err
 			}
 		}
 	}
+	if partition != nil {
+		return s.alterPartition(modify.T, partition)
+	}
+	return nil
+}
+
+// isPartitionChange reports if the given change modifies the table partitioning.
+func isPartitionChange(c schema.Change) bool {
+	var a schema.Attr
+	switch c := c.(type) {
+	case *schema.AddAttr:
+		a = c.A
+	case *schema.DropAttr:
+		a = c.A
+	case *schema.ModifyAttr:
+		a = c.To
+	}
+	_, ok := a.(*Partition)
+	return ok
+}
+
+// alterPartition builds and appends the migration change for
+// adding, dropping or modifying the partitioning of a table.
+func (s *state) alterPartition(t *schema.Table, c schema.Change) error {
+	var from, to *Partition
+	switch c := c.(type) {
+	case *schema.AddAttr:
+		to = c.A.(*Partition)
+	case *schema.DropAttr:
+		from = c.A.(*Partition)
+	case *schema.ModifyAttr:
+		from, to = c.From.(*Partition), c.To.(*Partition)
+	}
+	cmd, err := s.partitionCmd(t, from, to)
+	if err != nil {
+		return fmt.Errorf("alter table %q partitioning: %w", t.Name, err)
+	}
+	reverse, err := s.partitionCmd(t, to, from)
+	if err != nil {
+		return fmt.Errorf("reversed alter table %q partitioning: %w", t.Name, err)
+	}
+	s.append(&migrate.Change{
+		Cmd: cmd,
+		Source: &schema.ModifyTable{
+			T:       t,
+			Changes: []schema.Change{c},
+		},
+		Reverse: reverse,
+		Comment: fmt.Sprintf("modify %q table partitioning", t.Name),
+	})
 	return nil
 }
 
+// partitionCmd returns the ALTER TABLE command for migrating the table
+// partitioning from one state to the other. Changes to the partitioning
+// key require repartitioning the table. Otherwise, partitions are added,
+// dropped, coalesced or reorganized.
+func (s *state) partitionCmd(t *schema.Table, from, to *Partition) (string, error) {
+	b := s.Build("ALTER TABLE").Table(t)
+	switch {
+	case to == nil:
+		b.P("REMOVE PARTITIONING")
+	case from == nil || partitionKeyChanged(from, to):
+		x, err := formatPartition(*to)
+		if err != nil {
+			return "", err
+		}
+		b.P(x)
+	case to.T == PartitionTypeHash || to.T == PartitionTypeKey:
+		switch n, m := partitionCount(from.T, from.Count), partitionCount(to.T, to.Count); {
+		case n < m:
+			b.P("ADD PARTITION PARTITIONS", strconv.Itoa(m-n))
+		case n > m:
+			b.P("COALESCE PARTITION", strconv.Itoa(n-m))
+		}
+	default:
+		// Skip the common prefix and suffix of the partition definitions.
+		var i, j int
+		for i < len(from.Defs) && i < len(to.Defs) && !partitionDefChanged(from.Defs[i], to.Defs[i]) {
+			i++
+		}
+		for j < len(from.Defs)-i && j < len(to.Defs)-i && !partitionDefChanged(from.Defs[len(from.Defs)-j-1], to.Defs[len(to.Defs)-j-1]) {
+			j++
+		}
+		fd, td := from.Defs[i:len(from.Defs)-j], to.Defs[i:len(to.Defs)-j]
+		switch {
+		case len(td) == 0:
+			b.P("DROP PARTITION")
+			b.MapComma(fd, func(i int, b *sqlx.Builder) {
+				b.Ident(fd[i].Name)
+			})
+		// Partitions were appended to the end of the list.
+		case len(fd) == 0 && j == 0:
+			b.P("ADD PARTITION")
+			partitionDefs(b, to.T, td)
+		default:
+			// Partitions that were added in the middle of the list
+			// are reorganized along with the partition that follows.
+			if len(fd) == 0 {
+				fd, td = from.Defs[i:i+1], to.Defs[i:i+len(td)+1]
+			}
+			b.P("REORGANIZE PARTITION")
+			b.MapComma(fd, func(i int, b *sqlx.Builder) {
+				b.Ident(fd[i].Name)
+			})
+			b.P("INTO")
+			partitionDefs(b, to.T, td)
+		}
+	}
+	return b.String(), nil
+}
+
+// formatPartition returns the string representation of the
+// partitioning clause according to the MySQL format/grammar.
+func formatPartition(p Partition) (string, error) {
+	b := &sqlx.Builder{QuoteOpening: '`', QuoteClosing: '`'}
+	b.P("PARTITION BY")
+	if err := partitionKey(b, p.T, p.Linear, p.Parts); err != nil {
+		return "", err
+	}
+	switch p.T {
+	case PartitionTypeHash, PartitionTypeKey:
+		if p.Sub != nil || len(p.Defs) > 0 {
+			return "", fmt.Errorf("unexpected partition definitions for %s partitioning", p.T)
+		}
+		if p.Count > 0 {
+			b.P("PARTITIONS", strconv.Itoa(p.Count))
+		}
+	default:
+		if len(p.Defs) == 0 {
+			return "", fmt.Errorf("missing partition definitions for %s partitioning", p.T)
+		}
+		if p.Sub != nil {
+			if p.Sub.T != PartitionTypeHash && p.Sub.T != PartitionTypeKey {
+				return "", fmt.Errorf("unexpected subpartition type: %q", p.Sub.T)
+			}
+			b.P("SUBPARTITION BY")
+			if err := partitionKey(b, p.Sub.T, p.Sub.Linear, p.Sub.Parts); err != nil {
+				return "", err
+			}
+			if p.Sub.Count > 0 {
+				b.P("SUBPARTITIONS", strconv.Itoa(p.Sub.Count))
+			}
+		}
+		partitionDefs(b, p.T, p.Defs)
+	}
+	return b.String(), nil
+}
+
+// partitionKey writes the partitioning type and its key parts.
+func partitionKey(b *sqlx.Builder, t string, linear bool, parts []*PartitionPart) error {
+	switch t {
+	case PartitionTypeRange, PartitionTypeRangeColumns, PartitionTypeList, PartitionTypeListColumns:
+		if linear {
+			return fmt.Errorf("unexpected LINEAR for %s partitioning", t)
+		}
+	case PartitionTypeHash, PartitionTypeKey:
+		if linear {
+			b.P("LINEAR")
+		}
+	default:
+		return fmt.Errorf("unknown partition type: %q", t)
+	}
+	// KEY partitioning with no parts uses the primary key.
+	if len(parts) == 0 && t != PartitionTypeKey {
+		return fmt.Errorf("missing parts for %s partitioning", t)
+	}
+	b.P(t).Wrap(func(b *sqlx.Builder) {
+		b.MapComma(parts, func(i int, b *sqlx.Builder) {
+			switch k := parts[i]; {
+			case k.C != nil:
+				b.Ident(k.C.Name)
+			case k.X != nil:
+				b.P(k.X.(*schema.RawExpr).X)
+			}
+		})
+	})
+	return nil
+}
+
+// partitionDefs writes the definitions of RANGE or LIST partitions.
+func partitionDefs(b *sqlx.Builder, t string, defs []*PartitionDef) {
+	values := "VALUES IN"
+	if strings.HasPrefix(t, PartitionTypeRange) {
+		values = "VALUES LESS THAN"
+	}
+	b.Wrap(func(b *sqlx.Builder) {
+		b.MapComma(defs, func(i int, b *sqlx.Builder) {
+			b.P("PARTITION").Ident(defs[i].Name).P(values).Wrap(func(b *sqlx.Builder) {
+				b.P(defs[i].Values)
+			})
+			if c := (schema.Comment{}); sqlx.Has(defs[i].Attrs, &c) && c.Text != "" {
+				b.P("COMMENT", quote(c.Text))
+			}
+		})
+	})
+}
+
+// splitAlgorithm splits the given changes into groups, such that each group is
+// executed in one ALTER TABLE statement. If splitting was requested, INSTANT changes
+// are executed separately from changes that require rebuilding or copying the table.
+// Columns are dropped last, as the other changes might still refer to them.
+func (s *state) splitAlgorithm(t *schema.Table, changes []schema.Change, alg *AlterAlgorithm) [][]schema.Change {
+	if len(changes) == 0 {
+		return nil
+	}
+	// Nothing to split if the statement is already executed instantly.
+	if a := s.algorithm(t, changes); alg == nil || !alg.Split || len(changes) == 1 || a == "" || a == AlgorithmInstant {
+		return [][]schema.Change{changes}
+	}
+	var instant, rest, drop []schema.Change
+	for _, c := range changes {
+		switch c.(type) {
+		// Renaming the table must be executed in the same statement
+		// as the rest of the changes, as they refer to the table name.
+		case *schema.RenameTable:
+			return [][]schema.Change{changes}
+		case *schema.DropColumn:
+			if s.algorithm(t, []schema.Change{c}) == AlgorithmInstant {
+				drop = append(drop, c)
+				continue
+			}
+		default:
+			if s.algorithm(t, []schema.Change{c}) == AlgorithmInstant {
+				instant = append(instant, c)
+				continue
+			}
+		}
+		rest = append(rest, c)
+	}
+	groups := make([][]schema.Change, 0, 3)
+	for _, g := range [][]schema.Change{instant, rest, drop} {
+		if len(g) > 0 {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
 // alterTable modifies the given table by executing on it a list of
-// changes in one SQL statement.
-func (s *state) alterTable(t *schema.Table, changes []schema.Change) error {
+// changes in one SQL statement. If the algorithm clause is set, the
+// algorithm used for executing the statement is emitted or annotated.
+func (s *state) alterTable(t *schema.Table, changes []schema.Change, alg *AlterAlgorithm) error {
 	var (
 		reverse    []schema.Change
 		name       = t.Name
@@ -481,6 +781,9 @@ func (s *state) alterTable(t *schema.Table, changes []schema.Change) error {
 		if err != nil {
 			return "", err
 		}
+		if a := s.algorithm(t, changes); alg != nil && alg.Emit && a != "" {
+			b.Comma().P("ALGORITHM=" + a)
+		}
 		return b.String(), nil
 	}
 	cmd, err := build(changes)
@@ -495,6 +798,9 @@ func (s *state) alterTable(t *schema.Table, changes []schema.Change) error {
 		},
 		Comment: fmt.Sprintf("modify %q table", t.Name),
 	}
+	if a := s.algorithm(t, changes); alg != nil && !alg.Emit && a != "" {
+		change.Comment = fmt.Sprintf("modify %q table (ALGORITHM=%s)", t.Name, a)
+	}
 	if reversible {
 		// Changes should be reverted in
 		// a reversed order they were created.
@@ -507,6 +813,210 @@ func (s *state) alterTable(t *schema.Table, changes []schema.Change) error {
 	return nil
 }
 
+// algorithms holds the ALTER TABLE algorithms ordered
+// from the strongest (cheapest) to the weakest.
+var algorithms = []string{AlgorithmInstant, AlgorithmInplace, AlgorithmCopy}
+
+// algorithm returns the strongest ALTER TABLE algorithm that can be used for
+// executing the given changes on the table in one statement. i.e., the weakest
+// algorithm required by one of the changes. An empty string is returned if
+// the ALGORITHM clause is not supported by the database.
+func (c *conn) algorithm(t *schema.Table, changes []schema.Change) string {
+	switch {
+	case c.TiDB() || len(changes) == 0:
+		return ""
+	case !c.SupportsAlgorithmInplace():
+		return AlgorithmCopy
+	}
+	alg := AlgorithmInstant
+	for _, ch := range changes {
+		alg = weakest(alg, c.changeAlgorithm(t, ch))
+	}
+	if alg == AlgorithmInstant && !c.SupportsAlgorithmInstant() {
+		alg = AlgorithmInplace
+	}
+	return alg
+}
+
+// weakest returns the weakest of the two algorithms.
+func weakest(a1, a2 string) string {
+	if slices.Index(algorithms, a2) > slices.Index(algorithms, a1) {
+		return a2
+	}
+	return a1
+}
+
+// changeAlgorithm returns the strongest algorithm that can be used for executing
+// the given table change. The rules below follow the online DDL operations tables
+// of MySQL and MariaDB, and fall back to COPY in case an operation is unknown.
+func (c *conn) changeAlgorithm(t *schema.Table, change schema.Change) string {
+	switch change := change.(type) {
+	case *schema.RenameTable:
+		return AlgorithmInstant
+	case *schema.AddColumn:
+		var x schema.GeneratedExpr
+		switch {
+		case sqlx.Has(change.C.Attrs, &x):
+			if storedOrVirtual(x.Type) == stored {
+				return AlgorithmCopy
+			}
+			return AlgorithmInstant
+		// Columns cannot be added instantly to tables with
+		// FULLTEXT indexes or tables with compressed rows.
+		case sqlx.Has(change.C.Attrs, &AutoIncrement{}), hasFullText(t), compressed(t):
+			return AlgorithmInplace
+		}
+		return AlgorithmInstant
+	case *schema.DropColumn:
+		var x schema.GeneratedExpr
+		switch {
+		case sqlx.Has(change.C.Attrs, &x):
+			if storedOrVirtual(x.Type) == stored {
+				return AlgorithmInplace
+			}
+			return AlgorithmInstant
+		case c.SupportsInstantDropColumn() && !hasFullText(t) && !compressed(t):
+			return AlgorithmInstant
+		}
+		return AlgorithmInplace
+	case *schema.RenameColumn:
+		return c.renameAlgorithm()
+	case *schema.ModifyColumn:
+		return c.modifyColumnAlgorithm(t, change)
+	case *schema.AddIndex, *schema.DropIndex, *schema.ModifyIndex, *schema.RenameIndex,
+		*schema.AddPrimaryKey, *schema.ModifyPrimaryKey, *schema.DropForeignKey, *schema.DropCheck:
+		return AlgorithmInplace
+	case *schema.ModifyCheck:
+		// Dropping the enforcement does not require validating the table rows.
+		if !sqlx.Has(change.From.Attrs, &Enforced{}) && sqlx.Has(change.To.Attrs, &Enforced{}) {
+			return AlgorithmInplace
+		}
+		return AlgorithmCopy
+	case *schema.AddAttr:
+		return attrAlgorithm(change.A)
+	case *schema.DropAttr:
+		return attrAlgorithm(change.A)
+	case *schema.ModifyAttr:
+		return attrAlgorithm(change.To)
+	default:
+		// Adding a foreign key (with foreign_key_checks enabled), adding a CHECK
+		// constraint or dropping the primary key requires copying the table.
+		return AlgorithmCopy
+	}
+}
+
+// renameAlgorithm returns the algorithm used for renaming columns.
+func (c *conn) renameAlgorithm() string {
+	if c.SupportsInstantRenameColumn() {
+		return AlgorithmInstant
+	}
+	return AlgorithmInplace
+}
+
+// modifyColumnAlgorithm returns the algorithm used for modifying a column.
+func (c *conn) modifyColumnAlgorithm(t *schema.Table, m *schema.ModifyColumn) string {
+	k := m.Change
+	if k.Is(schema.ChangeCharset | schema.ChangeCollate | schema.ChangeGenerated | schema.ChangeAttr) {
+		return AlgorithmCopy
+	}
+	alg := AlgorithmInstant
+	if m.From.Name != m.To.Name {
+		alg = c.renameAlgorithm()
+	}
+	if k.Is(schema.ChangeType) {
+		if alg = weakest(alg, c.typeAlgorithm(t, m.From, m.To)); alg == AlgorithmCopy {
+			return alg
+		}
+	}
+	if k.Is(schema.ChangeNull | schema.ChangeComment) {
+		alg = AlgorithmInplace
+	}
+	return alg
+}
+
+// typeAlgorithm returns the algorithm used for changing the type of a column.
+func (c *conn) typeAlgorithm(t *schema.Table, from, to *schema.Column) string {
+	switch fromT := from.Type.Type.(type) {
+	// Appending members to the end of ENUM or SET
+	// columns is instant, as long as the storage
+	// size of the column is not changed.
+	case *schema.EnumType:
+		toT, ok := to.Type.Type.(*schema.EnumType)
+		if ok && valuesAppended(fromT.Values, toT.Values) && (len(fromT.Values) > 255) == (len(toT.Values) > 255) {
+			return AlgorithmInstant
+		}
+	case *SetType:
+		toT, ok := to.Type.Type.(*SetType)
+		if ok && valuesAppended(fromT.Values, toT.Values) && setBytes(len(fromT.Values)) == setBytes(len(toT.Values)) {
+			return AlgorithmInstant
+		}
+	// Extending the size of VARCHAR columns is done in place, as long as
+	// the number of length bytes is not changed (lengths of 0 to 255 bytes
+	// require one length byte, and bigger ones require two).
+	case *schema.StringType:
+		toT, ok := to.Type.Type.(*schema.StringType)
+		if ok && strings.EqualFold(fromT.T, TypeVarchar) && strings.EqualFold(toT.T, TypeVarchar) && fromT.Size <= toT.Size {
+			n := c.maxCharLen(t, from)
+			if (fromT.Size*n > 255) == (toT.Size*n > 255) {
+				return AlgorithmInplace
+			}
+		}
+	}
+	return AlgorithmCopy
+}
+
+// maxCharLen returns the maximum number of bytes a character of the column can take.
+func (c *conn) maxCharLen(t *schema.Table, col *schema.Column) int {
+	cs := schema.Charset{V: c.character(t)}
+	sqlx.Has(col.Attrs, &cs)
+	switch strings.ToLower(cs.V) {
+	case "latin1", "ascii", "binary":
+		return 1
+	case "utf8", "utf8mb3":
+		return 3
+	default:
+		return 4
+	}
+}
+
+// attrAlgorithm returns the algorithm used for changing the given table attribute.
+func attrAlgorithm(a schema.Attr) string {
+	switch a.(type) {
+	case *AutoIncrement, *schema.Comment, *schema.Charset, *schema.Collation, *CreateOptions:
+		return AlgorithmInplace
+	default:
+		return AlgorithmCopy
+	}
+}
+
+// valuesAppended reports if the "to" values were created
+// by appending zero or more members to the "from" values.
+func valuesAppended(from, to []string) bool {
+	return len(from) <= len(to) && slices.Equal(from, to[:len(from)])
+}
+
+// setBytes returns the storage size of a SET column with n members.
+func setBytes(n int) int {
+	if b := (n + 7) / 8; b < 5 {
+		return b
+	}
+	return 8
+}
+
+// hasFullText reports if the table has a FULLTEXT index.
+func hasFullText(t *schema.Table) bool {
+	return slices.ContainsFunc(t.Indexes, func(idx *schema.Index) bool {
+		var it IndexType
+		return sqlx.Has(idx.Attrs, &it) && strings.EqualFold(it.T, IndexTypeFullText)
+	})
+}
+
+// compressed reports if the table was defined with ROW_FORMAT=COMPRESSED.
+func compressed(t *schema.Table) bool {
+	var opts CreateOptions
+	return sqlx.Has(t.Attrs, &opts) && strings.Contains(strings.ToLower(opts.V), "row_format=compressed")
+}
+
 func (s *state) renameTable(c *schema.RenameTable) {
 	s.append(&migrate.Change{
 		Source:  c,
@@ -516,6 +1026,512 @@ func (s *state) renameTable(c *schema.RenameTable) {
 	})
 }
 
+// addView builds and appends the migrate.Change for creating a view.
+func (s *state) addView(add *schema.AddView) error {
+	s.append(&migrate.Change{
+		Cmd:     s.viewDef(s.Build("CREATE"), add.V).String(),
+		Source:  add,
+		Reverse: s.Build("DROP VIEW").View(add.V).String(),
+		Comment: fmt.Sprintf("create %q view", add.V.Name),
+	})
+	return nil
+}
+
+// dropView builds and appends the migrate.Change for dropping a view.
+func (s *state) dropView(drop *schema.DropView) error {
+	b := s.Build("DROP VIEW")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.View(drop.V).String(),
+		Source:  drop,
+		Reverse: s.viewDef(s.Build("CREATE"), drop.V).String(),
+		Comment: fmt.Sprintf("drop %q view", drop.V.Name),
+	})
+	return nil
+}
+
+// modifyView builds and appends the migrate.Change for bringing the view into its
+// modified state. Views whose definition or columns were changed are replaced using
+// CREATE OR REPLACE, and views whose attributes were changed are altered using ALTER VIEW.
+func (s *state) modifyView(modify *schema.ModifyView) error {
+	from, to := modify.From, modify.To
+	cmd := "ALTER"
+	switch {
+	case sqlx.BodyDefChanged(from.Def, to.Def) || viewColumnsChanged(from, to):
+		cmd = "CREATE OR REPLACE"
+	case len(modify.Changes) == 0:
+		return nil
+	}
+	s.append(&migrate.Change{
+		Cmd:     s.viewDef(s.Build(cmd), to).String(),
+		Source:  modify,
+		Reverse: s.viewDef(s.Build(cmd), from).String(),
+		Comment: fmt.Sprintf("modify %q view", to.Name),
+	})
+	return nil
+}
+
+// renameView builds and appends the migrate.Change for renaming a view.
+func (s *state) renameView(c *schema.RenameView) {
+	s.append(&migrate.Change{
+		Source:  c,
+		Comment: fmt.Sprintf("rename a view from %q to %q", c.From.Name, c.To.Name),
+		Cmd:     s.Build("RENAME TABLE").View(c.From).P("TO").View(c.To).String(),
+		Reverse: s.Build("RENAME TABLE").View(c.To).P("TO").View(c.From).String(),
+	})
+}
+
+// viewDef writes the view characteristics, its identifier, its
+// columns and its definition to the builder.
+func (s *state) viewDef(b *sqlx.Builder, v *schema.View) *sqlx.Builder {
+	if a := viewAlgorithm(v); a.V != ViewAlgorithmUndefined {
+		b.P("ALGORITHM", "=", a.V)
+	}
+	if d := (Definer{}); sqlx.Has(v.Attrs, &d) && d.V != "" {
+		b.P("DEFINER", "=", definerIdent(d.V))
+	}
+	if sec := viewSecurity(v); sec.V != ViewSecurityDefiner {
+		b.P("SQL SECURITY", sec.V)
+	}
+	b.P("VIEW").View(v)
+	if len(v.Columns) > 0 {
+		b.Wrap(func(b *sqlx.Builder) {
+			b.MapComma(v.Columns, func(i int, b *sqlx.Builder) {
+				b.Ident(v.Columns[i].Name)
+			})
+		})
+	}
+	b.P("AS", sqlx.TrimViewExtra(v.Def))
+	if c := viewCheckOption(v); c.V != schema.ViewCheckOptionNone {
+		b.P("WITH", c.V, "CHECK OPTION")
+	}
+	return b
+}
+
+// viewColumnsChanged reports if the columns of the view were renamed,
+// added or removed. Columns are unknown if the view was not normalized.
+func viewColumnsChanged(from, to *schema.View) bool {
+	if len(to.Columns) == 0 {
+		return false
+	}
+	if len(from.Columns) != len(to.Columns) {
+		return true
+	}
+	for i := range from.Columns {
+		if from.Columns[i].Name != to.Columns[i].Name {
+			return true
+		}
+	}
+	return false
+}
+
+// definerIdent returns the quoted account name of the given definer. For example,
+// root@% is returned as `root`@`%`. Special values, like CURRENT_USER, are kept as is.
+func definerIdent(d string) string {
+	if strings.EqualFold(d, "CURRENT_USER") || strings.EqualFold(d, "CURRENT_USER()") || strings.HasPrefix(d, "`") || strings.HasPrefix(d, "'") {
+		return d
+	}
+	b := &sqlx.Builder{QuoteOpening: '`', QuoteClosing: '`'}
+	i := strings.LastIndexByte(d, '@')
+	if i == -1 {
+		return b.Ident(d).String()
+	}
+	return b.Ident(d[:i]).String() + "@" + (&sqlx.Builder{QuoteOpening: '`', QuoteClosing: '`'}).Ident(d[i+1:]).String()
+}
+
+// addFunc builds and appends the migrate.Change for creating a function.
+func (s *state) addFunc(add *schema.AddFunc) error {
+	cmd, err := s.funcDef(add.F)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Cmd:     cmd,
+		Source:  add,
+		Reverse: s.Build("DROP FUNCTION").Func(add.F).String(),
+		Comment: fmt.Sprintf("create %q function", add.F.Name),
+	})
+	return nil
+}
+
+// dropFunc builds and appends the migrate.Change for dropping a function.
+func (s *state) dropFunc(drop *schema.DropFunc) error {
+	reverse, err := s.funcDef(drop.F)
+	if err != nil {
+		return fmt.Errorf("calculate reverse for drop function %q: %w", drop.F.Name, err)
+	}
+	b := s.Build("DROP FUNCTION")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.Func(drop.F).String(),
+		Source:  drop,
+		Reverse: reverse,
+		Comment: fmt.Sprintf("drop %q function", drop.F.Name),
+	})
+	return nil
+}
+
+// modifyFunc builds and appends the migrate.Changes for bringing the function into its
+// modified state. Functions whose definition was changed are dropped and recreated, and
+// functions whose characteristics were changed are altered using ALTER FUNCTION.
+func (s *state) modifyFunc(modify *schema.ModifyFunc) error {
+	changed, err := (&diff{conn: s.conn}).funcChanged(modify.From, modify.To)
+	if err != nil {
+		return err
+	}
+	if changed {
+		if err := s.dropFunc(&schema.DropFunc{F: modify.From}); err != nil {
+			return err
+		}
+		return s.addFunc(&schema.AddFunc{F: modify.To})
+	}
+	if len(modify.Changes) > 0 {
+		s.append(&migrate.Change{
+			Cmd:     s.alterRoutine(s.Build("ALTER FUNCTION").Func(modify.To), modify.Changes, modify.To.Attrs).String(),
+			Source:  modify,
+			Reverse: s.alterRoutine(s.Build("ALTER FUNCTION").Func(modify.From), modify.Changes, modify.From.Attrs).String(),
+			Comment: fmt.Sprintf("modify %q function", modify.To.Name),
+		})
+	}
+	return nil
+}
+
+// addProc builds and appends the migrate.Change for creating a procedure.
+func (s *state) addProc(add *schema.AddProc) error {
+	cmd, err := s.procDef(add.P)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Cmd:     cmd,
+		Source:  add,
+		Reverse: s.Build("DROP PROCEDURE").Proc(add.P).String(),
+		Comment: fmt.Sprintf("create %q procedure", add.P.Name),
+	})
+	return nil
+}
+
+// dropProc builds and appends the migrate.Change for dropping a procedure.
+func (s *state) dropProc(drop *schema.DropProc) error {
+	reverse, err := s.procDef(drop.P)
+	if err != nil {
+		return fmt.Errorf("calculate reverse for drop procedure %q: %w", drop.P.Name, err)
+	}
+	b := s.Build("DROP PROCEDURE")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.Proc(drop.P).String(),
+		Source:  drop,
+		Reverse: reverse,
+		Comment: fmt.Sprintf("drop %q procedure", drop.P.Name),
+	})
+	return nil
+}
+
+// modifyProc builds and appends the migrate.Changes for bringing the procedure into its
+// modified state. Procedures whose definition was changed are dropped and recreated, and
+// procedures whose characteristics were changed are altered using ALTER PROCEDURE.
+func (s *state) modifyProc(modify *schema.ModifyProc) error {
+	changed, err := (&diff{conn: s.conn}).procChanged(modify.From, modify.To)
+	if err != nil {
+		return err
+	}
+	if changed {
+		if err := s.dropProc(&schema.DropProc{P: modify.From}); err != nil {
+			return err
+		}
+		return s.addProc(&schema.AddProc{P: modify.To})
+	}
+	if len(modify.Changes) > 0 {
+		s.append(&migrate.Change{
+			Cmd:     s.alterRoutine(s.Build("ALTER PROCEDURE").Proc(modify.To), modify.Changes, modify.To.Attrs).String(),
+			Source:  modify,
+			Reverse: s.alterRoutine(s.Build("ALTER PROCEDURE").Proc(modify.From), modify.Changes, modify.From.Attrs).String(),
+			Comment: fmt.Sprintf("modify %q procedure", modify.To.Name),
+		})
+	}
+	return nil
+}
+
+// funcDef returns the CREATE FUNCTION statement of the given function.
+func (s *state) funcDef(f *schema.Func) (string, error) {
+	if f.Ret == nil {
+		return "", fmt.Errorf("mysql: missing return type for function %q", f.Name)
+	}
+	ret, err := FormatType(f.Ret)
+	if err != nil {
+		return "", fmt.Errorf("format return type of function %q: %w", f.Name, err)
+	}
+	b := s.definer(s.Build("CREATE"), f.Attrs).P("FUNCTION").Func(f)
+	if err := s.routineArgs(b, f.Args); err != nil {
+		return "", fmt.Errorf("format arguments of function %q: %w", f.Name, err)
+	}
+	b.P("RETURNS", ret)
+	if sqlx.Has(f.Attrs, &Deterministic{}) {
+		b.P("DETERMINISTIC")
+	}
+	return s.routineAttrs(b, f.Attrs).P(s.programBody(f.Body)).String(), nil
+}
+
+// procDef returns the CREATE PROCEDURE statement of the given procedure.
+func (s *state) procDef(p *schema.Proc) (string, error) {
+	b := s.definer(s.Build("CREATE"), p.Attrs).P("PROCEDURE").Proc(p)
+	if err := s.routineArgs(b, p.Args); err != nil {
+		return "", fmt.Errorf("format arguments of procedure %q: %w", p.Name, err)
+	}
+	if sqlx.Has(p.Attrs, &Deterministic{}) {
+		b.P("DETERMINISTIC")
+	}
+	return s.routineAttrs(b, p.Attrs).P(s.programBody(p.Body)).String(), nil
+}
+
+// routineArgs writes the parameter list of a stored routine to the builder.
+func (*state) routineArgs(b *sqlx.Builder, args []*schema.FuncArg) error {
+	if len(args) == 0 {
+		b.P("()")
+		return nil
+	}
+	return b.WrapErr(func(b *sqlx.Builder) error {
+		return b.MapCommaErr(args, func(i int, b *sqlx.Builder) error {
+			if args[i].Type == nil {
+				return fmt.Errorf("missing type for argument %q", args[i].Name)
+			}
+			t, err := FormatType(args[i].Type)
+			if err != nil {
+				return fmt.Errorf("format type of argument %q: %w", args[i].Name, err)
+			}
+			// The argument mode is allowed only for procedures,
+			// and it is written only if it is not the default.
+			if m := funcArgMode(args[i]); m != schema.FuncArgModeIn {
+				b.P(string(m))
+			}
+			b.Ident(args[i].Name).P(t)
+			return nil
+		})
+	})
+}
+
+// routineAttrs writes the characteristics of a stored routine that
+// can be changed using the ALTER FUNCTION/PROCEDURE statements.
+func (s *state) routineAttrs(b *sqlx.Builder, attrs []schema.Attr) *sqlx.Builder {
+	if sec := funcSecurity(attrs); sec.V != FuncSecurityDefiner {
+		b.P("SQL SECURITY", sec.V)
+	}
+	if c := (schema.Comment{}); sqlx.Has(attrs, &c) && c.Text != "" {
+		s.attr(b, &c)
+	}
+	return b
+}
+
+// alterRoutine writes the changed characteristics of a stored routine to the ALTER
+// FUNCTION/PROCEDURE builder. The characteristics are written with their values in
+// the given attributes, and therefore, the builder can be used for both directions.
+func (s *state) alterRoutine(b *sqlx.Builder, changes []schema.Change, attrs []schema.Attr) *sqlx.Builder {
+	for _, c := range changes {
+		var a schema.Attr
+		switch c := c.(type) {
+		case *schema.AddAttr:
+			a = c.A
+		case *schema.ModifyAttr:
+			a = c.To
+		case *schema.DropAttr:
+			a = c.A
+		}
+		switch a.(type) {
+		case *FuncSecurity:
+			b.P("SQL SECURITY", funcSecurity(attrs).V)
+		case *schema.Comment:
+			var c schema.Comment
+			sqlx.Has(attrs, &c)
+			s.attr(b, &c)
+		}
+	}
+	return b
+}
+
+// addTrigger builds and appends the migrate.Change for creating a trigger.
+func (s *state) addTrigger(add *schema.AddTrigger) error {
+	cmd, err := s.triggerDef(add.T)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Cmd:     cmd,
+		Source:  add,
+		Reverse: s.Build("DROP TRIGGER").SchemaResource(add.T.Table.Schema, add.T.Name).String(),
+		Comment: fmt.Sprintf("create trigger %q", add.T.Name),
+	})
+	return nil
+}
+
+// dropTrigger builds and appends the migrate.Change for dropping a trigger.
+func (s *state) dropTrigger(drop *schema.DropTrigger) error {
+	reverse, err := s.triggerDef(drop.T)
+	if err != nil {
+		return fmt.Errorf("calculate reverse for drop trigger %q: %w", drop.T.Name, err)
+	}
+	b := s.Build("DROP TRIGGER")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.SchemaResource(drop.T.Table.Schema, drop.T.Name).String(),
+		Source:  drop,
+		Reverse: reverse,
+		Comment: fmt.Sprintf("drop trigger %q", drop.T.Name),
+	})
+	return nil
+}
+
+// modifyTrigger builds and appends the migrate.Changes for bringing the trigger
+// into its modified state. MySQL does not support altering triggers, and therefore,
+// modified triggers are dropped and recreated.
+func (s *state) modifyTrigger(modify *schema.ModifyTrigger) error {
+	if err := s.dropTrigger(&schema.DropTrigger{T: modify.From}); err != nil {
+		return err
+	}
+	return s.addTrigger(&schema.AddTrigger{T: modify.To})
+}
+
+// triggerDef returns the CREATE TRIGGER statement of the given trigger.
+func (s *state) triggerDef(t *schema.Trigger) (string, error) {
+	switch {
+	case t.Table == nil:
+		return "", fmt.Errorf("mysql: trigger %q is not attached to a table", t.Name)
+	case len(t.Events) != 1:
+		return "", fmt.Errorf("mysql: trigger %q must have exactly one event, got %d", t.Name, len(t.Events))
+	case t.For != "" && t.For != schema.TriggerForRow:
+		return "", fmt.Errorf("mysql: unsupported trigger type %q for trigger %q", t.For, t.Name)
+	}
+	return s.definer(s.Build("CREATE"), t.Attrs).
+		P("TRIGGER").
+		SchemaResource(t.Table.Schema, t.Name).
+		P(strings.ToUpper(string(t.ActionTime)), strings.ToUpper(t.Events[0].Name), "ON").
+		Table(t.Table).
+		P("FOR EACH ROW", s.programBody(t.Body)).
+		String(), nil
+}
+
+// addObject builds and appends the migrate.Change for creating a schema object.
+func (s *state) addObject(add *schema.AddObject) error {
+	e, ok := add.O.(*Event)
+	if !ok {
+		return fmt.Errorf("unsupported object %T", add.O)
+	}
+	s.append(&migrate.Change{
+		Cmd:     s.eventDef("CREATE", e).String(),
+		Source:  add,
+		Reverse: s.Build("DROP EVENT").SchemaResource(e.Schema, e.Name).String(),
+		Comment: fmt.Sprintf("create %q event", e.Name),
+	})
+	return nil
+}
+
+// dropObject builds and appends the migrate.Change for dropping a schema object.
+func (s *state) dropObject(drop *schema.DropObject) error {
+	e, ok := drop.O.(*Event)
+	if !ok {
+		return fmt.Errorf("unsupported object %T", drop.O)
+	}
+	b := s.Build("DROP EVENT")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.SchemaResource(e.Schema, e.Name).String(),
+		Source:  drop,
+		Reverse: s.eventDef("CREATE", e).String(),
+		Comment: fmt.Sprintf("drop %q event", e.Name),
+	})
+	return nil
+}
+
+// modifyObject builds and appends the migrate.Change for modifying a schema object.
+// Events are altered using ALTER EVENT with their full definition, as the omitted
+// clauses keep their existing values.
+func (s *state) modifyObject(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*Event)
+	to, ok2 := modify.To.(*Event)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unsupported object modification %T -> %T", modify.From, modify.To)
+	}
+	s.append(&migrate.Change{
+		Cmd:     s.eventDef("ALTER", to).String(),
+		Source:  modify,
+		Reverse: s.eventDef("ALTER", from).String(),
+		Comment: fmt.Sprintf("modify %q event", to.Name),
+	})
+	return nil
+}
+
+// eventDef returns a builder with the CREATE or ALTER EVENT statement of the given event.
+// Clauses that are optional on CREATE EVENT are written explicitly on ALTER EVENT to
+// reset them.
+func (s *state) eventDef(cmd string, e *Event) *sqlx.Builder {
+	alter := cmd == "ALTER"
+	b := s.definer(s.Build(cmd), e.Attrs)
+	b.P("EVENT").SchemaResource(e.Schema, e.Name).P("ON SCHEDULE")
+	if e.At != nil {
+		b.P("AT", eventExpr(e.At))
+	} else {
+		b.P("EVERY", e.Every)
+		if e.Starts != nil {
+			b.P("STARTS", eventExpr(e.Starts))
+		}
+		if e.Ends != nil {
+			b.P("ENDS", eventExpr(e.Ends))
+		}
+	}
+	switch {
+	case e.Preserve:
+		b.P("ON COMPLETION PRESERVE")
+	case alter:
+		b.P("ON COMPLETION NOT PRESERVE")
+	}
+	if st := eventStatus(e); st != EventStatusEnable || alter {
+		b.P(st)
+	}
+	if c := (schema.Comment{}); sqlx.Has(e.Attrs, &c) && (c.Text != "" || alter) {
+		s.attr(b, &c)
+	}
+	return b.P("DO", s.programBody(e.Body))
+}
+
+// eventExpr returns the SQL representation of an event schedule expression.
+func eventExpr(x schema.Expr) string {
+	switch x := x.(type) {
+	case *schema.Literal:
+		return quote(x.V)
+	case *schema.RawExpr:
+		return x.X
+	}
+	return ""
+}
+
+// definer writes the DEFINER clause to the builder, if it was set explicitly.
+func (*state) definer(b *sqlx.Builder, attrs []schema.Attr) *sqlx.Builder {
+	if d := (Definer{}); sqlx.Has(attrs, &d) && d.V != "" {
+		b.P("DEFINER", "=", definerIdent(d.V))
+	}
+	return b
+}
+
+// programBody returns the body of the stored program (e.g., a function or a trigger)
+// for the CREATE statement. Plans that contain compound statements (i.e., with multiple
+// statements separated by semicolons) are delimited with a custom delimiter.
+func (s *state) programBody(body string) string {
+	if body = programBody(body); strings.Contains(body, ";") {
+		s.Delimiter = programDelimiter
+	}
+	return body
+}
+
 func (s *state) column(b *sqlx.Builder, t *schema.Table, c *schema.Column) error {
 	typ, err := FormatType(c.Type.Type)
 	if err != nil {
@@ -693,12 +1709,12 @@ func (s *state) tableAttrs(b *sqlx.Builder, c schema.Change, attrs ...schema.Att
 
 // character returns the table character-set from its attributes
 // or from the default defined in the schema or the database.
-func (s *state) character(t *schema.Table) string {
-	var c schema.Charset
-	if sqlx.Has(t.Attrs, &c) || t.Schema != nil && sqlx.Has(t.Schema.Attrs, &c) {
-		return c.V
+func (c *conn) character(t *schema.Table) string {
+	var cs schema.Charset
+	if sqlx.Has(t.Attrs, &cs) || t.Schema != nil && sqlx.Has(t.Schema.Attrs, &cs) {
+		return cs.V
 	}
-	return s.charset
+	return c.charset
 }
 
 // collation returns the table collation from its attributes
@@ -1135,6 +1135,373 @@ func TestPlanChanges(t *testing.T) {
 				},
 			},
 		},
+		// Views are created after the tables they depend on, and dropped before them.
+		{
+			changes: func() []schema.Change {
+				users := schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "int"))
+				pets := schema.NewTable("pets").AddColumns(schema.NewIntColumn("id", "int"))
+				return []schema.Change{
+					&schema.AddView{
+						V: schema.NewView("v1", "SELECT `id` FROM `users`").
+							AddColumns(schema.NewIntColumn("id", "int")).
+							AddAttrs(&ViewAlgorithm{V: ViewAlgorithmMerge}, &Definer{V: "admin@%"}, &ViewSecurity{V: ViewSecurityInvoker}).
+							SetCheckOption(schema.ViewCheckOptionCascaded).
+							AddDeps(users),
+					},
+					&schema.AddTable{T: users},
+					&schema.DropTable{T: pets},
+					&schema.DropView{V: schema.NewView("v2", "SELECT `id` FROM `pets`").AddDeps(pets), Extra: []schema.Clause{&schema.IfExists{}}},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "CREATE TABLE `users` (`id` int NOT NULL)",
+						Reverse: "DROP TABLE `users`",
+					},
+					{
+						Cmd:     "CREATE ALGORITHM = MERGE DEFINER = `admin`@`%` SQL SECURITY INVOKER VIEW `v1` (`id`) AS SELECT `id` FROM `users` WITH CASCADED CHECK OPTION",
+						Reverse: "DROP VIEW `v1`",
+					},
+					{
+						Cmd:     "DROP VIEW IF EXISTS `v2`",
+						Reverse: "CREATE VIEW `v2` AS SELECT `id` FROM `pets`",
+					},
+					{
+						Cmd:     "DROP TABLE `pets`",
+						Reverse: "CREATE TABLE `pets` (`id` int NOT NULL)",
+					},
+				},
+			},
+		},
+		// Views with changed definitions are replaced, and views with changed attributes are altered.
+		{
+			changes: []schema.Change{
+				&schema.ModifyView{
+					From: schema.NewView("v1", "SELECT 1"),
+					To:   schema.NewView("v1", "SELECT 2"),
+				},
+				&schema.ModifyView{
+					From: schema.NewView("v2", "SELECT 1"),
+					To:   schema.NewView("v2", "SELECT 1").AddAttrs(&ViewSecurity{V: ViewSecurityInvoker}),
+					Changes: []schema.Change{
+						&schema.ModifyAttr{From: &ViewSecurity{V: ViewSecurityDefiner}, To: &ViewSecurity{V: ViewSecurityInvoker}},
+					},
+				},
+				&schema.RenameView{From: schema.NewView("v3", "SELECT 3"), To: schema.NewView("v4", "SELECT 3")},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "RENAME TABLE `v3` TO `v4`",
+						Reverse: "RENAME TABLE `v4` TO `v3`",
+					},
+					{
+						Cmd:     "CREATE OR REPLACE VIEW `v1` AS SELECT 2",
+						Reverse: "CREATE OR REPLACE VIEW `v1` AS SELECT 1",
+					},
+					{
+						Cmd:     "ALTER SQL SECURITY INVOKER VIEW `v2` AS SELECT 1",
+						Reverse: "ALTER VIEW `v2` AS SELECT 1",
+					},
+				},
+			},
+		},
+		// Stored functions and procedures.
+		{
+			changes: func() []schema.Change {
+				s := schema.New("test")
+				intT := &schema.IntegerType{T: TypeInt}
+				return []schema.Change{
+					&schema.AddFunc{F: &schema.Func{
+						Name:   "add_one",
+						Schema: s,
+						Args:   []*schema.FuncArg{{Name: "a", Type: intT}},
+						Ret:    intT,
+						Body:   "RETURN a + 1",
+						Attrs:  []schema.Attr{&Definer{V: "root@%"}, &Deterministic{}, &schema.Comment{Text: "increment"}},
+					}},
+					&schema.DropFunc{F: &schema.Func{Name: "f2", Schema: s, Ret: intT, Body: "RETURN 2"}, Extra: []schema.Clause{&schema.IfExists{}}},
+					&schema.ModifyProc{
+						From: &schema.Proc{Name: "p1", Schema: s, Body: "SELECT 1"},
+						To:   &schema.Proc{Name: "p1", Schema: s, Body: "SELECT 1", Attrs: []schema.Attr{&FuncSecurity{V: FuncSecurityInvoker}, &schema.Comment{Text: "c"}}},
+						Changes: []schema.Change{
+							&schema.ModifyAttr{From: &FuncSecurity{V: FuncSecurityDefiner}, To: &FuncSecurity{V: FuncSecurityInvoker}},
+							&schema.AddAttr{A: &schema.Comment{Text: "c"}},
+						},
+					},
+					&schema.ModifyProc{
+						From: &schema.Proc{Name: "p2", Schema: s, Args: []*schema.FuncArg{{Name: "a", Type: intT}}, Body: "SELECT a"},
+						To:   &schema.Proc{Name: "p2", Schema: s, Args: []*schema.FuncArg{{Name: "a", Type: intT, Mode: schema.FuncArgModeOut}}, Body: "SELECT 1 INTO a"},
+					},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "CREATE DEFINER = `root`@`%` FUNCTION `test`.`add_one` (`a` int) RETURNS int DETERMINISTIC COMMENT \"increment\" RETURN a + 1",
+						Reverse: "DROP FUNCTION `test`.`add_one`",
+					},
+					{
+						Cmd:     "ALTER PROCEDURE `test`.`p1` SQL SECURITY INVOKER COMMENT \"c\"",
+						Reverse: "ALTER PROCEDURE `test`.`p1` SQL SECURITY DEFINER COMMENT \"\"",
+					},
+					{
+						Cmd:     "DROP PROCEDURE `test`.`p2`",
+						Reverse: "CREATE PROCEDURE `test`.`p2` (`a` int) SELECT a",
+					},
+					{
+						Cmd:     "CREATE PROCEDURE `test`.`p2` (OUT `a` int) SELECT 1 INTO a",
+						Reverse: "DROP PROCEDURE `test`.`p2`",
+					},
+					{
+						Cmd:     "DROP FUNCTION IF EXISTS `test`.`f2`",
+						Reverse: "CREATE FUNCTION `test`.`f2` () RETURNS int RETURN 2",
+					},
+				},
+			},
+		},
+		// Triggers and events. Compound statements are planned with a custom delimiter.
+		{
+			changes: func() []schema.Change {
+				users := schema.NewTable("users").AddColumns(schema.NewIntColumn("id", TypeInt))
+				trigger := func(at schema.TriggerTime, body string) *schema.Trigger {
+					return &schema.Trigger{Name: "t1", Table: users, ActionTime: at, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, For: schema.TriggerForRow, Body: body}
+				}
+				return []schema.Change{
+					&schema.AddTrigger{T: &schema.Trigger{
+						Name: "t2", Table: users, ActionTime: schema.TriggerTimeAfter, Events: []schema.TriggerEvent{schema.TriggerEventDelete},
+						Body: "BEGIN\n  DELETE FROM logs WHERE user_id = OLD.id;\n  SET @n = @n + 1;\nEND", Attrs: []schema.Attr{&Definer{V: "admin@localhost"}},
+					}},
+					&schema.ModifyTrigger{From: trigger(schema.TriggerTimeBefore, "SET NEW.id = 1"), To: trigger(schema.TriggerTimeAfter, "SET @n = 1;")},
+					&schema.AddObject{O: &Event{
+						Name:     "e1",
+						Every:    "1 DAY",
+						Starts:   &schema.Literal{V: "2024-01-01 00:00:00"},
+						Preserve: true,
+						Status:   EventStatusDisable,
+						Body:     "DELETE FROM logs",
+						Attrs:    []schema.Attr{&schema.Comment{Text: "nightly"}},
+					}},
+					&schema.ModifyObject{
+						From: &Event{Name: "e2", At: &schema.Literal{V: "2024-06-01 00:00:00"}, Body: "DELETE FROM logs"},
+						To:   &Event{Name: "e2", At: &schema.RawExpr{X: "CURRENT_TIMESTAMP + INTERVAL 1 HOUR"}, Body: "DELETE FROM logs"},
+					},
+					&schema.DropObject{O: &Event{Name: "e3", Every: "1 HOUR", Body: "DELETE FROM logs"}, Extra: []schema.Clause{&schema.IfExists{}}},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Delimiter:  "//",
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "CREATE DEFINER = `admin`@`localhost` TRIGGER `t2` AFTER DELETE ON `users` FOR EACH ROW BEGIN\n  DELETE FROM logs WHERE user_id = OLD.id;\n  SET @n = @n + 1;\nEND",
+						Reverse: "DROP TRIGGER `t2`",
+					},
+					{
+						Cmd:     "DROP TRIGGER `t1`",
+						Reverse: "CREATE TRIGGER `t1` BEFORE INSERT ON `users` FOR EACH ROW SET NEW.id = 1",
+					},
+					{
+						Cmd:     "CREATE TRIGGER `t1` AFTER INSERT ON `users` FOR EACH ROW SET @n = 1",
+						Reverse: "DROP TRIGGER `t1`",
+					},
+					{
+						Cmd:     "CREATE EVENT `e1` ON SCHEDULE EVERY 1 DAY STARTS \"2024-01-01 00:00:00\" ON COMPLETION PRESERVE DISABLE COMMENT \"nightly\" DO DELETE FROM logs",
+						Reverse: "DROP EVENT `e1`",
+					},
+					{
+						Cmd:     "ALTER EVENT `e2` ON SCHEDULE AT CURRENT_TIMESTAMP + INTERVAL 1 HOUR ON COMPLETION NOT PRESERVE ENABLE DO DELETE FROM logs",
+						Reverse: "ALTER EVENT `e2` ON SCHEDULE AT \"2024-06-01 00:00:00\" ON COMPLETION NOT PRESERVE ENABLE DO DELETE FROM logs",
+					},
+					{
+						Cmd:     "DROP EVENT IF EXISTS `e3`",
+						Reverse: "CREATE EVENT `e3` ON SCHEDULE EVERY 1 HOUR DO DELETE FROM logs",
+					},
+				},
+			},
+		},
+		// Table partitioning. Partitioning operations are not combined with other alterations.
+		{
+			changes: func() []schema.Change {
+				id, y := schema.NewIntColumn("id", "int"), schema.NewIntColumn("y", "int")
+				logs := schema.NewTable("logs").AddColumns(id, y)
+				logs.AddAttrs(&Partition{
+					T:     PartitionTypeRange,
+					Parts: []*PartitionPart{{C: y}},
+					Sub:   &SubPartition{T: PartitionTypeHash, Parts: []*PartitionPart{{C: id}}, Count: 2},
+					Defs: []*PartitionDef{
+						{Name: "p0", Values: "2020", Attrs: []schema.Attr{&schema.Comment{Text: "archived"}}},
+						{Name: "p1", Values: "MAXVALUE"},
+					},
+				})
+				ranges := func(names ...string) *Partition {
+					p := &Partition{T: PartitionTypeRange, Parts: []*PartitionPart{{X: &schema.RawExpr{X: "year(`created`)"}}}}
+					for i, n := range names {
+						p.Defs = append(p.Defs, &PartitionDef{Name: n, Values: strconv.Itoa(2020 + i)})
+					}
+					return p
+				}
+				events := schema.NewTable("events").AddColumns(schema.NewIntColumn("id", "int"))
+				users := schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "int"))
+				tenant := schema.NewIntColumn("tenant", "int")
+				return []schema.Change{
+					&schema.AddTable{T: logs},
+					&schema.ModifyTable{T: events, Changes: []schema.Change{
+						&schema.AddColumn{C: schema.NewIntColumn("c", "int")},
+						&schema.ModifyAttr{From: ranges("p0", "p1"), To: ranges("p0", "p1", "p2")},
+					}},
+					&schema.ModifyTable{T: events, Changes: []schema.Change{
+						&schema.ModifyAttr{From: ranges("p0", "p1", "p2"), To: &Partition{T: PartitionTypeRange, Parts: ranges().Parts, Defs: []*PartitionDef{{Name: "p0", Values: "2020"}, {Name: "p2", Values: "2022"}}}},
+					}},
+					&schema.ModifyTable{T: users, Changes: []schema.Change{
+						&schema.ModifyAttr{From: &Partition{T: PartitionTypeKey, Count: 2}, To: &Partition{T: PartitionTypeKey, Count: 4}},
+					}},
+					&schema.ModifyTable{T: users, Changes: []schema.Change{
+						&schema.DropColumn{C: tenant},
+						&schema.DropAttr{A: &Partition{T: PartitionTypeHash, Linear: true, Parts: []*PartitionPart{{C: tenant}}, Count: 4}},
+					}},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "CREATE TABLE `logs` (`id` int NOT NULL, `y` int NOT NULL) PARTITION BY RANGE (`y`) SUBPARTITION BY HASH (`id`) SUBPARTITIONS 2 (PARTITION `p0` VALUES LESS THAN (2020) COMMENT \"archived\", PARTITION `p1` VALUES LESS THAN (MAXVALUE))",
+						Reverse: "DROP TABLE `logs`",
+					},
+					{
+						Cmd:     "ALTER TABLE `events` ADD COLUMN `c` int NOT NULL",
+						Reverse: "ALTER TABLE `events` DROP COLUMN `c`",
+					},
+					{
+						Cmd:     "ALTER TABLE `events` ADD PARTITION (PARTITION `p2` VALUES LESS THAN (2022))",
+						Reverse: "ALTER TABLE `events` DROP PARTITION `p2`",
+					},
+					{
+						Cmd:     "ALTER TABLE `events` DROP PARTITION `p1`",
+						Reverse: "ALTER TABLE `events` REORGANIZE PARTITION `p2` INTO (PARTITION `p1` VALUES LESS THAN (2021), PARTITION `p2` VALUES LESS THAN (2022))",
+					},
+					{
+						Cmd:     "ALTER TABLE `users` ADD PARTITION PARTITIONS 2",
+						Reverse: "ALTER TABLE `users` COALESCE PARTITION 2",
+					},
+					// Partitioning is removed before dropping its key column.
+					{
+						Cmd:     "ALTER TABLE `users` REMOVE PARTITIONING",
+						Reverse: "ALTER TABLE `users` PARTITION BY LINEAR HASH (`tenant`) PARTITIONS 4",
+					},
+					{
+						Cmd:     "ALTER TABLE `users` DROP COLUMN `tenant`",
+						Reverse: "ALTER TABLE `users` ADD COLUMN `tenant` int NOT NULL",
+					},
+				},
+			},
+		},
+		// Emit the ALGORITHM clause and split INSTANT changes to separate statements.
+		{
+			version: "8.0.31",
+			changes: func() []schema.Change {
+				users := schema.NewTable("users").
+					AddColumns(
+						schema.NewIntColumn("id", "int"),
+						schema.NewStringColumn("name", "varchar", schema.StringSize(10)),
+						schema.NewIntColumn("old", "int"),
+					)
+				return []schema.Change{
+					&schema.ModifyTable{
+						T: users,
+						Changes: []schema.Change{
+							&schema.AddColumn{C: schema.NewNullIntColumn("a", "int")},
+							&schema.AddIndex{I: schema.NewIndex("name").AddColumns(users.Columns[1])},
+							&schema.DropColumn{C: users.Columns[2]},
+							&schema.ModifyColumn{
+								From:   users.Columns[0],
+								To:     schema.NewIntColumn("id", "bigint"),
+								Change: schema.ChangeType,
+							},
+						},
+						Extra: []schema.Clause{
+							&AlterAlgorithm{Emit: true, Split: true},
+						},
+					},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "ALTER TABLE `users` ADD COLUMN `a` int NULL, ALGORITHM=INSTANT",
+						Reverse: "ALTER TABLE `users` DROP COLUMN `a`, ALGORITHM=INSTANT",
+					},
+					{
+						Cmd:     "ALTER TABLE `users` ADD INDEX `name` (`name`), MODIFY COLUMN `id` bigint NOT NULL, ALGORITHM=COPY",
+						Reverse: "ALTER TABLE `users` MODIFY COLUMN `id` int NOT NULL, DROP INDEX `name`, ALGORITHM=COPY",
+					},
+					{
+						Cmd:     "ALTER TABLE `users` DROP COLUMN `old`, ALGORITHM=INSTANT",
+						Reverse: "ALTER TABLE `users` ADD COLUMN `old` int NOT NULL, ALGORITHM=INSTANT",
+					},
+				},
+			},
+		},
+		// Columns cannot be dropped instantly before MySQL 8.0.29.
+		{
+			version: "8.0.16",
+			changes: []schema.Change{
+				&schema.ModifyTable{
+					T: schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "int")),
+					Changes: []schema.Change{
+						&schema.AddColumn{C: schema.NewNullIntColumn("a", "int")},
+						&schema.DropColumn{C: schema.NewIntColumn("b", "int")},
+					},
+					Extra: []schema.Clause{
+						&AlterAlgorithm{Emit: true, Split: true},
+					},
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "ALTER TABLE `users` ADD COLUMN `a` int NULL, ALGORITHM=INSTANT",
+						Reverse: "ALTER TABLE `users` DROP COLUMN `a`, ALGORITHM=INPLACE",
+					},
+					{
+						Cmd:     "ALTER TABLE `users` DROP COLUMN `b`, ALGORITHM=INPLACE",
+						Reverse: "ALTER TABLE `users` ADD COLUMN `b` int NOT NULL, ALGORITHM=INSTANT",
+					},
+				},
+			},
+		},
+		// MySQL 5.7 does not support the INSTANT algorithm.
+		{
+			version: "5.7",
+			changes: []schema.Change{
+				&schema.ModifyTable{
+					T: schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "int")),
+					Changes: []schema.Change{
+						&schema.AddColumn{C: schema.NewNullIntColumn("a", "int")},
+						&schema.DropColumn{C: schema.NewIntColumn("b", "int")},
+					},
+					Extra: []schema.Clause{
+						&AlterAlgorithm{Emit: true, Split: true},
+					},
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     "ALTER TABLE `users` ADD COLUMN `a` int NULL, DROP COLUMN `b`, ALGORITHM=INPLACE",
+						Reverse: "ALTER TABLE `users` ADD COLUMN `b` int NOT NULL, DROP COLUMN `a`, ALGORITHM=INPLACE",
+					},
+				},
+			},
+		},
 		// Empty qualifier in multi-schema mode should fail.
 		{
 			changes: []schema.Change{
@@ -1163,6 +1530,7 @@ func TestPlanChanges(t *testing.T) {
 			require.NotNil(t, plan)
 			require.Equal(t, tt.wantPlan.Reversible, plan.Reversible)
 			require.Equal(t, tt.wantPlan.Transactional, plan.Transactional)
+			require.Equal(t, tt.wantPlan.Delimiter, plan.Delimiter)
 			require.Equal(t, len(tt.wantPlan.Changes), len(plan.Changes))
 			for i, c := range plan.Changes {
 				require.Equal(t, tt.wantPlan.Changes[i].Cmd, c.Cmd)
@@ -6,6 +6,7 @@ package mysqlcheck
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -27,6 +28,8 @@ var (
 	codeImplicitUpdate = sqlcheck.Code("MY101")
 	// codeInlineRef is a MySQL specific code for reporting columns with inline references.
 	codeInlineRef = sqlcheck.Code("MY102")
+	// codeTableCopy is a MySQL specific code for reporting changes that require copying the table.
+	codeTableCopy = sqlcheck.Code("MY103")
 )
 
 func addNotNull(p *datadepend.ColumnPass) (diags []sqlcheck.Diagnostic, err error) {
@@ -203,6 +206,118 @@ func inlineRefs(_ context.Context, p *sqlcheck.Pass) error {
 	return nil
 }
 
+// TableCopy is an analyzer that detects table changes that cannot be executed
+// in place (or instantly) by the database, and require copying the table. Copying
+// the table blocks concurrent writes and might take long on large tables.
+//
+// The analyzer reports warnings by default, and can be configured by the table_copy
+// block in the mysql section of the lint configuration. For example, failing on changes
+// that require a table copy:
+//
+//	lint {
+//	  mysql {
+//	    table_copy {
+//	      error = true
+//	    }
+//	  }
+//	}
+//
+// Or disabling the analyzer by setting "skip = true".
+//
+// Note that the algorithm is classified according to the version of the dev
+// database, and changes that are executed in place on the dev database might
+// require a table copy on a target database that runs an older version.
+type TableCopy struct {
+	sqlcheck.Options
+}
+
+// NewTableCopy creates a new table copy Analyzer with the given options.
+// A nil Analyzer is returned in case the analyzer was disabled.
+func NewTableCopy(r *schemahcl.Resource) (*TableCopy, error) {
+	az := &TableCopy{}
+	r, ok := r.Resource(mysql.DriverName)
+	if !ok {
+		return az, nil
+	}
+	if r, ok = r.Resource(az.Name()); !ok {
+		return az, nil
+	}
+	if err := r.As(&az.Options); err != nil {
+		return nil, fmt.Errorf("sql/mysql/mysqlcheck: parsing table_copy check options: %w", err)
+	}
+	if a, ok := r.Attr("skip"); ok {
+		skip, err := a.Bool()
+		if err != nil {
+			return nil, fmt.Errorf("sql/mysql/mysqlcheck: parsing table_copy skip option: %w", err)
+		}
+		if skip {
+			return nil, nil
+		}
+	}
+	return az, nil
+}
+
+// Name of the analyzer. Implements the sqlcheck.NamedAnalyzer interface.
+func (*TableCopy) Name() string {
+	return "table_copy"
+}
+
+// Analyze implements sqlcheck.Analyzer.
+func (a *TableCopy) Analyze(_ context.Context, p *sqlcheck.Pass) error {
+	drv, ok := p.Dev.Driver.(*mysql.Driver)
+	if !ok {
+		return nil
+	}
+	var diags []sqlcheck.Diagnostic
+	for _, sc := range p.File.Changes {
+		for _, c := range sc.Changes {
+			m, ok := c.(*schema.ModifyTable)
+			if !ok {
+				continue
+			}
+			for _, mc := range m.Changes {
+				if drv.Algorithm(&schema.ModifyTable{T: m.T, Changes: []schema.Change{mc}}) == mysql.AlgorithmCopy {
+					diags = append(diags, sqlcheck.Diagnostic{
+						Pos:  sc.Stmt.Pos,
+						Code: codeTableCopy,
+						Text: fmt.Sprintf("%s on table %q requires a table copy (ALGORITHM=COPY)", changeName(mc), m.T.Name),
+					})
+				}
+			}
+		}
+	}
+	if len(diags) > 0 {
+		const reportText = "table copy detected"
+		p.Reporter.WriteReport(sqlcheck.Report{Text: reportText, Diagnostics: diags})
+		if sqlx.V(a.Error) {
+			return errors.New(reportText)
+		}
+	}
+	return nil
+}
+
+// changeName returns a short description of the given table change.
+func changeName(c schema.Change) string {
+	switch c := c.(type) {
+	case *schema.AddColumn:
+		return fmt.Sprintf("Adding column %q", c.C.Name)
+	case *schema.DropColumn:
+		return fmt.Sprintf("Dropping column %q", c.C.Name)
+	case *schema.ModifyColumn:
+		return fmt.Sprintf("Modifying column %q", c.From.Name)
+	case *schema.AddForeignKey:
+		return fmt.Sprintf("Adding foreign key %q", c.F.Symbol)
+	case *schema.AddCheck:
+		return fmt.Sprintf("Adding check constraint %q", c.C.Name)
+	case *schema.ModifyCheck:
+		return fmt.Sprintf("Modifying check constraint %q", c.From.Name)
+	case *schema.DropPrimaryKey:
+		return "Dropping the primary key"
+	default:
+		return "Changing the table definition"
+	}
+}
+
 func analyzers(r *schemahcl.Resource) ([]sqlcheck.Analyzer, error) {
 	ds, err := destructive.New(r)
 	if err != nil {
@@ -222,5 +337,13 @@ func analyzers(r *schemahcl.Resource) ([]sqlcheck.Analyzer, error) {
 	if err != nil {
 		return nil, err
 	}
-	return []sqlcheck.Analyzer{ds, dd, cd, bc, sqlcheck.AnalyzerFunc(inlineRefs)}, nil
+	tc, err := NewTableCopy(r)
+	if err != nil {
+		return nil, err
+	}
+	azs := []sqlcheck.Analyzer{ds, dd, cd, bc, sqlcheck.AnalyzerFunc(inlineRefs)}
+	if tc != nil {
+		azs = append(azs, tc)
+	}
+	return azs, nil
 }
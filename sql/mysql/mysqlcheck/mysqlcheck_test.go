@@ -8,10 +8,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/veiloq/atlas/schemahcl"
 	"github.com/veiloq/atlas/sql/internal/sqltest"
 	"github.com/veiloq/atlas/sql/migrate"
 	"github.com/veiloq/atlas/sql/mysql"
-	_ "github.com/veiloq/atlas/sql/mysql/mysqlcheck"
+	"github.com/veiloq/atlas/sql/mysql/mysqlcheck"
 	"github.com/veiloq/atlas/sql/schema"
 	"github.com/veiloq/atlas/sql/sqlcheck"
 	"github.com/veiloq/atlas/sql/sqlclient"
@@ -230,6 +231,92 @@ func TestDataDepend_Maria_ImplicitUpdate(t *testing.T) {
 
 }
 
+func TestTableCopy(t *testing.T) {
+	var (
+		report *sqlcheck.Report
+		users  = schema.NewTable("users").
+			SetSchema(schema.New("test")).
+			AddColumns(
+				schema.NewIntColumn("id", mysql.TypeInt),
+				schema.NewStringColumn("name", mysql.TypeVarchar, schema.StringSize(10)),
+			)
+		pass = &sqlcheck.Pass{
+			Dev: &sqlclient.Client{
+				Name:   "mysql",
+				Driver: devDriver(t, "8.0.31"),
+			},
+			File: &sqlcheck.File{
+				File: testFile{name: "1.sql"},
+				Changes: []*sqlcheck.Change{
+					{
+						Stmt: &migrate.Stmt{
+							Text: "ALTER TABLE users",
+						},
+						Changes: schema.Changes{
+							&schema.ModifyTable{
+								T: users,
+								Changes: []schema.Change{
+									&schema.AddColumn{C: schema.NewNullIntColumn("a", mysql.TypeInt)},
+									&schema.AddIndex{I: schema.NewIndex("name").AddColumns(users.Columns[1])},
+									&schema.ModifyColumn{
+										From:   users.Columns[0],
+										To:     schema.NewIntColumn("id", mysql.TypeBigInt),
+										Change: schema.ChangeType,
+									},
+									&schema.ModifyColumn{
+										From:   users.Columns[1],
+										To:     schema.NewStringColumn("name", mysql.TypeVarchar, schema.StringSize(20)),
+										Change: schema.ChangeType,
+									},
+									&schema.AddCheck{C: schema.NewCheck().SetName("positive").SetExpr("id > 0")},
+								},
+							},
+						},
+					},
+				},
+			},
+			Reporter: sqlcheck.ReportWriterFunc(func(r sqlcheck.Report) {
+				report = &r
+			}),
+		}
+	)
+	config := func(attrs ...*schemahcl.Attr) *schemahcl.Resource {
+		return &schemahcl.Resource{
+			Children: []*schemahcl.Resource{
+				{
+					Type: "mysql",
+					Children: []*schemahcl.Resource{
+						{Type: "table_copy", Attrs: attrs},
+					},
+				},
+			},
+		}
+	}
+	// Enabled by default.
+	for _, r := range []*schemahcl.Resource{nil, config()} {
+		report = nil
+		azs, err := sqlcheck.AnalyzerFor(mysql.DriverName, r)
+		require.NoError(t, err)
+		require.NoError(t, sqlcheck.Analyzers(azs).Analyze(context.Background(), pass))
+		require.Equal(t, "table copy detected", report.Text)
+		require.Len(t, report.Diagnostics, 2)
+		require.Equal(t, `Modifying column "id" on table "users" requires a table copy (ALGORITHM=COPY)`, report.Diagnostics[0].Text)
+		require.Equal(t, `Adding check constraint "positive" on table "users" requires a table copy (ALGORITHM=COPY)`, report.Diagnostics[1].Text)
+	}
+
+	// Disabled by configuration.
+	report = nil
+	azs, err := sqlcheck.AnalyzerFor(mysql.DriverName, config(schemahcl.BoolAttr("skip", true)))
+	require.NoError(t, err)
+	require.NoError(t, sqlcheck.Analyzers(azs).Analyze(context.Background(), pass))
+	require.Nil(t, report)
+
+	az, err := mysqlcheck.NewTableCopy(config(schemahcl.BoolAttr("error", true)))
+	require.NoError(t, err)
+	require.Equal(t, "table_copy", az.Name())
+	require.EqualError(t, az.Analyze(context.Background(), pass), "table copy detected")
+}
+
 type testFile struct {
 	name string
 	migrate.File
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/veiloq/atlas/schemahcl"
@@ -22,6 +23,66 @@ import (
 	"github.com/zclconf/go-cty/cty"
 )
 
+type (
+	doc struct {
+		Tables   []*sqlspec.Table   `spec:"table"`
+		Views    []*sqlspec.View    `spec:"view"`
+		Funcs    []*sqlspec.Func    `spec:"function"`
+		Procs    []*sqlspec.Func    `spec:"procedure"`
+		Triggers []*sqlspec.Trigger `spec:"trigger"`
+		Events   []*event           `spec:"event"`
+		Schemas  []*sqlspec.Schema  `spec:"schema"`
+	}
+
+	// event holds a specification for a scheduled event.
+	event struct {
+		Name      string         `spec:",name"`
+		Qualifier string         `spec:",qualifier"`
+		Schema    *schemahcl.Ref `spec:"schema"`
+		// The schedule, the characteristics and the
+		// definition are appended after the schema.
+		schemahcl.DefaultExtension
+	}
+)
+
+// merge merges the doc d1 into d.
+func (d *doc) merge(d1 *doc) {
+	d.Tables = append(d.Tables, d1.Tables...)
+	d.Views = append(d.Views, d1.Views...)
+	d.Funcs = append(d.Funcs, d1.Funcs...)
+	d.Procs = append(d.Procs, d1.Procs...)
+	d.Triggers = append(d.Triggers, d1.Triggers...)
+	d.Events = append(d.Events, d1.Events...)
+	d.Schemas = append(d.Schemas, d1.Schemas...)
+}
+
+func (d *doc) ScanDoc() *specutil.ScanDoc {
+	return &specutil.ScanDoc{
+		Schemas:  d.Schemas,
+		Tables:   d.Tables,
+		Views:    d.Views,
+		Funcs:    d.Funcs,
+		Procs:    d.Procs,
+		Triggers: d.Triggers,
+	}
+}
+
+// Label returns the defaults label used for the event resource.
+func (e *event) Label() string { return e.Name }
+
+// QualifierLabel returns the qualifier label used for the event resource, if any.
+func (e *event) QualifierLabel() string { return e.Qualifier }
+
+// SetQualifier sets the qualifier label used for the event resource.
+func (e *event) SetQualifier(q string) { e.Qualifier = q }
+
+// SchemaRef returns the schema reference for the event.
+func (e *event) SchemaRef() *schemahcl.Ref { return e.Schema }
+
+func init() {
+	schemahcl.Register("event", &event{})
+}
+
 // Codec for schemahcl.
 type Codec struct {
 	State *schemahcl.State
@@ -36,16 +97,16 @@ func (c *Codec) Eval(p *hclparse.Parser, v any, input map[string]cty.Value) erro
 func (c *Codec) EvalOptions(p *hclparse.Parser, v any, opts *schemahcl.EvalOptions) error {
 	switch v := v.(type) {
 	case *schema.Realm:
-		var d specutil.Doc
+		var d doc
 		if err := c.State.EvalOptions(p, &d, opts); err != nil {
 			return err
 		}
-		if err := specutil.Scan(v,
-			&specutil.ScanDoc{Schemas: d.Schemas, Tables: d.Tables, Views: d.Views, Funcs: d.Funcs, Procs: d.Procs, Triggers: d.Triggers},
-			scanFuncs,
-		); err != nil {
+		if err := specutil.Scan(v, d.ScanDoc(), scanFuncs); err != nil {
 			return fmt.Errorf("mysql: failed converting to *schema.Realm: %w", err)
 		}
+		if err := convertEvents(d.Events, v); err != nil {
+			return err
+		}
 		for _, spec := range d.Schemas {
 			s, ok := v.Schema(spec.Name)
 			if !ok {
@@ -56,7 +117,7 @@ func (c *Codec) EvalOptions(p *hclparse.Parser, v any, opts *schemahcl.EvalOptio
 			}
 		}
 	case *schema.Schema:
-		var d specutil.Doc
+		var d doc
 		if err := c.State.EvalOptions(p, &d, opts); err != nil {
 			return err
 		}
@@ -64,10 +125,10 @@ func (c *Codec) EvalOptions(p *hclparse.Parser, v any, opts *schemahcl.EvalOptio
 			return fmt.Errorf("mysql: expecting document to contain a single schema, got %d", len(d.Schemas))
 		}
 		r := &schema.Realm{}
-		if err := specutil.Scan(r,
-			&specutil.ScanDoc{Schemas: d.Schemas, Tables: d.Tables, Views: d.Views, Funcs: d.Funcs, Procs: d.Procs, Triggers: d.Triggers},
-			scanFuncs,
-		); err != nil {
+		if err := specutil.Scan(r, d.ScanDoc(), scanFuncs); err != nil {
+			return err
+		}
+		if err := convertEvents(d.Events, r); err != nil {
 			return err
 		}
 		if err := convertCharset(d.Schemas[0], &r.Schemas[0].Attrs); err != nil {
@@ -84,14 +145,52 @@ func (c *Codec) EvalOptions(p *hclparse.Parser, v any, opts *schemahcl.EvalOptio
 
 // MarshalSpec marshals v into an Atlas DDL document using a schemahcl.Marshaler.
 func (c *Codec) MarshalSpec(v any) ([]byte, error) {
-	return specutil.Marshal(v, c.State, specutil.RealmFuncs{
-		Schema:   schemaSpec,
-		Triggers: triggersSpec,
-	})
-}
-
-func triggersSpec([]*schema.Trigger, *specutil.Doc) ([]*sqlspec.Trigger, error) {
-	return nil, nil // unimplemented.
+	var (
+		d  doc
+		ts []*schema.Trigger
+	)
+	switch rv := v.(type) {
+	case *schema.Schema:
+		d1, trs, err := schemaSpec(rv)
+		if err != nil {
+			return nil, fmt.Errorf("specutil: failed converting schema to spec: %w", err)
+		}
+		d.merge(d1)
+		ts = trs
+	case *schema.Realm:
+		for _, s := range rv.Schemas {
+			d1, trs, err := schemaSpec(s)
+			if err != nil {
+				return nil, fmt.Errorf("specutil: failed converting schema to spec: %w", err)
+			}
+			d.merge(d1)
+			ts = append(ts, trs...)
+		}
+		if err := specutil.QualifyObjects(d.Tables); err != nil {
+			return nil, err
+		}
+		if err := specutil.QualifyObjects(d.Views); err != nil {
+			return nil, err
+		}
+		if err := specutil.QualifyObjects(d.Funcs); err != nil {
+			return nil, err
+		}
+		if err := specutil.QualifyObjects(d.Procs); err != nil {
+			return nil, err
+		}
+		if err := specutil.QualifyObjects(d.Events); err != nil {
+			return nil, err
+		}
+		if err := specutil.QualifyReferences(d.Tables, rv); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("specutil: failed marshaling spec. %T is not supported", v)
+	}
+	if err := triggersSpec(ts, &d); err != nil {
+		return nil, err
+	}
+	return c.State.MarshalSpec(&d)
 }
 
 var (
@@ -100,6 +199,17 @@ var (
 		schemahcl.WithTypes("table.column.type", registrySpecs),
 		schemahcl.WithTypes("view.column.type", registrySpecs),
 		schemahcl.WithScopedEnums("view.check_option", schema.ViewCheckOptionLocal, schema.ViewCheckOptionCascaded),
+		schemahcl.WithScopedEnums("view.algorithm", ViewAlgorithmUndefined, ViewAlgorithmMerge, ViewAlgorithmTempTable),
+		schemahcl.WithScopedEnums("view.security", ViewSecurityDefiner, ViewSecurityInvoker),
+		schemahcl.WithTypes("function.arg.type", registrySpecs),
+		schemahcl.WithTypes("function.return", registrySpecs),
+		schemahcl.WithTypes("procedure.arg.type", registrySpecs),
+		schemahcl.WithScopedEnums("function.security", FuncSecurityDefiner, FuncSecurityInvoker),
+		schemahcl.WithScopedEnums("procedure.security", FuncSecurityDefiner, FuncSecurityInvoker),
+		schemahcl.WithScopedEnums("procedure.arg.mode", schema.FuncArgModeIn, schema.FuncArgModeOut, schema.FuncArgModeInOut),
+		schemahcl.WithScopedEnums("event.status", EventStatusEnable, EventStatusDisable, specutil.Var(EventStatusDisableOnSlave)),
+		schemahcl.WithScopedEnums("table.partition.type", PartitionTypeRange, specutil.Var(PartitionTypeRangeColumns), PartitionTypeList, specutil.Var(PartitionTypeListColumns), PartitionTypeHash, PartitionTypeKey),
+		schemahcl.WithScopedEnums("table.partition.subpartition.type", PartitionTypeHash, PartitionTypeKey),
 		schemahcl.WithScopedEnums("table.engine", EngineInnoDB, EngineMyISAM, EngineMemory, EngineCSV, EngineNDB),
 		schemahcl.WithScopedEnums("table.index.type", IndexTypeBTree, IndexTypeHash, IndexTypeFullText, IndexTypeSpatial),
 		schemahcl.WithScopedEnums("table.index.parser", IndexParserNGram, IndexParserMeCab),
@@ -138,10 +248,15 @@ var (
 	specFuncs                     = &specutil.SchemaFuncs{
 		Table: tableSpec,
 		View:  viewSpec,
+		Func:  funcSpec,
+		Proc:  procSpec,
 	}
 	scanFuncs = &specutil.ScanFuncs{
-		Table: convertTable,
-		View:  convertView,
+		Table:    convertTable,
+		View:     convertView,
+		Func:     convertFunc,
+		Proc:     convertProc,
+		Triggers: convertTriggers,
 	}
 )
 
@@ -172,9 +287,205 @@ func convertTable(spec *sqlspec.Table, parent *schema.Schema) (*schema.Table, er
 		}
 		t.AddAttrs(&Engine{V: v})
 	}
+	if err := convertPartition(spec.Extra, t); err != nil {
+		return nil, err
+	}
 	return t, nil
 }
 
+// partitionSpec describes the HCL representation of the
+// partitioning or the subpartitioning of a table.
+type partitionSpec struct {
+	Type    string           `spec:"type"`
+	Linear  bool             `spec:"linear"`
+	Columns []*schemahcl.Ref `spec:"columns"`
+	Parts   []*struct {
+		Expr   string         `spec:"expr"`
+		Column *schemahcl.Ref `spec:"column"`
+	} `spec:"by"`
+	Count int            `spec:"partitions"`
+	Sub   *partitionSpec `spec:"subpartition"`
+	Defs  []*struct {
+		Name    string `spec:",name"`
+		Values  string `spec:"values"`
+		Comment string `spec:"comment"`
+	} `spec:"part"`
+}
+
+// convertPartition converts and appends the partition block into the table attributes if exists.
+func convertPartition(spec schemahcl.Resource, table *schema.Table) error {
+	r, ok := spec.Resource("partition")
+	if !ok {
+		return nil
+	}
+	var p partitionSpec
+	if err := r.As(&p); err != nil {
+		return fmt.Errorf("parsing %s.partition: %w", table.Name, err)
+	}
+	key := &Partition{Count: p.Count}
+	parts, err := convertPartitionKey(table, "partition", &p)
+	if err != nil {
+		return err
+	}
+	key.T, key.Linear, key.Parts = specutil.FromVar(p.Type), p.Linear, parts
+	if s := p.Sub; s != nil {
+		if s.Sub != nil || len(s.Defs) > 0 {
+			return fmt.Errorf("unexpected partition definitions in %s.partition.subpartition", table.Name)
+		}
+		parts, err := convertPartitionKey(table, "partition.subpartition", s)
+		if err != nil {
+			return err
+		}
+		key.Sub = &SubPartition{T: specutil.FromVar(s.Type), Linear: s.Linear, Parts: parts, Count: s.Count}
+	}
+	for _, d := range p.Defs {
+		def := &PartitionDef{Name: d.Name, Values: d.Values}
+		if d.Comment != "" {
+			def.Attrs = append(def.Attrs, &schema.Comment{Text: d.Comment})
+		}
+		key.Defs = append(key.Defs, def)
+	}
+	table.AddAttrs(key)
+	return nil
+}
+
+// convertPartitionKey converts the partitioning key parts of the given spec.
+func convertPartitionKey(table *schema.Table, path string, p *partitionSpec) ([]*PartitionPart, error) {
+	if p.Type == "" {
+		return nil, fmt.Errorf("missing attribute %s.%s.type", table.Name, path)
+	}
+	var parts []*PartitionPart
+	switch n, m := len(p.Columns), len(p.Parts); {
+	// KEY partitioning with no columns uses the primary key.
+	case n == 0 && m == 0 && p.Type != PartitionTypeKey:
+		return nil, fmt.Errorf("missing columns or expressions for %s.%s", table.Name, path)
+	case n > 0 && m > 0:
+		return nil, fmt.Errorf(`multiple definitions for %s.%s, use "columns" or "by"`, table.Name, path)
+	case n > 0:
+		for _, r := range p.Columns {
+			c, err := specutil.ColumnByRef(table, r)
+			if err != nil {
+				return nil, err
+			}
+			parts = append(parts, &PartitionPart{C: c})
+		}
+	case m > 0:
+		for i, p := range p.Parts {
+			switch {
+			case p.Column == nil && p.Expr == "":
+				return nil, fmt.Errorf("missing column or expression for %s.%s.by at position %d", table.Name, path, i)
+			case p.Column != nil && p.Expr != "":
+				return nil, fmt.Errorf("multiple definitions for %s.%s.by at position %d", table.Name, path, i)
+			case p.Column != nil:
+				c, err := specutil.ColumnByRef(table, p.Column)
+				if err != nil {
+					return nil, err
+				}
+				parts = append(parts, &PartitionPart{C: c})
+			case p.Expr != "":
+				parts = append(parts, &PartitionPart{X: &schema.RawExpr{X: p.Expr}})
+			}
+		}
+	}
+	return parts, nil
+}
+
+// fromPartition returns the resource spec for representing the partition block.
+func fromPartition(p Partition) *schemahcl.Resource {
+	key := fromPartitionKey("partition", p.T, p.Linear, p.Parts, p.Count)
+	if s := p.Sub; s != nil {
+		key.Children = append(key.Children, fromPartitionKey("subpartition", s.T, s.Linear, s.Parts, s.Count))
+	}
+	for _, d := range p.Defs {
+		def := &schemahcl.Resource{
+			Type:  "part",
+			Name:  d.Name,
+			Attrs: []*schemahcl.Attr{schemahcl.StringAttr("values", d.Values)},
+		}
+		if c := (schema.Comment{}); sqlx.Has(d.Attrs, &c) && c.Text != "" {
+			def.Attrs = append(def.Attrs, schemahcl.StringAttr("comment", c.Text))
+		}
+		key.Children = append(key.Children, def)
+	}
+	return key
+}
+
+// fromPartitionKey returns the resource spec for representing a partitioning key.
+func fromPartitionKey(typ, t string, linear bool, parts []*PartitionPart, count int) *schemahcl.Resource {
+	key := &schemahcl.Resource{
+		Type: typ,
+		Attrs: []*schemahcl.Attr{
+			specutil.VarAttr("type", specutil.Var(strings.ToUpper(t))),
+		},
+	}
+	if linear {
+		key.Attrs = append(key.Attrs, schemahcl.BoolAttr("linear", true))
+	}
+	if count > 0 {
+		key.Attrs = append(key.Attrs, schemahcl.IntAttr("partitions", count))
+	}
+	columns, ok := func() ([]*schemahcl.Ref, bool) {
+		refs := make([]*schemahcl.Ref, 0, len(parts))
+		for _, p := range parts {
+			if p.C == nil {
+				return nil, false
+			}
+			refs = append(refs, specutil.ColumnRef(p.C.Name))
+		}
+		return refs, true
+	}()
+	switch {
+	case len(parts) == 0:
+	case ok:
+		key.Attrs = append(key.Attrs, schemahcl.RefsAttr("columns", columns...))
+	default:
+		for _, p := range parts {
+			part := &schemahcl.Resource{Type: "by"}
+			switch {
+			case p.C != nil:
+				part.Attrs = append(part.Attrs, schemahcl.RefAttr("column", specutil.ColumnRef(p.C.Name)))
+			case p.X != nil:
+				part.Attrs = append(part.Attrs, schemahcl.StringAttr("expr", p.X.(*schema.RawExpr).X))
+			}
+			key.Children = append(key.Children, part)
+		}
+	}
+	return key
+}
+
+// convertView converts a sqlspec.View to a schema.View.
+func convertView(spec *sqlspec.View, parent *schema.Schema) (*schema.View, error) {
+	v, err := specutil.View(
+		spec, parent,
+		func(c *sqlspec.Column, _ *schema.View) (*schema.Column, error) {
+			return specutil.Column(c, convertColumnType)
+		},
+		func(i *sqlspec.Index, _ *schema.View) (*schema.Index, error) {
+			return nil, fmt.Errorf("unexpected index %q on view %q", i.Name, spec.Name)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range []struct {
+		k    string
+		conv func(string) schema.Attr
+	}{
+		{k: "algorithm", conv: func(s string) schema.Attr { return &ViewAlgorithm{V: s} }},
+		{k: "security", conv: func(s string) schema.Attr { return &ViewSecurity{V: s} }},
+		{k: "definer", conv: func(s string) schema.Attr { return &Definer{V: s} }},
+	} {
+		if attr, ok := spec.Attr(a.k); ok {
+			s, err := attr.String()
+			if err != nil {
+				return nil, fmt.Errorf("expect string definition for attribute view.%s.%s: %w", spec.Name, a.k, err)
+			}
+			v.AddAttrs(a.conv(s))
+		}
+	}
+	return v, nil
+}
+
 // convertPK converts a sqlspec.PrimaryKey into a schema.Index.
 func convertPK(spec *sqlspec.PrimaryKey, parent *schema.Table) (*schema.Index, error) {
 	return convertIndex(&sqlspec.Index{
@@ -288,10 +599,10 @@ func convertColumnType(spec *sqlspec.Column) (schema.Type, error) {
 }
 
 // schemaSpec converts from a concrete MySQL schema to Atlas specification.
-func schemaSpec(s *schema.Schema) (*specutil.SchemaSpec, error) {
+func schemaSpec(s *schema.Schema) (*doc, []*schema.Trigger, error) {
 	spec, err := specutil.FromSchema(s, specFuncs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if c, ok := sqlx.Charset(s.Attrs, nil); ok {
 		spec.Schema.Extra.Attrs = append(spec.Schema.Extra.Attrs, schemahcl.StringAttr("charset", c))
@@ -299,7 +610,19 @@ func schemaSpec(s *schema.Schema) (*specutil.SchemaSpec, error) {
 	if c, ok := sqlx.Collate(s.Attrs, nil); ok {
 		spec.Schema.Extra.Attrs = append(spec.Schema.Extra.Attrs, schemahcl.StringAttr("collate", c))
 	}
-	return spec, nil
+	d := &doc{
+		Tables:  spec.Tables,
+		Views:   spec.Views,
+		Funcs:   spec.Funcs,
+		Procs:   spec.Procs,
+		Schemas: []*sqlspec.Schema{spec.Schema},
+	}
+	for _, o := range s.Objects {
+		if e, ok := o.(*Event); ok {
+			d.Events = append(d.Events, eventSpec(spec, e))
+		}
+	}
+	return d, spec.Triggers, nil
 }
 
 // tableSpec converts from a concrete MySQL sqlspec.Table to a schema.Table.
@@ -332,9 +655,338 @@ func tableSpec(t *schema.Table) (*sqlspec.Table, error) {
 		}
 		ts.Extra.Attrs = append(ts.Extra.Attrs, attr)
 	}
+	if p := (Partition{}); sqlx.Has(t.Attrs, &p) {
+		ts.Extra.Children = append(ts.Extra.Children, fromPartition(p))
+	}
 	return ts, nil
 }
 
+// viewSpec converts from a concrete MySQL schema.View to a sqlspec.View.
+func viewSpec(view *schema.View) (*sqlspec.View, error) {
+	spec, err := specutil.FromView(
+		view,
+		func(c *schema.Column, _ *schema.View) (*sqlspec.Column, error) {
+			return specutil.FromColumn(c, columnTypeSpec)
+		},
+		indexSpec,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// The view characteristics are marshaled along
+	// with its definition, after the view columns.
+	embed := spec.Extra.Children[len(spec.Extra.Children)-1]
+	if a := viewAlgorithm(view); a.V != ViewAlgorithmUndefined {
+		embed.Attrs = append(embed.Attrs, specutil.VarAttr("algorithm", a.V))
+	}
+	if s := (ViewSecurity{}); sqlx.Has(view.Attrs, &s) && s.V != "" {
+		embed.Attrs = append(embed.Attrs, specutil.VarAttr("security", strings.ToUpper(s.V)))
+	}
+	if d := (Definer{}); sqlx.Has(view.Attrs, &d) && d.V != "" {
+		embed.Attrs = append(embed.Attrs, schemahcl.StringAttr("definer", d.V))
+	}
+	return spec, nil
+}
+
+// funcSpec converts from a concrete MySQL schema.Func to a sqlspec.Func.
+func funcSpec(f *schema.Func) (*sqlspec.Func, error) {
+	spec, err := specutil.FromFunc(f, columnTypeSpec)
+	if err != nil {
+		return nil, err
+	}
+	funcAttrsSpec(spec, f.Attrs)
+	return spec, nil
+}
+
+// procSpec converts from a concrete MySQL schema.Proc to a sqlspec.Func.
+func procSpec(p *schema.Proc) (*sqlspec.Func, error) {
+	spec, err := specutil.FromProc(p, columnTypeSpec)
+	if err != nil {
+		return nil, err
+	}
+	funcAttrsSpec(spec, p.Attrs)
+	return spec, nil
+}
+
+// funcAttrsSpec sets the characteristics of functions and procedures.
+// The characteristics are placed before the routine definition.
+func funcAttrsSpec(spec *sqlspec.Func, attrs []schema.Attr) {
+	var extra []*schemahcl.Attr
+	if sqlx.Has(attrs, &Deterministic{}) {
+		extra = append(extra, schemahcl.BoolAttr("deterministic", true))
+	}
+	if s := funcSecurity(attrs); s.V != FuncSecurityDefiner {
+		extra = append(extra, specutil.VarAttr("security", s.V))
+	}
+	if d := (Definer{}); sqlx.Has(attrs, &d) && d.V != "" {
+		extra = append(extra, schemahcl.StringAttr("definer", d.V))
+	}
+	i := slices.IndexFunc(spec.Extra.Attrs, func(a *schemahcl.Attr) bool { return a.K == "as" })
+	if i == -1 {
+		i = len(spec.Extra.Attrs)
+	}
+	spec.Extra.Attrs = slices.Insert(spec.Extra.Attrs, i, extra...)
+}
+
+// convertFunc converts a sqlspec.Func to a schema.Func.
+func convertFunc(spec *sqlspec.Func, parent *schema.Schema) (*schema.Func, error) {
+	f, err := specutil.Func(spec, parent, convertColumnType)
+	if err != nil {
+		return nil, err
+	}
+	if err := convertFuncAttrs(spec, &f.Attrs); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// convertProc converts a sqlspec.Func to a schema.Proc.
+func convertProc(spec *sqlspec.Func, parent *schema.Schema) (*schema.Proc, error) {
+	p, err := specutil.Proc(spec, parent, convertColumnType)
+	if err != nil {
+		return nil, err
+	}
+	if err := convertFuncAttrs(spec, &p.Attrs); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// convertFuncAttrs converts the MySQL specific characteristics of functions and procedures.
+func convertFuncAttrs(spec *sqlspec.Func, attrs *[]schema.Attr) error {
+	if a, ok := spec.Extra.Attr("deterministic"); ok {
+		b, err := a.Bool()
+		if err != nil {
+			return fmt.Errorf("mysql: unexpected deterministic value for %q: %w", spec.Name, err)
+		}
+		if b {
+			*attrs = append(*attrs, &Deterministic{})
+		}
+	}
+	if a, ok := spec.Extra.Attr("security"); ok {
+		v, err := a.String()
+		if err != nil {
+			return fmt.Errorf("mysql: unexpected security for %q: %w", spec.Name, err)
+		}
+		switch v = strings.ToUpper(v); v {
+		case FuncSecurityDefiner:
+		case FuncSecurityInvoker:
+			*attrs = append(*attrs, &FuncSecurity{V: v})
+		default:
+			return fmt.Errorf("mysql: unexpected security %q for %q", v, spec.Name)
+		}
+	}
+	return convertDefiner(spec, spec.Name, attrs)
+}
+
+// convertDefiner converts the definer attribute of views and stored programs.
+func convertDefiner(spec specutil.Attrer, name string, attrs *[]schema.Attr) error {
+	if a, ok := spec.Attr("definer"); ok {
+		v, err := a.String()
+		if err != nil {
+			return fmt.Errorf("mysql: unexpected definer for %q: %w", name, err)
+		}
+		*attrs = append(*attrs, &Definer{V: v})
+	}
+	return nil
+}
+
+// triggersSpec converts from concrete MySQL triggers to sqlspec.Trigger.
+func triggersSpec(ts []*schema.Trigger, d *doc) error {
+	for _, t := range ts {
+		if t.Table == nil {
+			return fmt.Errorf("trigger %q is not attached to a table", t.Name)
+		}
+		spec := &sqlspec.Trigger{Name: t.Name, On: specutil.TableSpecRef(t.Table)}
+		timing := &schemahcl.Resource{
+			Type: strings.ToLower(string(t.ActionTime)),
+		}
+		for _, e := range t.Events {
+			timing.Attrs = append(timing.Attrs, schemahcl.BoolAttr(strings.ToLower(e.Name), true))
+		}
+		spec.Extra.Children = append(spec.Extra.Children, timing)
+		if df := (Definer{}); sqlx.Has(t.Attrs, &df) && df.V != "" {
+			spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("definer", df.V))
+		}
+		spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("as", sqlspec.MightHeredoc(t.Body)))
+		d.Triggers = append(d.Triggers, spec)
+	}
+	return nil
+}
+
+// convertTriggers converts the trigger specs and attaches them to their tables.
+func convertTriggers(r *schema.Realm, triggers []*sqlspec.Trigger) error {
+	for _, spec := range triggers {
+		if spec.On == nil {
+			return fmt.Errorf("missing 'on' definition for trigger %q", spec.Name)
+		}
+		o, err := specutil.ObjectByRef(r, spec.On)
+		if err != nil {
+			return fmt.Errorf("find table of trigger %q: %w", spec.Name, err)
+		}
+		tb, ok := o.(*schema.Table)
+		if !ok {
+			return fmt.Errorf("unexpected trigger %q target: %T", spec.Name, o)
+		}
+		t := &schema.Trigger{Name: spec.Name, Table: tb, For: schema.TriggerForRow}
+		for _, at := range []schema.TriggerTime{schema.TriggerTimeBefore, schema.TriggerTimeAfter} {
+			timing, ok := spec.Extra.Resource(strings.ToLower(string(at)))
+			if !ok {
+				continue
+			}
+			if t.ActionTime != "" {
+				return fmt.Errorf("multiple action times defined for trigger %q", spec.Name)
+			}
+			t.ActionTime = at
+			for _, e := range []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventUpdate, schema.TriggerEventDelete} {
+				a, ok := timing.Attr(strings.ToLower(e.Name))
+				if !ok {
+					continue
+				}
+				if b, err := a.Bool(); err != nil {
+					return fmt.Errorf("expect bool value for attribute trigger.%s.%s.%s: %w", spec.Name, timing.Type, a.K, err)
+				} else if b {
+					t.Events = append(t.Events, e)
+				}
+			}
+		}
+		switch {
+		case t.ActionTime == "":
+			return fmt.Errorf("missing action time (before or after) for trigger %q", spec.Name)
+		case len(t.Events) != 1:
+			return fmt.Errorf("expect exactly one event (insert, update or delete) for trigger %q, got %d", spec.Name, len(t.Events))
+		}
+		as, ok := spec.Extra.Attr("as")
+		if !ok {
+			return fmt.Errorf("missing 'as' definition for trigger %q", spec.Name)
+		}
+		if t.Body, err = as.String(); err != nil {
+			return fmt.Errorf("expect string definition for attribute trigger.%s.as: %w", spec.Name, err)
+		}
+		if err := convertDefiner(&spec.Extra, spec.Name, &t.Attrs); err != nil {
+			return err
+		}
+		schemahcl.AppendPos(&t.Attrs, spec.Range)
+		tb.Triggers = append(tb.Triggers, t)
+	}
+	return nil
+}
+
+// eventSpec converts a schema event to its spec. Characteristics
+// that are set to their default values are omitted.
+func eventSpec(spec *specutil.SchemaSpec, e *Event) *event {
+	s := &event{
+		Name:   e.Name,
+		Schema: specutil.SchemaRef(spec.Schema.Name),
+	}
+	if e.At != nil {
+		s.Extra.Attrs = append(s.Extra.Attrs, eventExprAttr("at", e.At))
+	} else {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("every", e.Every))
+		if e.Starts != nil {
+			s.Extra.Attrs = append(s.Extra.Attrs, eventExprAttr("starts", e.Starts))
+		}
+		if e.Ends != nil {
+			s.Extra.Attrs = append(s.Extra.Attrs, eventExprAttr("ends", e.Ends))
+		}
+	}
+	if e.Preserve {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.BoolAttr("preserve", true))
+	}
+	if st := eventStatus(e); st != EventStatusEnable {
+		s.Extra.Attrs = append(s.Extra.Attrs, specutil.VarAttr("status", specutil.Var(st)))
+	}
+	if d := (Definer{}); sqlx.Has(e.Attrs, &d) && d.V != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("definer", d.V))
+	}
+	if c := (schema.Comment{}); sqlx.Has(e.Attrs, &c) && c.Text != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("comment", c.Text))
+	}
+	s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("as", sqlspec.MightHeredoc(e.Body)))
+	return s
+}
+
+// eventExprAttr returns the attribute of an event schedule expression.
+func eventExprAttr(k string, x schema.Expr) *schemahcl.Attr {
+	switch x := x.(type) {
+	case *schema.RawExpr:
+		return schemahcl.RawAttr(k, x.X)
+	case *schema.Literal:
+		return schemahcl.StringAttr(k, x.V)
+	}
+	return nil
+}
+
+// convertEvents converts the event specs and adds them to their schemas.
+func convertEvents(evs []*event, r *schema.Realm) error {
+	for _, spec := range evs {
+		ns, err := specutil.SchemaName(spec.Schema)
+		if err != nil {
+			return fmt.Errorf("extract schema name from event reference: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("schema %q defined on event %q was not found in realm", ns, spec.Name)
+		}
+		e := &Event{Name: spec.Name, Schema: s}
+		for k, v := range map[string]*schema.Expr{"at": &e.At, "starts": &e.Starts, "ends": &e.Ends} {
+			if a, ok := spec.Extra.Attr(k); ok {
+				if *v, err = specutil.Default(a.V); err != nil {
+					return fmt.Errorf("unexpected value for attribute event.%s.%s: %w", spec.Name, k, err)
+				}
+			}
+		}
+		if a, ok := spec.Extra.Attr("every"); ok {
+			if e.Every, err = a.String(); err != nil {
+				return fmt.Errorf("expect string value for attribute event.%s.every: %w", spec.Name, err)
+			}
+		}
+		switch {
+		case e.At == nil && e.Every == "":
+			return fmt.Errorf("missing schedule ('at' or 'every') for event %q", spec.Name)
+		case e.At != nil && (e.Every != "" || e.Starts != nil || e.Ends != nil):
+			return fmt.Errorf("attribute 'at' cannot be combined with 'every', 'starts' or 'ends' for event %q", spec.Name)
+		}
+		if a, ok := spec.Extra.Attr("preserve"); ok {
+			if e.Preserve, err = a.Bool(); err != nil {
+				return fmt.Errorf("expect bool value for attribute event.%s.preserve: %w", spec.Name, err)
+			}
+		}
+		if a, ok := spec.Extra.Attr("status"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute event.%s.status: %w", spec.Name, err)
+			}
+			switch v = strings.ToUpper(specutil.FromVar(v)); v {
+			case EventStatusEnable:
+			case EventStatusDisable, EventStatusDisableOnSlave:
+				e.Status = v
+			default:
+				return fmt.Errorf("unexpected status %q for event %q", v, spec.Name)
+			}
+		}
+		if err := convertDefiner(&spec.Extra, spec.Name, &e.Attrs); err != nil {
+			return err
+		}
+		if a, ok := spec.Extra.Attr("comment"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute event.%s.comment: %w", spec.Name, err)
+			}
+			e.Attrs = append(e.Attrs, &schema.Comment{Text: v})
+		}
+		as, ok := spec.Extra.Attr("as")
+		if !ok {
+			return fmt.Errorf("missing 'as' definition for event %q", spec.Name)
+		}
+		if e.Body, err = as.String(); err != nil {
+			return fmt.Errorf("expect string definition for attribute event.%s.as: %w", spec.Name, err)
+		}
+		s.AddObjects(e)
+	}
+	return nil
+}
+
 func pkSpec(idx *schema.Index) (*sqlspec.PrimaryKey, error) {
 	spec, err := indexSpec(idx)
 	if err != nil {
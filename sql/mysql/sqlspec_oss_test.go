@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/veiloq/atlas/sql/internal/spectest"
+	"github.com/veiloq/atlas/sql/internal/sqlx"
 	"github.com/veiloq/atlas/sql/schema"
 	"github.com/stretchr/testify/require"
 )
@@ -675,6 +676,286 @@ schema "a8m" {}
 	}
 }
 
+func TestMarshalSpec_Views(t *testing.T) {
+	users := schema.NewTable("users").AddColumns(schema.NewIntColumn("id", TypeInt))
+	s := schema.New("a8m").
+		AddTables(users).
+		AddViews(
+			schema.NewView("v1", "select `users`.`id` AS `id` from `users`").
+				AddColumns(schema.NewIntColumn("id", TypeInt)).
+				AddDeps(users),
+			schema.NewView("v2", "select 1 AS `one`").
+				AddAttrs(&ViewAlgorithm{V: ViewAlgorithmTempTable}, &ViewSecurity{V: ViewSecurityInvoker}, &Definer{V: "admin@%"}).
+				SetCheckOption(schema.ViewCheckOptionLocal),
+		)
+	schema.NewRealm(s)
+	buf, err := MarshalHCL(s)
+	require.NoError(t, err)
+	const expected = `table "users" {
+  schema = schema.a8m
+  column "id" {
+    null = false
+    type = int
+  }
+}
+view "v1" {
+  schema = schema.a8m
+  column "id" {
+    null = false
+    type = int
+  }
+  as         = "select ` + "`users`.`id` AS `id` from `users`" + `"
+  depends_on = [table.users]
+}
+view "v2" {
+  schema       = schema.a8m
+  as           = "select 1 AS ` + "`one`" + `"
+  check_option = LOCAL
+  algorithm    = TEMPTABLE
+  security     = INVOKER
+  definer      = "admin@%"
+}
+schema "a8m" {
+}
+`
+	require.EqualValues(t, expected, string(buf))
+}
+
+func TestUnmarshalSpec_Views(t *testing.T) {
+	var (
+		s schema.Schema
+		f = `table "users" {
+  schema = schema.a8m
+  column "id" {
+    type = int
+  }
+}
+view "v1" {
+  schema     = schema.a8m
+  as         = "SELECT id FROM users"
+  depends_on = [table.users]
+}
+view "v2" {
+  schema       = schema.a8m
+  as           = "SELECT 1"
+  check_option = CASCADED
+  algorithm    = MERGE
+  security     = DEFINER
+  definer      = "root@localhost"
+}
+schema "a8m" {}
+`
+	)
+	require.NoError(t, EvalHCLBytes([]byte(f), &s, nil))
+	require.Len(t, s.Views, 2)
+	v1, v2 := s.Views[0], s.Views[1]
+	require.Equal(t, "SELECT id FROM users", v1.Def)
+	require.Equal(t, []schema.Object{s.Tables[0]}, v1.Deps)
+	require.Equal(t, "SELECT 1", v2.Def)
+	var (
+		c schema.ViewCheckOption
+		a ViewAlgorithm
+		e ViewSecurity
+		d Definer
+	)
+	require.True(t, sqlx.Has(v2.Attrs, &c) && sqlx.Has(v2.Attrs, &a) && sqlx.Has(v2.Attrs, &e) && sqlx.Has(v2.Attrs, &d))
+	require.Equal(t, schema.ViewCheckOptionCascaded, c.V)
+	require.Equal(t, ViewAlgorithmMerge, a.V)
+	require.Equal(t, ViewSecurityDefiner, e.V)
+	require.Equal(t, "root@localhost", d.V)
+}
+
+func TestMarshalSpec_Programs(t *testing.T) {
+	var (
+		intT  = &schema.IntegerType{T: TypeInt}
+		users = schema.NewTable("users").AddColumns(schema.NewIntColumn("id", TypeInt))
+		s     = schema.New("a8m").AddTables(users)
+	)
+	users.Triggers = []*schema.Trigger{
+		{Name: "users_bi", Table: users, ActionTime: schema.TriggerTimeBefore, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, For: schema.TriggerForRow, Body: "SET NEW.id = NEW.id + 1", Attrs: []schema.Attr{&Definer{V: "root@%"}}},
+	}
+	s.AddFuncs(&schema.Func{
+		Name:  "add_one",
+		Args:  []*schema.FuncArg{{Name: "a", Type: intT, Mode: schema.FuncArgModeIn}},
+		Ret:   intT,
+		Body:  "RETURN a + 1",
+		Attrs: []schema.Attr{&Deterministic{}, &schema.Comment{Text: "increment"}},
+	})
+	s.AddProcs(&schema.Proc{
+		Name:  "cleanup",
+		Args:  []*schema.FuncArg{{Name: "n", Type: intT, Mode: schema.FuncArgModeOut}},
+		Body:  "SELECT COUNT(*) INTO n FROM users",
+		Attrs: []schema.Attr{&FuncSecurity{V: FuncSecurityInvoker}, &Definer{V: "admin@localhost"}},
+	})
+	s.AddObjects(
+		&Event{Name: "e1", Every: "1 DAY", Starts: &schema.Literal{V: "2024-01-01 00:00:00"}, Preserve: true, Status: EventStatusDisableOnSlave, Body: "CALL cleanup(@n)", Attrs: []schema.Attr{&schema.Comment{Text: "nightly"}}},
+		&Event{Name: "e2", At: &schema.RawExpr{X: "CURRENT_TIMESTAMP + INTERVAL 1 HOUR"}, Body: "DELETE FROM users"},
+	)
+	schema.NewRealm(s)
+	buf, err := MarshalHCL(s)
+	require.NoError(t, err)
+	const expected = `table "users" {
+  schema = schema.a8m
+  column "id" {
+    null = false
+    type = int
+  }
+}
+function "add_one" {
+  schema        = schema.a8m
+  return        = int
+  deterministic = true
+  as            = "RETURN a + 1"
+  comment       = "increment"
+  arg "a" {
+    type = int
+  }
+}
+procedure "cleanup" {
+  schema   = schema.a8m
+  security = INVOKER
+  definer  = "admin@localhost"
+  as       = "SELECT COUNT(*) INTO n FROM users"
+  arg "n" {
+    type = int
+    mode = OUT
+  }
+}
+trigger "users_bi" {
+  on      = table.users
+  definer = "root@%"
+  as      = "SET NEW.id = NEW.id + 1"
+  before {
+    insert = true
+  }
+}
+event "e1" {
+  schema   = schema.a8m
+  every    = "1 DAY"
+  starts   = "2024-01-01 00:00:00"
+  preserve = true
+  status   = DISABLE_ON_SLAVE
+  comment  = "nightly"
+  as       = "CALL cleanup(@n)"
+}
+event "e2" {
+  schema = schema.a8m
+  at     = sql("CURRENT_TIMESTAMP + INTERVAL 1 HOUR")
+  as     = "DELETE FROM users"
+}
+schema "a8m" {
+}
+`
+	require.EqualValues(t, expected, string(buf))
+
+	var got schema.Schema
+	require.NoError(t, EvalHCLBytes(buf, &got, nil))
+	require.Len(t, got.Funcs, 1)
+	require.Equal(t, s.Funcs[0].Body, got.Funcs[0].Body)
+	require.Equal(t, s.Funcs[0].Ret, got.Funcs[0].Ret)
+	require.Equal(t, s.Funcs[0].Args, got.Funcs[0].Args)
+	require.True(t, sqlx.Has(got.Funcs[0].Attrs, &Deterministic{}))
+	require.Len(t, got.Procs, 1)
+	require.Equal(t, s.Procs[0].Args, got.Procs[0].Args)
+	require.Equal(t, FuncSecurityInvoker, funcSecurity(got.Procs[0].Attrs).V)
+	require.Len(t, got.Tables[0].Triggers, 1)
+	tr := got.Tables[0].Triggers[0]
+	require.Equal(t, got.Tables[0], tr.Table)
+	require.Equal(t, schema.TriggerTimeBefore, tr.ActionTime)
+	require.Equal(t, []schema.TriggerEvent{schema.TriggerEventInsert}, tr.Events)
+	require.Equal(t, users.Triggers[0].Body, tr.Body)
+	require.Len(t, got.Objects, 2)
+	e1, e2 := got.Objects[0].(*Event), got.Objects[1].(*Event)
+	require.False(t, eventChanged(s.Objects[0].(*Event), e1))
+	require.Equal(t, EventStatusDisableOnSlave, e1.Status)
+	require.Equal(t, &schema.RawExpr{X: "CURRENT_TIMESTAMP + INTERVAL 1 HOUR"}, e2.At)
+}
+
+func TestUnmarshalSpec_Programs(t *testing.T) {
+	var (
+		s schema.Schema
+		f = `table "users" {
+  schema = schema.a8m
+  column "id" {
+    type = int
+  }
+}
+procedure "cleanup" {
+  schema = schema.a8m
+  arg "max" {
+    type = bigint
+  }
+  arg "n" {
+    type = int
+    mode = INOUT
+  }
+  deterministic = true
+  as            = <<-SQL
+  BEGIN
+    DELETE FROM users WHERE id > max;
+    SET n = ROW_COUNT();
+  END
+  SQL
+}
+trigger "users_au" {
+  on = table.users
+  after {
+    update = true
+  }
+  as = "SET @updated = @updated + 1"
+}
+event "e1" {
+  schema = schema.a8m
+  every  = "'1:30' HOUR_MINUTE"
+  ends   = sql("CURRENT_TIMESTAMP + INTERVAL 1 WEEK")
+  status = DISABLE
+  as     = "CALL cleanup(100, @n)"
+}
+schema "a8m" {}
+`
+	)
+	require.NoError(t, EvalHCLBytes([]byte(f), &s, nil))
+	require.Len(t, s.Procs, 1)
+	p := s.Procs[0]
+	require.Equal(t, []*schema.FuncArg{
+		{Name: "max", Type: &schema.IntegerType{T: TypeBigInt}, Mode: schema.FuncArgModeIn},
+		{Name: "n", Type: &schema.IntegerType{T: TypeInt}, Mode: schema.FuncArgModeInOut},
+	}, p.Args)
+	require.Equal(t, "BEGIN\n  DELETE FROM users WHERE id > max;\n  SET n = ROW_COUNT();\nEND\n", p.Body)
+	require.True(t, sqlx.Has(p.Attrs, &Deterministic{}))
+	users, ok := s.Table("users")
+	require.True(t, ok)
+	require.Len(t, users.Triggers, 1)
+	require.Equal(t, schema.TriggerTimeAfter, users.Triggers[0].ActionTime)
+	require.Equal(t, []schema.TriggerEvent{schema.TriggerEventUpdate}, users.Triggers[0].Events)
+	require.Equal(t, schema.TriggerForRow, users.Triggers[0].For)
+	require.Len(t, s.Objects, 1)
+	e := s.Objects[0].(*Event)
+	require.Equal(t, "'1:30' HOUR_MINUTE", e.Every)
+	require.Equal(t, &schema.RawExpr{X: "CURRENT_TIMESTAMP + INTERVAL 1 WEEK"}, e.Ends)
+	require.Equal(t, EventStatusDisable, e.Status)
+	require.Equal(t, &s, e.Schema)
+
+	// Triggers can be defined only for a single event.
+	err := EvalHCLBytes([]byte(`table "users" {
+  schema = schema.a8m
+  column "id" {
+    type = int
+  }
+}
+trigger "t" {
+  on = table.users
+  before {
+    insert = true
+    update = true
+  }
+  as = "SET @n = 1"
+}
+schema "a8m" {}
+`), &s, nil)
+	require.EqualError(t, err, `expect exactly one event (insert, update or delete) for trigger "t", got 2`)
+}
+
 func TestUnmarshalSpec_IndexParts(t *testing.T) {
 	var (
 		s schema.Schema
@@ -1796,3 +2077,140 @@ schema "nati.schema" {
 }
 `, string(got))
 }
+
+func TestMarshalSpec_Partitioned(t *testing.T) {
+	id, created := schema.NewIntColumn("id", TypeInt), schema.NewTimeColumn("created", TypeDate)
+	logs := schema.NewTable("logs").AddColumns(id, created).AddAttrs(&Partition{
+		T:     PartitionTypeRangeColumns,
+		Parts: []*PartitionPart{{C: created}},
+		Sub:   &SubPartition{T: PartitionTypeKey, Linear: true, Parts: []*PartitionPart{{C: id}}, Count: 2},
+		Defs: []*PartitionDef{
+			{Name: "p0", Values: "'2020-01-01'", Attrs: []schema.Attr{&schema.Comment{Text: "archived"}}},
+			{Name: "p1", Values: "MAXVALUE"},
+		},
+	})
+	users := schema.NewTable("users").AddColumns(schema.NewIntColumn("id", TypeInt)).AddAttrs(&Partition{
+		T:     PartitionTypeHash,
+		Parts: []*PartitionPart{{X: &schema.RawExpr{X: "`id` DIV 10"}}},
+		Count: 4,
+	})
+	s := schema.New("test").AddTables(logs, users)
+	buf, err := MarshalHCL(s)
+	require.NoError(t, err)
+	const expected = `table "logs" {
+  schema = schema.test
+  column "id" {
+    null = false
+    type = int
+  }
+  column "created" {
+    null = false
+    type = date
+  }
+  partition {
+    type    = RANGE_COLUMNS
+    columns = [column.created]
+    subpartition {
+      type       = KEY
+      linear     = true
+      partitions = 2
+      columns    = [column.id]
+    }
+    part "p0" {
+      values  = "'2020-01-01'"
+      comment = "archived"
+    }
+    part "p1" {
+      values = "MAXVALUE"
+    }
+  }
+}
+table "users" {
+  schema = schema.test
+  column "id" {
+    null = false
+    type = int
+  }
+  partition {
+    type       = HASH
+    partitions = 4
+    by {
+      expr = "` + "`id`" + ` DIV 10"
+    }
+  }
+}
+schema "test" {
+}
+`
+	require.Equal(t, expected, string(buf))
+
+	var got schema.Schema
+	require.NoError(t, EvalHCLBytes(buf, &got, nil))
+	require.Equal(t, logs.Attrs[0].(*Partition).Defs, got.Tables[0].Attrs[0].(*Partition).Defs)
+	require.Equal(t, PartitionTypeRangeColumns, got.Tables[0].Attrs[0].(*Partition).T)
+	require.Equal(t, got.Tables[0].Columns[0], got.Tables[0].Attrs[0].(*Partition).Sub.Parts[0].C)
+}
+
+func TestUnmarshalSpec_Partitioned(t *testing.T) {
+	var (
+		s schema.Schema
+		f = `
+schema "test" {}
+table "users" {
+  schema = schema.test
+  column "id" {
+    type = int
+  }
+  column "tenant" {
+    type = int
+  }
+  partition {
+    type   = KEY
+    linear = true
+    columns = [column.tenant, column.id]
+    partitions = 3
+  }
+}
+table "logs" {
+  schema = schema.test
+  column "id" {
+    type = int
+  }
+  partition {
+    type = LIST
+    by {
+      expr = "id % 3"
+    }
+    part "p0" {
+      values = "0, 1"
+    }
+    part "p1" {
+      values = "2"
+    }
+  }
+}
+`
+	)
+	require.NoError(t, EvalHCLBytes([]byte(f), &s, nil))
+	users, logs := s.Tables[0], s.Tables[1]
+	require.Equal(t, []schema.Attr{&Partition{T: PartitionTypeKey, Linear: true, Parts: []*PartitionPart{{C: users.Columns[1]}, {C: users.Columns[0]}}, Count: 3}}, users.Attrs)
+	require.Equal(t, []schema.Attr{&Partition{
+		T:     PartitionTypeList,
+		Parts: []*PartitionPart{{X: &schema.RawExpr{X: "id % 3"}}},
+		Defs:  []*PartitionDef{{Name: "p0", Values: "0, 1"}, {Name: "p1", Values: "2"}},
+	}}, logs.Attrs)
+
+	err := EvalHCLBytes([]byte(`
+schema "test" {}
+table "logs" {
+  schema = schema.test
+  column "id" {
+    type = int
+  }
+  partition {
+    type = RANGE
+  }
+}
+`), &schema.Schema{}, nil)
+	require.EqualError(t, err, `cannot convert table "logs": missing columns or expressions for logs.partition`)
+}
@@ -64,6 +64,7 @@ func flat(changes []schema.Change) []schema.Change {
 				flat = append(flat, &schema.ModifyTable{
 					T:       m.T,
 					Changes: []schema.Change{c},
+					Extra:   m.Extra,
 				})
 			}
 		case *schema.ModifySchema:
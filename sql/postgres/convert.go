@@ -159,6 +159,11 @@ func FormatType(t schema.Type) (string, error) {
 	case *NetworkType:
 		f = strings.ToLower(t.T)
 	case *RangeType:
+		// User-defined range types are formatted by their name.
+		if t.Schema != nil || t.Subtype != nil {
+			f = t.T
+			break
+		}
 		switch f = strings.ToLower(t.T); f {
 		case TypeInt4Range, TypeInt4MultiRange, TypeInt8Range, TypeInt8MultiRange, TypeNumRange, TypeNumMultiRange,
 			TypeTSRange, TypeTSMultiRange, TypeTSTZRange, TypeTSTZMultiRange, TypeDateRange, TypeDateMultiRange:
@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode"
@@ -84,6 +85,488 @@ func (d *diff) TableAttrDiff(from, to *schema.Table, opts *schema.DiffOptions) (
 	})...), nil
 }
 
+// tableAttrDiff returns the changes of the row-level security configuration and the policies of the table.
+func (*diff) tableAttrDiff(from, to *schema.Table) ([]schema.Change, error) {
+	var changes []schema.Change
+	if r1, r2 := rowSecurity(from), rowSecurity(to); r1.Enabled != r2.Enabled || r1.Enforced != r2.Enforced {
+		changes = append(changes, &schema.ModifyAttr{From: r1, To: r2})
+	}
+	fromP, toP := policies(from), policies(to)
+	for _, p1 := range fromP {
+		i := slices.IndexFunc(toP, func(p2 *Policy) bool { return p1.Name == p2.Name })
+		switch {
+		case i == -1:
+			changes = append(changes, &schema.DropAttr{A: p1})
+		case policyChanged(p1, toP[i]):
+			changes = append(changes, &schema.ModifyAttr{From: p1, To: toP[i]})
+		}
+	}
+	for _, p2 := range toP {
+		if !slices.ContainsFunc(fromP, func(p1 *Policy) bool { return p1.Name == p2.Name }) {
+			changes = append(changes, &schema.AddAttr{A: p2})
+		}
+	}
+	return changes, nil
+}
+
+// ViewAttrChanges returns the changes between the two view attributes.
+func (*diff) ViewAttrChanges(from, to *schema.View) []schema.Change {
+	var changes []schema.Change
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		changes = append(changes, change)
+	}
+	if c1, c2 := viewCheckOption(from), viewCheckOption(to); c1.V != c2.V {
+		changes = append(changes, &schema.ModifyAttr{From: c1, To: c2})
+	}
+	return changes
+}
+
+// ProcFuncsDiff implements the sqlx.ProcFuncsDiffer interface. Functions and procedures
+// are matched by their names and input arguments, as PostgreSQL allows overloading them.
+// Therefore, a change to the signature is planned as a drop and a creation.
+func (d *diff) ProcFuncsDiff(from, to *schema.Schema, opts *schema.DiffOptions) ([]schema.Change, error) {
+	var (
+		changes  []schema.Change
+		funcSig  = func(f *schema.Func) (string, []*schema.FuncArg) { return f.Name, f.Args }
+		procSig  = func(p *schema.Proc) (string, []*schema.FuncArg) { return p.Name, p.Args }
+		ns       = d.conn.schema
+		findFunc = func(fs []*schema.Func, f *schema.Func) (*schema.Func, bool, error) {
+			return findFuncSig(fs, f.Name, f.Args, ns, funcSig)
+		}
+		findProc = func(ps []*schema.Proc, p *schema.Proc) (*schema.Proc, bool, error) {
+			return findFuncSig(ps, p.Name, p.Args, ns, procSig)
+		}
+	)
+	// Drop or modify functions.
+	for _, f1 := range from.Funcs {
+		f2, ok, err := findFunc(to.Funcs, f1)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			changes = opts.AddOrSkip(changes, &schema.DropFunc{F: f1})
+			continue
+		}
+		changed, err := funcChanged(f1, f2, ns)
+		if err != nil {
+			return nil, err
+		}
+		var attrs []schema.Change
+		if change := sqlx.CommentDiff(f1.Attrs, f2.Attrs); change != nil {
+			attrs = append(attrs, change)
+		}
+		if changed || len(attrs) > 0 {
+			changes = opts.AddOrSkip(changes, &schema.ModifyFunc{From: f1, To: f2, Changes: attrs})
+		}
+	}
+	// Add new functions.
+	for _, f1 := range to.Funcs {
+		if _, ok, err := findFunc(from.Funcs, f1); err != nil {
+			return nil, err
+		} else if !ok {
+			changes = opts.AddOrSkip(changes, &schema.AddFunc{F: f1})
+		}
+	}
+	// Drop or modify procedures.
+	for _, p1 := range from.Procs {
+		p2, ok, err := findProc(to.Procs, p1)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			changes = opts.AddOrSkip(changes, &schema.DropProc{P: p1})
+			continue
+		}
+		changed, err := procChanged(p1, p2, ns)
+		if err != nil {
+			return nil, err
+		}
+		var attrs []schema.Change
+		if change := sqlx.CommentDiff(p1.Attrs, p2.Attrs); change != nil {
+			attrs = append(attrs, change)
+		}
+		if changed || len(attrs) > 0 {
+			changes = opts.AddOrSkip(changes, &schema.ModifyProc{From: p1, To: p2, Changes: attrs})
+		}
+	}
+	// Add new procedures.
+	for _, p1 := range to.Procs {
+		if _, ok, err := findProc(from.Procs, p1); err != nil {
+			return nil, err
+		} else if !ok {
+			changes = opts.AddOrSkip(changes, &schema.AddProc{P: p1})
+		}
+	}
+	return changes, nil
+}
+
+// TriggerDiff implements the sqlx.TriggerDiffer interface and returns a changeset
+// for migrating triggers from one state to the other.
+func (d *diff) TriggerDiff(from, to *schema.Trigger) ([]schema.Change, error) {
+	var attrs []schema.Change
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		attrs = append(attrs, change)
+	}
+	if triggerChanged(from, to, d.conn.schema) || len(attrs) > 0 {
+		return []schema.Change{&schema.ModifyTrigger{From: from, To: to, Changes: attrs}}, nil
+	}
+	return nil, nil
+}
+
+// RealmObjectDiff returns a changeset for migrating realm (database) objects
+// from one state to the other. For example, adding extensions or event triggers.
+func (d *diff) RealmObjectDiff(from, to *schema.Realm) ([]schema.Change, error) {
+	changes := extensionsDiff(from, to)
+	// Drop or modify event triggers.
+	for _, o1 := range from.Objects {
+		e1, ok := o1.(*EventTrigger)
+		if !ok {
+			continue // Unsupported object type.
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			e2, ok := o.(*EventTrigger)
+			return ok && e1.Name == e2.Name
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: o1})
+			continue
+		}
+		e2 := o2.(*EventTrigger)
+		if eventTriggerChanged(e1, e2, d.conn.schema) || sqlx.CommentDiff(e1.Attrs, e2.Attrs) != nil {
+			changes = append(changes, &schema.ModifyObject{From: e1, To: e2})
+		}
+	}
+	// Add event triggers.
+	for _, o1 := range to.Objects {
+		e1, ok := o1.(*EventTrigger)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			e2, ok := o.(*EventTrigger)
+			return ok && e1.Name == e2.Name
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: e1})
+		}
+	}
+	return changes, nil
+}
+
+// extensionsDiff returns a changeset for migrating the extensions of the realm.
+func extensionsDiff(from, to *schema.Realm) []schema.Change {
+	var changes []schema.Change
+	// Drop or modify extensions.
+	for _, o1 := range from.Objects {
+		e1, ok := o1.(*Extension)
+		if !ok {
+			continue
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			e2, ok := o.(*Extension)
+			return ok && e1.Name == e2.Name
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: o1})
+			continue
+		}
+		if e2 := o2.(*Extension); extensionChanged(e1, e2) {
+			changes = append(changes, &schema.ModifyObject{From: e1, To: e2})
+		}
+	}
+	// Add extensions.
+	for _, o1 := range to.Objects {
+		e1, ok := o1.(*Extension)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			e2, ok := o.(*Extension)
+			return ok && e1.Name == e2.Name
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: e1})
+		}
+	}
+	return changes
+}
+
+// domainsDiff returns a changeset for migrating the domain types of the schema.
+// Domains are matched by their names, and changes are applied using ALTER DOMAIN.
+func (d *diff) domainsDiff(from, to *schema.Schema) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify domains.
+	for _, o1 := range from.Objects {
+		d1, ok := o1.(*DomainType)
+		if !ok {
+			continue
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			d2, ok := o.(*DomainType)
+			return ok && d1.T == d2.T
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: d1})
+			continue
+		}
+		d2 := o2.(*DomainType)
+		changed, err := d.domainChanged(d1, d2)
+		if err != nil {
+			return nil, err
+		}
+		if changed || len(domainChecksDiff(d1, d2)) > 0 || sqlx.CommentDiff(d1.Attrs, d2.Attrs) != nil {
+			changes = append(changes, &schema.ModifyObject{From: d1, To: d2})
+		}
+	}
+	// Add domains.
+	for _, o1 := range to.Objects {
+		d1, ok := o1.(*DomainType)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			d2, ok := o.(*DomainType)
+			return ok && d1.T == d2.T
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: d1})
+		}
+	}
+	return changes, nil
+}
+
+// domainChanged reports if the base type, the nullability
+// or the default value of the domain was changed.
+func (d *diff) domainChanged(from, to *DomainType) (bool, error) {
+	if from.Null != to.Null {
+		return true, nil
+	}
+	if changed, err := funcTypeChanged(from.Type, to.Type, d.conn.schema); err != nil || changed {
+		return changed, err
+	}
+	return d.defaultChanged(domainColumn(from), domainColumn(to))
+}
+
+// domainChecksDiff returns the check constraints changes between the two domains.
+func domainChecksDiff(from, to *DomainType) []schema.Change {
+	t1, t2 := &schema.Table{}, &schema.Table{}
+	for _, c := range from.Checks {
+		t1.Attrs = append(t1.Attrs, c)
+	}
+	for _, c := range to.Checks {
+		t2.Attrs = append(t2.Attrs, c)
+	}
+	return sqlx.ChecksDiff(t1, t2, func(c1, c2 *schema.Check) bool {
+		return c1.Expr == c2.Expr || sqlx.MayWrap(c1.Expr) == sqlx.MayWrap(c2.Expr)
+	})
+}
+
+// domainColumn returns a column representation of the domain,
+// used for comparing and formatting its default value.
+func domainColumn(d *DomainType) *schema.Column {
+	return &schema.Column{Name: d.T, Type: &schema.ColumnType{Type: d.Type, Null: d.Null}, Default: d.Default}
+}
+
+// rangesDiff returns a changeset for migrating the user-defined range types of the schema.
+func (d *diff) rangesDiff(from, to *schema.Schema) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify range types.
+	for _, o1 := range from.Objects {
+		r1, ok := o1.(*RangeType)
+		if !ok {
+			continue
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			r2, ok := o.(*RangeType)
+			return ok && r1.T == r2.T
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: r1})
+			continue
+		}
+		r2 := o2.(*RangeType)
+		changed, err := funcTypeChanged(r1.Subtype, r2.Subtype, d.conn.schema)
+		if err != nil {
+			return nil, err
+		}
+		if changed || sqlx.CommentDiff(r1.Attrs, r2.Attrs) != nil {
+			changes = append(changes, &schema.ModifyObject{From: r1, To: r2})
+		}
+	}
+	// Add range types.
+	for _, o1 := range to.Objects {
+		r1, ok := o1.(*RangeType)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			r2, ok := o.(*RangeType)
+			return ok && r1.T == r2.T
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: r1})
+		}
+	}
+	return changes, nil
+}
+
+// compositesDiff returns a changeset for migrating the composite types of the schema.
+// Composite types are matched by their names, and changes are applied using ALTER TYPE.
+func (d *diff) compositesDiff(from, to *schema.Schema) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify composite types.
+	for _, o1 := range from.Objects {
+		c1, ok := o1.(*CompositeType)
+		if !ok {
+			continue
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			c2, ok := o.(*CompositeType)
+			return ok && c1.T == c2.T
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: c1})
+			continue
+		}
+		c2 := o2.(*CompositeType)
+		fields, err := d.fieldsDiff(c1, c2)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 || sqlx.CommentDiff(c1.Attrs, c2.Attrs) != nil {
+			changes = append(changes, &schema.ModifyObject{From: c1, To: c2})
+		}
+	}
+	// Add composite types.
+	for _, o1 := range to.Objects {
+		c1, ok := o1.(*CompositeType)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			c2, ok := o.(*CompositeType)
+			return ok && c1.T == c2.T
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: c1})
+		}
+	}
+	return changes, nil
+}
+
+// fieldsDiff returns the changes between the fields of the two composite
+// types. Fields are matched by their names, and only their types and
+// collations are compared.
+func (d *diff) fieldsDiff(from, to *CompositeType) ([]schema.Change, error) {
+	var changes []schema.Change
+	for _, f1 := range from.Fields {
+		idx := slices.IndexFunc(to.Fields, func(f2 *schema.Column) bool { return f1.Name == f2.Name })
+		if idx == -1 {
+			changes = append(changes, &schema.DropColumn{C: f1})
+			continue
+		}
+		f2 := to.Fields[idx]
+		changed, err := funcTypeChanged(f1.Type.Type, f2.Type.Type, d.conn.schema)
+		if err != nil {
+			return nil, err
+		}
+		var c1, c2 schema.Collation
+		sqlx.Has(f1.Attrs, &c1)
+		sqlx.Has(f2.Attrs, &c2)
+		if changed || c1.V != c2.V {
+			changes = append(changes, &schema.ModifyColumn{From: f1, To: f2, Change: schema.ChangeType})
+		}
+	}
+	for _, f2 := range to.Fields {
+		if !slices.ContainsFunc(from.Fields, func(f1 *schema.Column) bool { return f1.Name == f2.Name }) {
+			changes = append(changes, &schema.AddColumn{C: f2})
+		}
+	}
+	return changes, nil
+}
+
+// sequencesDiff returns a changeset for migrating the standalone sequences of the schema.
+// Sequences are matched by their names, and changes are applied using ALTER SEQUENCE.
+func (d *diff) sequencesDiff(from, to *schema.Schema) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify sequences.
+	for _, o1 := range from.Objects {
+		s1, ok := o1.(*Sequence)
+		if !ok {
+			continue
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			s2, ok := o.(*Sequence)
+			return ok && s1.Name == s2.Name
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: s1})
+			continue
+		}
+		s2 := o2.(*Sequence)
+		changed, err := seqChanged(s1, s2, d.conn.schema)
+		if err != nil {
+			return nil, err
+		}
+		if changed || sqlx.CommentDiff(s1.Attrs, s2.Attrs) != nil {
+			changes = append(changes, &schema.ModifyObject{From: s1, To: s2})
+		}
+	}
+	// Add sequences.
+	for _, o1 := range to.Objects {
+		s1, ok := o1.(*Sequence)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			s2, ok := o.(*Sequence)
+			return ok && s1.Name == s2.Name
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: s1})
+		}
+	}
+	return changes, nil
+}
+
+// aggregatesDiff returns a changeset for migrating the aggregates of the schema.
+// Aggregates are matched by their names, and are recreated in case their
+// definition was changed.
+func (d *diff) aggregatesDiff(from, to *schema.Schema) ([]schema.Change, error) {
+	var changes []schema.Change
+	// Drop or modify aggregates.
+	for _, o1 := range from.Objects {
+		a1, ok := o1.(*Aggregate)
+		if !ok {
+			continue
+		}
+		o2, ok := to.Object(func(o schema.Object) bool {
+			a2, ok := o.(*Aggregate)
+			return ok && a1.Name == a2.Name
+		})
+		if !ok {
+			changes = append(changes, &schema.DropObject{O: a1})
+			continue
+		}
+		a2 := o2.(*Aggregate)
+		changed, err := aggregateChanged(a1, a2, d.conn.schema)
+		if err != nil {
+			return nil, err
+		}
+		if changed || sqlx.CommentDiff(a1.Attrs, a2.Attrs) != nil {
+			changes = append(changes, &schema.ModifyObject{From: a1, To: a2})
+		}
+	}
+	// Add aggregates.
+	for _, o1 := range to.Objects {
+		a1, ok := o1.(*Aggregate)
+		if !ok {
+			continue
+		}
+		if _, ok := from.Object(func(o schema.Object) bool {
+			a2, ok := o.(*Aggregate)
+			return ok && a1.Name == a2.Name
+		}); !ok {
+			changes = append(changes, &schema.AddObject{O: a1})
+		}
+	}
+	return changes, nil
+}
+
 // ColumnChange returns the schema changes (if any) for migrating one column to the other.
 func (d *diff) ColumnChange(_ *schema.Table, from, to *schema.Column, _ *schema.DiffOptions) (schema.Change, error) {
 	change := sqlx.CommentChange(from.Attrs, to.Attrs)
@@ -336,6 +819,23 @@ func (*diff) AnnotateChanges(changes []schema.Change, opts *schema.DiffOptions)
 	return nil
 }
 
+// AnnotateTableChange implements the sqlx.TableChangeAnnotator interface. The current state
+// of tables that views depend on is attached to their changes, as the desired state may lack
+// the view dependencies. For example, when it was not normalized by a dev-database.
+func (*diff) AnnotateTableChange(from *schema.Table, m *schema.ModifyTable) {
+	for _, s := range realmSchemas(from.Schema) {
+		for _, v := range s.Views {
+			if slices.ContainsFunc(v.Deps, func(o schema.Object) bool {
+				t, ok := o.(*schema.Table)
+				return ok && sqlx.SameTable(t, from)
+			}) {
+				m.Extra = append(m.Extra, &CurrentTable{T: from})
+				return
+			}
+		}
+	}
+}
+
 func (d *diff) typeChanged(from, to *schema.Column) (bool, error) {
 	return typeChanged(from, to, d.conn.schema)
 }
@@ -351,7 +851,7 @@ func typeChanged(from, to *schema.Column, ns string) (bool, error) {
 	var changed bool
 	switch fromT := fromT.(type) {
 	case *schema.BinaryType, *BitType, *schema.BoolType, *schema.DecimalType, *schema.FloatType, *IntervalType,
-		*schema.IntegerType, *schema.JSONType, *OIDType, *RangeType, *SerialType, *schema.SpatialType,
+		*schema.IntegerType, *schema.JSONType, *OIDType, *SerialType, *schema.SpatialType,
 		*schema.StringType, *PseudoType, *schema.TimeType, *TextSearchType, *NetworkType, *schema.UUIDType:
 		t1, err := FormatType(toT)
 		if err != nil {
@@ -368,6 +868,10 @@ func typeChanged(from, to *schema.Column, ns string) (bool, error) {
 			// In case the type is defined with schema qualifier, but returned without
 			// (inspecting a schema scope), or vice versa, remove before comparing.
 			ns != "" && trimSchema(toT.T, ns) != trimSchema(toT.T, ns)
+	case *RangeType:
+		toT := toT.(*RangeType)
+		changed = !strings.EqualFold(toT.T, fromT.T) ||
+			(toT.Schema != nil && fromT.Schema != nil && toT.Schema.Name != fromT.Schema.Name)
 	case *CompositeType:
 		toT := toT.(*CompositeType)
 		changed = toT.T != fromT.T ||
@@ -582,3 +1086,399 @@ func trimCast(s string) string {
 	}
 	return s[:i]
 }
+
+// viewCheckOption returns the check option of the view, or NONE if it was not set.
+func viewCheckOption(v *schema.View) *schema.ViewCheckOption {
+	c := &schema.ViewCheckOption{V: schema.ViewCheckOptionNone}
+	if sqlx.Has(v.Attrs, c) && c.V == "" {
+		c.V = schema.ViewCheckOptionNone
+	}
+	c.V = strings.ToUpper(c.V)
+	return c
+}
+
+// findFuncSig returns the first function or procedure in the list that
+// matches the given name and the types of the input arguments.
+func findFuncSig[T any](list []T, name string, args []*schema.FuncArg, ns string, sig func(T) (string, []*schema.FuncArg)) (T, bool, error) {
+	in := funcInArgs(args)
+search:
+	for _, f := range list {
+		n, fargs := sig(f)
+		if n != name {
+			continue
+		}
+		fin := funcInArgs(fargs)
+		if len(fin) != len(in) {
+			continue
+		}
+		for i := range fin {
+			changed, err := funcTypeChanged(in[i].Type, fin[i].Type, ns)
+			if err != nil {
+				var zero T
+				return zero, false, err
+			}
+			if changed {
+				continue search
+			}
+		}
+		return f, true, nil
+	}
+	var zero T
+	return zero, false, nil
+}
+
+// funcInArgs returns the arguments that are part of the function signature.
+func funcInArgs(args []*schema.FuncArg) []*schema.FuncArg {
+	in := make([]*schema.FuncArg, 0, len(args))
+	for _, a := range args {
+		if funcArgMode(a) != schema.FuncArgModeOut {
+			in = append(in, a)
+		}
+	}
+	return in
+}
+
+// funcArgMode returns the mode of the argument, or IN if it was not set.
+func funcArgMode(a *schema.FuncArg) schema.FuncArgMode {
+	if a.Mode == "" {
+		return schema.FuncArgModeIn
+	}
+	return schema.FuncArgMode(strings.ToUpper(string(a.Mode)))
+}
+
+// funcChanged reports if the definition of the function was changed.
+func funcChanged(from, to *schema.Func, ns string) (bool, error) {
+	if changed, err := funcTypeChanged(from.Ret, to.Ret, ns); err != nil || changed {
+		return changed, err
+	}
+	if changed, _, err := funcArgsChanged(from.Args, to.Args, ns); err != nil || changed {
+		return changed, err
+	}
+	return funcBodyChanged(from.Lang, to.Lang, from.Body, to.Body) ||
+		funcVolatility(from.Attrs) != funcVolatility(to.Attrs) ||
+		funcSecurity(from.Attrs) != funcSecurity(to.Attrs), nil
+}
+
+// triggerChanged reports if the definition of the trigger was changed.
+func triggerChanged(from, to *schema.Trigger, ns string) bool {
+	if from.ActionTime != to.ActionTime || triggerFor(from) != triggerFor(to) || len(from.Events) != len(to.Events) {
+		return true
+	}
+	for i := range from.Events {
+		e1, e2 := from.Events[i], to.Events[i]
+		if e1.Name != e2.Name || len(e1.Columns) != len(e2.Columns) {
+			return true
+		}
+		for j := range e1.Columns {
+			if e1.Columns[j].Name != e2.Columns[j].Name {
+				return true
+			}
+		}
+	}
+	var w1, w2 TriggerWhen
+	sqlx.Has(from.Attrs, &w1)
+	sqlx.Has(to.Attrs, &w2)
+	if !strings.EqualFold(sqlx.MayWrap(w1.X), sqlx.MayWrap(w2.X)) {
+		return true
+	}
+	var f1, f2 TriggerFunc
+	sqlx.Has(from.Attrs, &f1)
+	sqlx.Has(to.Attrs, &f2)
+	return funcRefChanged(f1.F, f2.F, ns) || !slices.Equal(f1.Args, f2.Args)
+}
+
+// rowSecurity returns the row-level security configuration of the table.
+func rowSecurity(t *schema.Table) *RowSecurity {
+	r := &RowSecurity{}
+	sqlx.Has(t.Attrs, r)
+	return r
+}
+
+// policies returns the row-level security policies of the table.
+func policies(t *schema.Table) []*Policy {
+	var ps []*Policy
+	for _, a := range t.Attrs {
+		if p, ok := a.(*Policy); ok {
+			ps = append(ps, p)
+		}
+	}
+	return ps
+}
+
+// policyChanged reports if the definition of the policy was changed.
+func policyChanged(from, to *Policy) bool {
+	return policyAs(from) != policyAs(to) || policyFor(from) != policyFor(to) ||
+		!slices.Equal(policyRoles(from), policyRoles(to)) ||
+		exprChanged(from.Using, to.Using) || exprChanged(from.Check, to.Check)
+}
+
+// exprChanged reports if the two optional expressions are different,
+// ignoring their wrapping parentheses.
+func exprChanged(x1, x2 string) bool {
+	if x1 == "" || x2 == "" {
+		return x1 != x2
+	}
+	return x1 != x2 && sqlx.MayWrap(x1) != sqlx.MayWrap(x2)
+}
+
+// policyAs returns the type of the policy, or PERMISSIVE if it was not set.
+func policyAs(p *Policy) string {
+	if p.As == "" {
+		return PolicyAsPermissive
+	}
+	return strings.ToUpper(p.As)
+}
+
+// policyFor returns the command of the policy, or ALL if it was not set.
+func policyFor(p *Policy) string {
+	if p.For == "" {
+		return PolicyForAll
+	}
+	return strings.ToUpper(p.For)
+}
+
+// policyRoles returns the sorted roles of the policy, or PUBLIC if they
+// were not set. Role keywords, such as CURRENT_USER, are case-insensitive.
+func policyRoles(p *Policy) []string {
+	if len(p.To) == 0 {
+		return []string{"PUBLIC"}
+	}
+	roles := make([]string, len(p.To))
+	for i, r := range p.To {
+		if isRoleKeyword(r) {
+			r = strings.ToUpper(r)
+		}
+		roles[i] = r
+	}
+	slices.Sort(roles)
+	return roles
+}
+
+// isRoleKeyword reports if the given role is a keyword that is not quoted in role specifications.
+func isRoleKeyword(r string) bool {
+	switch strings.ToUpper(r) {
+	case "PUBLIC", "CURRENT_USER", "CURRENT_ROLE", "SESSION_USER":
+		return true
+	}
+	return false
+}
+
+// extensionChanged reports if the version or the schema of the extension were changed.
+// Version and schema that were not set in the desired state are not compared.
+func extensionChanged(from, to *Extension) bool {
+	return (to.Version != "" && from.Version != to.Version) ||
+		(to.Schema != nil && (from.Schema == nil || from.Schema.Name != to.Schema.Name))
+}
+
+// eventTriggerChanged reports if the definition of the event trigger was changed.
+func eventTriggerChanged(from, to *EventTrigger, ns string) bool {
+	return !strings.EqualFold(from.Event, to.Event) || !slices.Equal(from.Tags, to.Tags) || funcRefChanged(from.F, to.F, ns)
+}
+
+// aggregateChanged reports if the definition of the aggregate was changed.
+func aggregateChanged(from, to *Aggregate, ns string) (bool, error) {
+	if changed, _, err := funcArgsChanged(from.Args, to.Args, ns); err != nil || changed {
+		return changed, err
+	}
+	if changed, err := funcTypeChanged(from.StateType, to.StateType, ns); err != nil || changed {
+		return changed, err
+	}
+	return funcRefChanged(from.StateFunc, to.StateFunc, ns) || funcRefChanged(from.FinalFunc, to.FinalFunc, ns) ||
+		from.InitCond != to.InitCond || aggregateParallel(from) != aggregateParallel(to), nil
+}
+
+// aggregateParallel returns the parallel safety of the aggregate, or UNSAFE if it was not set.
+func aggregateParallel(a *Aggregate) string {
+	if a.Parallel == "" {
+		return AggregateParallelUnsafe
+	}
+	return strings.ToUpper(a.Parallel)
+}
+
+// seqChanged reports if the definition of the sequence was changed.
+func seqChanged(from, to *Sequence, ns string) (bool, error) {
+	if changed, err := funcTypeChanged(seqType(from), seqType(to), ns); err != nil || changed {
+		return changed, err
+	}
+	min1, max1 := seqMinMax(from)
+	min2, max2 := seqMinMax(to)
+	return seqIncrement(from) != seqIncrement(to) || seqStart(from) != seqStart(to) ||
+		min1 != min2 || max1 != max2 || seqCache(from) != seqCache(to) || from.Cycle != to.Cycle ||
+		seqOwnerChanged(from, to), nil
+}
+
+// seqOwnerChanged reports if the owner column of the sequence was changed.
+func seqOwnerChanged(from, to *Sequence) bool {
+	if (from.Owner.C == nil) != (to.Owner.C == nil) {
+		return true
+	}
+	return from.Owner.C != nil && (from.Owner.T.Name != to.Owner.T.Name || from.Owner.C.Name != to.Owner.C.Name)
+}
+
+// seqType returns the type of the sequence, or bigint if it was not set.
+func seqType(s *Sequence) schema.Type {
+	if s.Type == nil {
+		return &schema.IntegerType{T: TypeBigInt}
+	}
+	return s.Type
+}
+
+// seqIncrement returns the increment of the sequence, or 1 if it was not set.
+func seqIncrement(s *Sequence) int64 {
+	if s.Increment == 0 {
+		return defaultSeqIncrement
+	}
+	return s.Increment
+}
+
+// seqCache returns the cache size of the sequence, or 1 if it was not set.
+func seqCache(s *Sequence) int64 {
+	if s.Cache == 0 {
+		return 1
+	}
+	return s.Cache
+}
+
+// seqMinMax returns the minimum and maximum values of the sequence,
+// or their defaults in case they were not set.
+func seqMinMax(s *Sequence) (int64, int64) {
+	minV, maxV := seqLimits(&Sequence{Type: seqType(s), Increment: seqIncrement(s)})
+	if s.Min != nil {
+		minV = *s.Min
+	}
+	if s.Max != nil {
+		maxV = *s.Max
+	}
+	return minV, maxV
+}
+
+// seqStart returns the start value of the sequence, or its default in case it was not
+// set. The default is the minimum value for ascending sequences and the maximum value
+// for descending ones.
+func seqStart(s *Sequence) int64 {
+	if s.Start != 0 {
+		return s.Start
+	}
+	minV, maxV := seqMinMax(s)
+	if seqIncrement(s) < 0 {
+		return maxV
+	}
+	return minV
+}
+
+// triggerFor returns the FOR EACH spec of the trigger, or STATEMENT if it was not set.
+func triggerFor(t *schema.Trigger) schema.TriggerFor {
+	if t.For == "" {
+		return schema.TriggerForStmt
+	}
+	return schema.TriggerFor(strings.ToUpper(string(t.For)))
+}
+
+// funcRefChanged reports if the function referenced by a trigger was changed.
+func funcRefChanged(from, to *schema.Func, ns string) bool {
+	if from == nil || to == nil {
+		return from != to
+	}
+	var s1, s2 string
+	if from.Schema != nil {
+		s1 = from.Schema.Name
+	}
+	if to.Schema != nil {
+		s2 = to.Schema.Name
+	}
+	// Unqualified functions are resolved to the connected schema, if there is one.
+	if s1 == "" {
+		s1 = ns
+	}
+	if s2 == "" {
+		s2 = ns
+	}
+	return from.Name != to.Name || (s1 != "" && s2 != "" && s1 != s2)
+}
+
+// procChanged reports if the definition of the procedure was changed.
+func procChanged(from, to *schema.Proc, ns string) (bool, error) {
+	if changed, _, err := funcArgsChanged(from.Args, to.Args, ns); err != nil || changed {
+		return changed, err
+	}
+	return funcBodyChanged(from.Lang, to.Lang, from.Body, to.Body) ||
+		funcSecurity(from.Attrs) != funcSecurity(to.Attrs), nil
+}
+
+// funcBodyChanged reports if the language or the body of a function or procedure was changed.
+func funcBodyChanged(fromL, toL, fromB, toB string) bool {
+	return !strings.EqualFold(fromL, toL) || sqlx.BodyDefChanged(fromB, toB)
+}
+
+// funcArgsChanged reports if the arguments of a function or procedure were changed.
+// The replace flag reports if the change can be applied using CREATE OR REPLACE,
+// which does not allow changing the names, types or modes of the arguments, nor
+// removing their defaults.
+func funcArgsChanged(from, to []*schema.FuncArg, ns string) (changed, replace bool, err error) {
+	if len(from) != len(to) {
+		return true, false, nil
+	}
+	for i := range from {
+		a1, a2 := from[i], to[i]
+		if a1.Name != a2.Name || funcArgMode(a1) != funcArgMode(a2) {
+			return true, false, nil
+		}
+		if c, err := funcTypeChanged(a1.Type, a2.Type, ns); err != nil || c {
+			return c, false, err
+		}
+		switch x1, x2 := funcArgDefault(a1), funcArgDefault(a2); {
+		case trimCast(x1) == trimCast(x2):
+		case x2 == "":
+			return true, false, nil
+		default:
+			changed = true
+		}
+	}
+	return changed, true, nil
+}
+
+// funcTypeChanged reports if the type of function argument or its return type was changed.
+func funcTypeChanged(from, to schema.Type, ns string) (bool, error) {
+	if from == nil || to == nil {
+		return from != to, nil
+	}
+	u1, ok1 := from.(*UserDefinedType)
+	u2, ok2 := to.(*UserDefinedType)
+	if ok1 && ok2 {
+		return !strings.EqualFold(trimSchema(u1.T, ns), trimSchema(u2.T, ns)), nil
+	}
+	return typeChanged(&schema.Column{Type: &schema.ColumnType{Type: from}}, &schema.Column{Type: &schema.ColumnType{Type: to}}, ns)
+}
+
+// funcArgDefault returns the default value of the argument, formatted as an SQL expression.
+func funcArgDefault(a *schema.FuncArg) string {
+	switch x := a.Default.(type) {
+	case *schema.Literal:
+		switch a.Type.(type) {
+		case *schema.BoolType, *schema.DecimalType, *schema.IntegerType, *schema.FloatType:
+			return x.V
+		default:
+			return quote(x.V)
+		}
+	case *schema.RawExpr:
+		return x.X
+	default:
+		return ""
+	}
+}
+
+// funcVolatility returns the volatility of the function, or VOLATILE if it was not set.
+func funcVolatility(attrs []schema.Attr) string {
+	if v := (FuncVolatility{}); sqlx.Has(attrs, &v) && v.V != "" {
+		return strings.ToUpper(v.V)
+	}
+	return FuncVolatilityVolatile
+}
+
+// funcSecurity returns the security of the function or procedure, or INVOKER if it was not set.
+func funcSecurity(attrs []schema.Attr) string {
+	if s := (FuncSecurity{}); sqlx.Has(attrs, &s) && s.V != "" {
+		return strings.ToUpper(s.V)
+	}
+	return FuncSecurityInvoker
+}
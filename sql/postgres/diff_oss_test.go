@@ -624,6 +624,314 @@ func TestDiff_SchemaDiff(t *testing.T) {
 	})
 }
 
+func TestDiff_ViewDiff(t *testing.T) {
+	from := schema.New("public").AddViews(
+		schema.NewView("v1", " SELECT id\n   FROM users;").SetComment("users"),
+		schema.NewView("v2", "SELECT 1"),
+		schema.NewMaterializedView("m1", "SELECT 1"),
+	)
+	to := schema.New("public").AddViews(
+		schema.NewView("v1", "SELECT id FROM users").SetCheckOption(schema.ViewCheckOptionCascaded),
+		schema.NewView("v2", "SELECT 1").SetCheckOption(schema.ViewCheckOptionNone),
+		schema.NewMaterializedView("m1", "SELECT 2"),
+	)
+	changes, err := DefaultDiff.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyView{From: from.Views[0], To: to.Views[0], Changes: []schema.Change{
+			&schema.ModifyAttr{From: &schema.Comment{Text: "users"}, To: &schema.Comment{}},
+			&schema.ModifyAttr{From: &schema.ViewCheckOption{V: schema.ViewCheckOptionNone}, To: &schema.ViewCheckOption{V: schema.ViewCheckOptionCascaded}},
+		}},
+		&schema.ModifyView{From: from.Views[2], To: to.Views[2]},
+	}, changes)
+}
+
+func TestDiff_FuncDiff(t *testing.T) {
+	var (
+		intT  = &schema.IntegerType{T: "integer"}
+		textT = &schema.StringType{T: "text"}
+		from  = schema.New("public")
+		to    = schema.New("public")
+	)
+	from.AddFuncs(
+		&schema.Func{Name: "f1", Args: []*schema.FuncArg{{Name: "a", Type: intT}}, Ret: intT, Lang: "sql", Body: "SELECT a"},
+		&schema.Func{Name: "f1", Args: []*schema.FuncArg{{Name: "a", Type: textT}}, Ret: textT, Lang: "sql", Body: "SELECT a"},
+		&schema.Func{Name: "f2", Ret: intT, Lang: "sql", Body: "SELECT 1", Attrs: []schema.Attr{&FuncVolatility{V: FuncVolatilityStable}}},
+		&schema.Func{Name: "f3", Ret: intT, Lang: "sql", Body: "SELECT 1"},
+	)
+	from.AddProcs(
+		&schema.Proc{Name: "p1", Lang: "plpgsql", Body: "BEGIN END;"},
+	)
+	to.AddFuncs(
+		// Body only differs in whitespace and language in case.
+		&schema.Func{Name: "f1", Args: []*schema.FuncArg{{Name: "a", Type: intT, Mode: schema.FuncArgModeIn}}, Ret: intT, Lang: FuncLangSQL, Body: "SELECT a\n"},
+		&schema.Func{Name: "f1", Args: []*schema.FuncArg{{Name: "a", Type: textT}}, Ret: textT, Lang: "sql", Body: "SELECT a", Attrs: []schema.Attr{&schema.Comment{Text: "text"}}},
+		&schema.Func{Name: "f2", Ret: intT, Lang: "sql", Body: "SELECT 1", Attrs: []schema.Attr{&FuncVolatility{V: FuncVolatilityImmutable}}},
+		&schema.Func{Name: "f4", Ret: intT, Lang: "sql", Body: "SELECT 1"},
+	)
+	to.AddProcs(
+		&schema.Proc{Name: "p1", Lang: FuncLangPLpgSQL, Body: "BEGIN RETURN; END;", Attrs: []schema.Attr{&FuncSecurity{V: FuncSecurityDefiner}}},
+	)
+	changes, err := DefaultDiff.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyFunc{From: from.Funcs[1], To: to.Funcs[1], Changes: []schema.Change{
+			&schema.AddAttr{A: &schema.Comment{Text: "text"}},
+		}},
+		&schema.ModifyFunc{From: from.Funcs[2], To: to.Funcs[2]},
+		&schema.DropFunc{F: from.Funcs[3]},
+		&schema.AddFunc{F: to.Funcs[3]},
+		&schema.ModifyProc{From: from.Procs[0], To: to.Procs[0]},
+	}, changes)
+}
+
+func TestDiff_TriggerDiff(t *testing.T) {
+	var (
+		audit = &schema.Func{Name: "audit", Schema: schema.New("public")}
+		from  = schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "int"))
+		to    = schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "int"))
+	)
+	from.Triggers = []*schema.Trigger{
+		{Name: "t1", Table: from, ActionTime: schema.TriggerTimeAfter, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, Attrs: []schema.Attr{&TriggerFunc{F: audit}}},
+		{Name: "t2", Table: from, ActionTime: schema.TriggerTimeAfter, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, Attrs: []schema.Attr{&TriggerWhen{X: "(new.id > 0)"}, &TriggerFunc{F: audit}}},
+		{Name: "t3", Table: from, ActionTime: schema.TriggerTimeAfter, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, Attrs: []schema.Attr{&TriggerFunc{F: audit}}},
+		{Name: "t4", Table: from, ActionTime: schema.TriggerTimeAfter, Events: []schema.TriggerEvent{schema.TriggerEventUpdateOf(from.Columns[0])}, Attrs: []schema.Attr{&TriggerFunc{F: audit}}},
+	}
+	to.Triggers = []*schema.Trigger{
+		// Statement-level is the default, and the function schema may be omitted.
+		{Name: "t1", Table: to, ActionTime: schema.TriggerTimeAfter, For: schema.TriggerForStmt, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, Attrs: []schema.Attr{&TriggerFunc{F: &schema.Func{Name: "audit", Schema: &schema.Schema{}}}}},
+		{Name: "t2", Table: to, ActionTime: schema.TriggerTimeAfter, Events: []schema.TriggerEvent{schema.TriggerEventInsert}, Attrs: []schema.Attr{&TriggerWhen{X: "NEW.id > 0"}, &TriggerFunc{F: audit}, &schema.Comment{Text: "c"}}},
+		{Name: "t4", Table: to, ActionTime: schema.TriggerTimeAfter, Events: []schema.TriggerEvent{schema.TriggerEventUpdate}, Attrs: []schema.Attr{&TriggerFunc{F: audit}}},
+		{Name: "t5", Table: to, ActionTime: schema.TriggerTimeBefore, Events: []schema.TriggerEvent{schema.TriggerEventDelete}, Attrs: []schema.Attr{&TriggerFunc{F: audit}}},
+	}
+	changes, err := DefaultDiff.TableDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyTrigger{From: from.Triggers[1], To: to.Triggers[1], Changes: []schema.Change{
+			&schema.AddAttr{A: &schema.Comment{Text: "c"}},
+		}},
+		&schema.DropTrigger{T: from.Triggers[2]},
+		&schema.ModifyTrigger{From: from.Triggers[3], To: to.Triggers[2]},
+		&schema.AddTrigger{T: to.Triggers[3]},
+	}, changes)
+
+	var (
+		fromR = schema.NewRealm()
+		toR   = schema.NewRealm()
+	)
+	fromR.AddObjects(
+		&EventTrigger{Name: "e1", Event: "ddl_command_end", F: audit},
+		&EventTrigger{Name: "e2", Event: "ddl_command_end", F: audit},
+		&EventTrigger{Name: "e3", Event: "sql_drop", F: audit},
+	)
+	toR.AddObjects(
+		&EventTrigger{Name: "e1", Event: "ddl_command_end", F: audit},
+		&EventTrigger{Name: "e2", Event: "ddl_command_end", Tags: []string{"CREATE TABLE"}, F: audit},
+		&EventTrigger{Name: "e4", Event: "sql_drop", F: audit},
+	)
+	changes, err = DefaultDiff.RealmDiff(fromR, toR)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyObject{From: fromR.Objects[1], To: toR.Objects[1]},
+		&schema.DropObject{O: fromR.Objects[2]},
+		&schema.AddObject{O: toR.Objects[2]},
+	}, changes)
+}
+
+func TestDiff_ExtensionDiff(t *testing.T) {
+	var (
+		fromR = schema.NewRealm(schema.New("public"), schema.New("extensions"))
+		toR   = schema.NewRealm(schema.New("public"), schema.New("extensions"))
+	)
+	fromR.AddObjects(
+		&Extension{Name: "hstore", Schema: fromR.Schemas[0], Version: "1.7"},
+		&Extension{Name: "citext", Schema: fromR.Schemas[0], Version: "1.6"},
+		&Extension{Name: "postgis", Schema: fromR.Schemas[0], Version: "3.4.2"},
+		&Extension{Name: "pg_trgm", Schema: fromR.Schemas[0], Version: "1.6"},
+	)
+	toR.AddObjects(
+		&Extension{Name: "hstore", Schema: toR.Schemas[0], Version: "1.8"},
+		// Version and schema are not compared if they were not set.
+		&Extension{Name: "citext"},
+		&Extension{Name: "postgis", Schema: toR.Schemas[1]},
+		&Extension{Name: "vector", Schema: toR.Schemas[1], Version: "0.7.0"},
+	)
+	changes, err := DefaultDiff.RealmDiff(fromR, toR)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyObject{From: fromR.Objects[0], To: toR.Objects[0]},
+		&schema.ModifyObject{From: fromR.Objects[2], To: toR.Objects[2]},
+		&schema.DropObject{O: fromR.Objects[3]},
+		&schema.AddObject{O: toR.Objects[3]},
+	}, changes)
+}
+
+func TestDiff_PolicyDiff(t *testing.T) {
+	var (
+		from = schema.NewTable("users").SetSchema(schema.New("public"))
+		to   = schema.NewTable("users").SetSchema(schema.New("public"))
+	)
+	from.AddAttrs(
+		&RowSecurity{Enabled: true},
+		&Policy{Name: "p1", Table: from, As: PolicyAsPermissive, For: PolicyForAll, To: []string{"PUBLIC"}, Using: "(tenant_id = 1)"},
+		&Policy{Name: "p2", Table: from, As: PolicyAsPermissive, For: PolicyForAll, To: []string{"PUBLIC"}, Using: "(tenant_id = 1)"},
+		&Policy{Name: "p3", Table: from, For: PolicyForSelect, To: []string{"admin", "auditor"}},
+		&Policy{Name: "p4", Table: from, Using: "true"},
+	)
+	to.AddAttrs(
+		&RowSecurity{Enabled: true, Enforced: true},
+		// Defaults and wrapping parentheses are ignored.
+		&Policy{Name: "p1", Table: to, Using: "tenant_id = 1"},
+		&Policy{Name: "p2", Table: to, As: "restrictive", Using: "tenant_id = 1"},
+		&Policy{Name: "p3", Table: to, For: "select", To: []string{"auditor", "admin"}},
+		&Policy{Name: "p5", Table: to, To: []string{"current_user"}},
+	)
+	changes, err := DefaultDiff.TableDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyAttr{From: from.Attrs[0], To: to.Attrs[0]},
+		&schema.ModifyAttr{From: from.Attrs[2], To: to.Attrs[2]},
+		&schema.DropAttr{A: from.Attrs[4]},
+		&schema.AddAttr{A: to.Attrs[4]},
+	}, changes)
+}
+
+func TestDiff_DomainDiff(t *testing.T) {
+	var (
+		from = schema.New("public")
+		to   = schema.New("public")
+	)
+	from.AddObjects(
+		&DomainType{T: "d1", Schema: from, Type: &schema.IntegerType{T: TypeInteger}, Checks: []*schema.Check{{Name: "c1", Expr: "(VALUE > 0)"}}},
+		&DomainType{T: "d2", Schema: from, Type: &schema.IntegerType{T: TypeInteger}},
+		&DomainType{T: "d3", Schema: from, Type: &schema.IntegerType{T: TypeInteger}, Null: true},
+		&DomainType{T: "d4", Schema: from, Type: &schema.IntegerType{T: TypeInteger}, Checks: []*schema.Check{{Name: "c1", Expr: "(VALUE > 0)"}}},
+		&DomainType{T: "d5", Schema: from, Type: &schema.IntegerType{T: TypeInteger}, Default: &schema.Literal{V: "1"}},
+	)
+	to.AddObjects(
+		// Check expressions are compared with their wrapping parentheses removed.
+		&DomainType{T: "d1", Schema: to, Type: &schema.IntegerType{T: TypeInteger}, Checks: []*schema.Check{{Name: "c1", Expr: "VALUE > 0"}}},
+		&DomainType{T: "d3", Schema: to, Type: &schema.IntegerType{T: TypeInteger}},
+		&DomainType{T: "d4", Schema: to, Type: &schema.IntegerType{T: TypeInteger}, Checks: []*schema.Check{{Name: "c1", Expr: "VALUE > 1"}}},
+		&DomainType{T: "d5", Schema: to, Type: &schema.IntegerType{T: TypeInteger}, Default: &schema.Literal{V: "2"}},
+		&DomainType{T: "d6", Schema: to, Type: &schema.IntegerType{T: TypeInteger}},
+	)
+	changes, err := DefaultDiff.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.DropObject{O: from.Objects[1]},
+		&schema.ModifyObject{From: from.Objects[2], To: to.Objects[1]},
+		&schema.ModifyObject{From: from.Objects[3], To: to.Objects[2]},
+		&schema.ModifyObject{From: from.Objects[4], To: to.Objects[3]},
+		&schema.AddObject{O: to.Objects[4]},
+	}, changes)
+}
+
+func TestDiff_CompositeDiff(t *testing.T) {
+	var (
+		from = schema.New("public")
+		to   = schema.New("public")
+		intT = &schema.ColumnType{Type: &schema.IntegerType{T: TypeInteger}}
+		txtT = &schema.ColumnType{Type: &schema.StringType{T: TypeText}}
+	)
+	from.AddObjects(
+		&RangeType{T: "r1", Schema: from, Subtype: &schema.FloatType{T: TypeFloat8}},
+		&RangeType{T: "r2", Schema: from, Subtype: &schema.FloatType{T: TypeFloat8}},
+		&CompositeType{T: "c1", Schema: from, Fields: []*schema.Column{{Name: "a", Type: intT}, {Name: "b", Type: txtT}}},
+		&CompositeType{T: "c2", Schema: from, Fields: []*schema.Column{{Name: "a", Type: intT}}},
+		&CompositeType{T: "c3", Schema: from, Fields: []*schema.Column{{Name: "a", Type: intT}}},
+		&CompositeType{T: "c4", Schema: from, Fields: []*schema.Column{{Name: "a", Type: txtT}}},
+	)
+	to.AddObjects(
+		&RangeType{T: "r1", Schema: to, Subtype: &schema.FloatType{T: TypeFloat8}},
+		&RangeType{T: "r2", Schema: to, Subtype: &schema.FloatType{T: TypeFloat8}, Attrs: []schema.Attr{&schema.Comment{Text: "r2"}}},
+		// Field was dropped and another one was added.
+		&CompositeType{T: "c1", Schema: to, Fields: []*schema.Column{{Name: "a", Type: intT}, {Name: "c", Type: txtT}}},
+		// Field type was changed.
+		&CompositeType{T: "c3", Schema: to, Fields: []*schema.Column{{Name: "a", Type: &schema.ColumnType{Type: &schema.IntegerType{T: TypeBigInt}}}}},
+		// Field collation was changed.
+		&CompositeType{T: "c4", Schema: to, Fields: []*schema.Column{{Name: "a", Type: txtT, Attrs: []schema.Attr{&schema.Collation{V: "C"}}}}},
+		&CompositeType{T: "c5", Schema: to, Fields: []*schema.Column{{Name: "a", Type: intT}}},
+	)
+	changes, err := DefaultDiff.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyObject{From: from.Objects[1], To: to.Objects[1]},
+		&schema.ModifyObject{From: from.Objects[2], To: to.Objects[2]},
+		&schema.DropObject{O: from.Objects[3]},
+		&schema.ModifyObject{From: from.Objects[4], To: to.Objects[3]},
+		&schema.ModifyObject{From: from.Objects[5], To: to.Objects[4]},
+		&schema.AddObject{O: to.Objects[5]},
+	}, changes)
+}
+
+func TestDiff_SequenceDiff(t *testing.T) {
+	var (
+		minV = int64(1)
+		from = schema.New("public")
+		to   = schema.New("public")
+	)
+	from.AddObjects(
+		// Inspected sequences hold all their options.
+		&Sequence{Name: "s1", Schema: from, Type: &schema.IntegerType{T: TypeBigInt}, Start: 1, Increment: 1, Cache: 1},
+		&Sequence{Name: "s2", Schema: from, Type: &schema.IntegerType{T: TypeBigInt}, Start: 1, Increment: 1, Cache: 1},
+		&Sequence{Name: "s3", Schema: from},
+		&Sequence{Name: "s4", Schema: from},
+	)
+	to.AddObjects(
+		// Default options may be omitted.
+		&Sequence{Name: "s1", Schema: to, Min: &minV},
+		&Sequence{Name: "s2", Schema: to, Increment: 2, Cycle: true},
+		&Sequence{Name: "s4", Schema: to, Attrs: []schema.Attr{&schema.Comment{Text: "c"}}},
+		&Sequence{Name: "s5", Schema: to},
+	)
+	changes, err := DefaultDiff.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyObject{From: from.Objects[1], To: to.Objects[1]},
+		&schema.DropObject{O: from.Objects[2]},
+		&schema.ModifyObject{From: from.Objects[3], To: to.Objects[2]},
+		&schema.AddObject{O: to.Objects[3]},
+	}, changes)
+}
+
+func TestDiff_AggregateDiff(t *testing.T) {
+	var (
+		from   = schema.New("public")
+		to     = schema.New("public")
+		intT   = &schema.IntegerType{T: TypeInteger}
+		int4pl = &schema.Func{Name: "int4pl", Schema: schema.New("pg_catalog")}
+		args   = []*schema.FuncArg{{Type: intT}}
+	)
+	from.AddObjects(
+		&Aggregate{Name: "a1", Schema: from, Args: args, StateFunc: int4pl, StateType: intT},
+		&Aggregate{Name: "a2", Schema: from, Args: args, StateFunc: int4pl, StateType: intT},
+		&Aggregate{Name: "a3", Schema: from, Args: args, StateFunc: int4pl, StateType: intT},
+		&Aggregate{Name: "a4", Schema: from, Args: args, StateFunc: int4pl, StateType: intT, Parallel: AggregateParallelUnsafe},
+		&Aggregate{Name: "a5", Schema: from, Args: args, StateFunc: int4pl, StateType: intT},
+	)
+	to.AddObjects(
+		// Initial condition was added.
+		&Aggregate{Name: "a1", Schema: to, Args: args, StateFunc: int4pl, StateType: intT, InitCond: "0"},
+		// Comment was added.
+		&Aggregate{Name: "a3", Schema: to, Args: args, StateFunc: int4pl, StateType: intT, Attrs: []schema.Attr{&schema.Comment{Text: "c"}}},
+		// UNSAFE is the default parallel mode.
+		&Aggregate{Name: "a4", Schema: to, Args: args, StateFunc: int4pl, StateType: intT},
+		// State function was changed.
+		&Aggregate{Name: "a5", Schema: to, Args: args, StateFunc: &schema.Func{Name: "int4larger", Schema: schema.New("pg_catalog")}, StateType: intT},
+		&Aggregate{Name: "a6", Schema: to, Args: args, StateFunc: int4pl, StateType: intT},
+	)
+	changes, err := DefaultDiff.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.EqualValues(t, []schema.Change{
+		&schema.ModifyObject{From: from.Objects[0], To: to.Objects[0]},
+		&schema.DropObject{O: from.Objects[1]},
+		&schema.ModifyObject{From: from.Objects[2], To: to.Objects[1]},
+		&schema.ModifyObject{From: from.Objects[4], To: to.Objects[3]},
+		&schema.AddObject{O: to.Objects[4]},
+	}, changes)
+}
+
 func TestDefaultDiff(t *testing.T) {
 	changes, err := DefaultDiff.SchemaDiff(
 		schema.New("public").
@@ -662,3 +970,43 @@ concurrent_index {
 	require.Equal(t, `CREATE INDEX CONCURRENTLY "users_pkey_new" ON "public"."users" ("id")`, plan.Changes[1].Cmd)
 	require.Equal(t, `DROP INDEX CONCURRENTLY "public"."users_pkey_new"`, plan.Changes[1].Reverse)
 }
+
+func TestDiff_AnnotateTableChange(t *testing.T) {
+	// View dependencies exist only in the current state.
+	// For example, an inspected database and an HCL file.
+	var (
+		fromT = schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "integer"), schema.NewStringColumn("name", "character varying"))
+		fromV = schema.NewView("v1", "SELECT name FROM users").AddDeps(fromT)
+		from  = schema.New("public").AddTables(fromT).AddViews(fromV, schema.NewView("v2", "SELECT name FROM v1").AddDeps(fromV), schema.NewView("v3", "SELECT 1"))
+		toT   = schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "integer"), schema.NewStringColumn("name", "text"))
+		to    = schema.New("public").AddTables(toT).AddViews(schema.NewView("v1", "SELECT name FROM users"), schema.NewView("v2", "SELECT name FROM v1"), schema.NewView("v3", "SELECT 1"))
+	)
+	changes, err := DefaultDiff.SchemaDiff(from, to)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	m, ok := changes[0].(*schema.ModifyTable)
+	require.True(t, ok)
+	require.Equal(t, []schema.Clause{&CurrentTable{T: fromT}}, m.Extra)
+	plan, err := DefaultPlan.PlanChanges(context.Background(), "changes", changes)
+	require.NoError(t, err)
+	var cmds []string
+	for _, c := range plan.Changes {
+		cmds = append(cmds, c.Cmd)
+	}
+	require.Equal(t, []string{
+		`DROP VIEW "public"."v2"`,
+		`DROP VIEW "public"."v1"`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE text`,
+		`CREATE VIEW "public"."v1" AS SELECT name FROM users`,
+		`CREATE VIEW "public"."v2" AS SELECT name FROM v1`,
+	}, cmds)
+
+	// Tables without dependent views are not annotated.
+	changes, err = DefaultDiff.SchemaDiff(
+		schema.New("public").AddTables(schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "integer"))),
+		schema.New("public").AddTables(schema.NewTable("users").AddColumns(schema.NewIntColumn("id", "bigint"))),
+	)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	require.Empty(t, changes[0].(*schema.ModifyTable).Extra)
+}
@@ -418,6 +418,27 @@ func (c *conn) supportsIndexNullsDistinct() bool {
 	return c.version >= 15_00_00
 }
 
+// supportsProcKind reports if the server supports the pg_proc.prokind column (and procedures).
+func (c *conn) supportsProcKind() bool {
+	return c.version >= 11_00_00
+}
+
+// supportsExecFunc reports if the server supports the EXECUTE FUNCTION clause in trigger
+// definitions. Planning without a connection (unknown version) uses the newer syntax.
+func (c *conn) supportsExecFunc() bool {
+	return c.version == 0 || c.version >= 11_00_00
+}
+
+// supportsReplaceTrigger reports if the server supports the CREATE OR REPLACE TRIGGER command.
+func (c *conn) supportsReplaceTrigger() bool {
+	return c.version >= 14_00_00
+}
+
+// supportsSQLBody reports if the server supports SQL-standard function bodies.
+func (c *conn) supportsSQLBody() bool {
+	return c.version >= 14_00_00
+}
+
 type parser struct{}
 
 // ParseURL implements the sqlclient.URLParser interface.
@@ -597,125 +618,60 @@ const (
 	PartitionTypeHash  = "HASH"
 )
 
+// List of function volatility categories.
+const (
+	FuncVolatilityImmutable = "IMMUTABLE"
+	FuncVolatilityStable    = "STABLE"
+	FuncVolatilityVolatile  = "VOLATILE"
+)
+
+// List of function and procedure security modes.
+const (
+	FuncSecurityInvoker = "INVOKER"
+	FuncSecurityDefiner = "DEFINER"
+)
+
+// List of aggregate parallel safety modes.
+const (
+	AggregateParallelSafe       = "SAFE"
+	AggregateParallelRestricted = "RESTRICTED"
+	AggregateParallelUnsafe     = "UNSAFE"
+)
+
+// List of function languages that are printed as HCL enums.
+const (
+	FuncLangSQL     = "SQL"
+	FuncLangPLpgSQL = "PLpgSQL"
+)
+
+// List of row-level security policy types and commands.
+const (
+	PolicyAsPermissive  = "PERMISSIVE"
+	PolicyAsRestrictive = "RESTRICTIVE"
+	PolicyForAll        = "ALL"
+	PolicyForSelect     = "SELECT"
+	PolicyForInsert     = "INSERT"
+	PolicyForUpdate     = "UPDATE"
+	PolicyForDelete     = "DELETE"
+)
+
 var (
 	specOptions []schemahcl.Option
 	specFuncs   = &specutil.SchemaFuncs{
 		Table: tableSpec,
 		View:  viewSpec,
+		Func:  funcSpec,
+		Proc:  procSpec,
 	}
 	scanFuncs = &specutil.ScanFuncs{
-		Table: convertTable,
-		View:  convertView,
+		Table:    convertTable,
+		View:     convertView,
+		Func:     convertFunc,
+		Proc:     convertProc,
+		Triggers: convertTriggers,
 	}
 )
 
-func tableAttrsSpec(*schema.Table, *sqlspec.Table) {
-	// unimplemented.
-}
-
-func convertTableAttrs(*sqlspec.Table, *schema.Table) error {
-	return nil // unimplemented.
-}
-
-// tableAttrDiff allows extending table attributes diffing with build-specific logic.
-func (*diff) tableAttrDiff(_, _ *schema.Table) ([]schema.Change, error) {
-	return nil, nil // unimplemented.
-}
-
-// addTableAttrs allows extending table attributes creation with build-specific logic.
-func (*state) addTableAttrs(_ *schema.AddTable) {
-	// unimplemented.
-}
-
-// alterTableAttr allows extending table attributes alteration with build-specific logic.
-func (s *state) alterTableAttr(*sqlx.Builder, *schema.ModifyAttr) {
-	// unimplemented.
-}
-
-func realmObjectsSpec(*doc, *schema.Realm) error {
-	return nil // unimplemented.
-}
-
-func triggersSpec([]*schema.Trigger, *doc) error {
-	return nil // unimplemented.
-}
-
-func (*inspect) inspectViews(context.Context, *schema.Realm, *schema.InspectOptions) error {
-	return nil // unimplemented.
-}
-
-func (*inspect) inspectFuncs(context.Context, *schema.Realm, *schema.InspectOptions) error {
-	return nil // unimplemented.
-}
-
-func (*inspect) inspectTypes(context.Context, *schema.Realm, *schema.InspectOptions) error {
-	return nil // unimplemented.
-}
-
-func (*inspect) inspectObjects(context.Context, *schema.Realm, *schema.InspectOptions) error {
-	return nil // unimplemented.
-}
-
-func (*inspect) inspectTriggers(context.Context, *schema.Realm, *schema.InspectOptions) error {
-	return nil // unimplemented.
-}
-
-func (*inspect) inspectDeps(context.Context, *schema.Realm, *schema.InspectOptions) error {
-	return nil // unimplemented.
-}
-
-func (*inspect) inspectRealmObjects(context.Context, *schema.Realm, *schema.InspectOptions) error {
-	return nil // unimplemented.
-}
-
-func (*state) addView(*schema.AddView) error {
-	return nil // unimplemented.
-}
-
-func (*state) dropView(*schema.DropView) error {
-	return nil // unimplemented.
-}
-
-func (*state) modifyView(*schema.ModifyView) error {
-	return nil // unimplemented.
-}
-
-func (*state) renameView(*schema.RenameView) {
-	// unimplemented.
-}
-
-func (s *state) addFunc(*schema.AddFunc) error {
-	return nil // unimplemented.
-}
-
-func (s *state) dropFunc(*schema.DropFunc) error {
-	return nil // unimplemented.
-}
-
-func (s *state) modifyFunc(*schema.ModifyFunc) error {
-	return nil // unimplemented.
-}
-
-func (s *state) renameFunc(*schema.RenameFunc) error {
-	return nil // unimplemented.
-}
-
-func (s *state) addProc(*schema.AddProc) error {
-	return nil // unimplemented.
-}
-
-func (s *state) dropProc(*schema.DropProc) error {
-	return nil // unimplemented.
-}
-
-func (s *state) modifyProc(*schema.ModifyProc) error {
-	return nil // unimplemented.
-}
-
-func (s *state) renameProc(*schema.RenameProc) error {
-	return nil // unimplemented.
-}
-
 func (s *state) addObject(add *schema.AddObject) error {
 	switch o := add.O.(type) {
 	case *schema.EnumType:
@@ -726,6 +682,20 @@ func (s *state) addObject(add *schema.AddObject) error {
 			Reverse: drop,
 			Comment: fmt.Sprintf("create enum type %q", o.T),
 		})
+	case *Extension:
+		s.addExtension(add, o)
+	case *EventTrigger:
+		return s.addEventTrigger(add, o)
+	case *Sequence:
+		return s.addSequence(add, o)
+	case *DomainType:
+		return s.addDomain(add, o)
+	case *RangeType:
+		return s.addRange(add, o)
+	case *CompositeType:
+		return s.addComposite(add, o)
+	case *Aggregate:
+		return s.addAggregate(add, o)
 	default:
 		// unsupported object type.
 	}
@@ -742,6 +712,20 @@ func (s *state) dropObject(drop *schema.DropObject) error {
 			Reverse: create,
 			Comment: fmt.Sprintf("drop enum type %q", o.T),
 		})
+	case *Extension:
+		s.dropExtension(drop, o)
+	case *EventTrigger:
+		return s.dropEventTrigger(drop, o)
+	case *Sequence:
+		return s.dropSequence(drop, o)
+	case *DomainType:
+		return s.dropDomain(drop, o)
+	case *RangeType:
+		return s.dropRange(drop, o)
+	case *CompositeType:
+		return s.dropComposite(drop, o)
+	case *Aggregate:
+		return s.dropAggregate(drop, o)
 	default:
 		// unsupported object type.
 	}
@@ -749,41 +733,30 @@ func (s *state) dropObject(drop *schema.DropObject) error {
 }
 
 func (s *state) modifyObject(modify *schema.ModifyObject) error {
-	if _, ok := modify.From.(*schema.EnumType); ok {
+	switch modify.From.(type) {
+	case *schema.EnumType:
 		return s.alterEnum(modify)
+	case *Extension:
+		return s.modifyExtension(modify)
+	case *EventTrigger:
+		return s.modifyEventTrigger(modify)
+	case *Sequence:
+		return s.modifySequence(modify)
+	case *DomainType:
+		return s.modifyDomain(modify)
+	case *RangeType:
+		return s.modifyRange(modify)
+	case *CompositeType:
+		return s.modifyComposite(modify)
+	case *Aggregate:
+		return s.modifyAggregate(modify)
 	}
 	return nil // unimplemented.
 }
 
-func (*state) addTrigger(*schema.AddTrigger) error {
-	return nil // unimplemented.
-}
-
-func (*state) dropTrigger(*schema.DropTrigger) error {
-	return nil // unimplemented.
-}
-
-func (*state) renameTrigger(*schema.RenameTrigger) error {
-	return nil // unimplemented.
-}
-
-func (*state) modifyTrigger(*schema.ModifyTrigger) error {
-	return nil // unimplemented.
-}
-
-func (*diff) ViewAttrChanges(_, _ *schema.View) []schema.Change {
-	return nil // unimplemented.
-}
-
-// RealmObjectDiff returns a changeset for migrating realm (database) objects
-// from one state to the other. For example, adding extensions or users.
-func (*diff) RealmObjectDiff(_, _ *schema.Realm) ([]schema.Change, error) {
-	return nil, nil // unimplemented.
-}
-
 // SchemaObjectDiff returns a changeset for migrating schema objects from
 // one state to the other.
-func (*diff) SchemaObjectDiff(from, to *schema.Schema, _ *schema.DiffOptions) ([]schema.Change, error) {
+func (d *diff) SchemaObjectDiff(from, to *schema.Schema, _ *schema.DiffOptions) ([]schema.Change, error) {
 	var changes []schema.Change
 	// Drop or modify enums.
 	for _, o1 := range from.Objects {
@@ -816,53 +789,33 @@ func (*diff) SchemaObjectDiff(from, to *schema.Schema, _ *schema.DiffOptions) ([
 			changes = append(changes, &schema.AddObject{O: e1})
 		}
 	}
-	return changes, nil
-}
-
-func verifyChanges(context.Context, []schema.Change) error {
-	return nil // unimplemented.
-}
-
-func convertDomains(_ []*sqlspec.Table, domains []*domain, _ *schema.Realm) error {
-	if len(domains) > 0 {
-		return fmt.Errorf("postgres: domains are not supported by this version. Use: https://atlasgo.io/getting-started")
+	domains, err := d.domainsDiff(from, to)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-func convertAggregate(d *doc, _ *schema.Realm) error {
-	if len(d.Aggregates) > 0 {
-		return fmt.Errorf("postgres: aggregates are not supported by this version. Use: https://atlasgo.io/getting-started")
+	ranges, err := d.rangesDiff(from, to)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-func convertSequences(_ []*sqlspec.Table, seqs []*sqlspec.Sequence, _ *schema.Realm) error {
-	if len(seqs) > 0 {
-		return fmt.Errorf("postgres: sequences are not supported by this version. Use: https://atlasgo.io/getting-started")
+	composites, err := d.compositesDiff(from, to)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-func convertPolicies(_ []*sqlspec.Table, ps []*policy, _ *schema.Realm) error {
-	if len(ps) > 0 {
-		return fmt.Errorf("postgres: policies are not supported by this version. Use: https://atlasgo.io/getting-started")
+	seqs, err := d.sequencesDiff(from, to)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-func convertExtensions(exs []*extension, _ *schema.Realm) error {
-	if len(exs) > 0 {
-		return fmt.Errorf("postgres: extensions are not supported by this version. Use: https://atlasgo.io/getting-started")
+	aggs, err := d.aggregatesDiff(from, to)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	changes = append(append(changes, domains...), ranges...)
+	changes = append(append(changes, composites...), seqs...)
+	return append(changes, aggs...), nil
 }
 
-func convertEventTriggers(evs []*eventTrigger, _ *schema.Realm) error {
-	if len(evs) > 0 {
-		return fmt.Errorf("postgres: event triggers are not supported by this version. Use: https://atlasgo.io/getting-started")
-	}
-	return nil
+func verifyChanges(context.Context, []schema.Change) error {
+	return nil // unimplemented.
 }
 
 func normalizeRealm(*schema.Realm) error {
@@ -876,12 +829,48 @@ func schemasObjectSpec(*doc, ...*schema.Schema) error {
 // objectSpec converts from a concrete schema objects into specs.
 func objectSpec(d *doc, spec *specutil.SchemaSpec, s *schema.Schema) error {
 	for _, o := range s.Objects {
-		if e, ok := o.(*schema.EnumType); ok {
+		switch o := o.(type) {
+		case *schema.EnumType:
 			d.Enums = append(d.Enums, &enum{
-				Name:   e.T,
-				Values: e.Values,
+				Name:   o.T,
+				Values: o.Values,
 				Schema: specutil.SchemaRef(spec.Schema.Name),
 			})
+		case *DomainType:
+			dm, err := domainSpec(spec, o)
+			if err != nil {
+				return err
+			}
+			d.Domains = append(d.Domains, dm)
+		case *RangeType:
+			rt, err := rangeSpec(spec, o)
+			if err != nil {
+				return err
+			}
+			d.Ranges = append(d.Ranges, rt)
+		case *CompositeType:
+			c, err := compositeSpec(spec, o)
+			if err != nil {
+				return err
+			}
+			d.Composites = append(d.Composites, c)
+		case *Sequence:
+			seq, err := sequenceSpec(spec, o)
+			if err != nil {
+				return err
+			}
+			d.Sequences = append(d.Sequences, seq)
+		case *Aggregate:
+			a, err := aggregateSpec(spec, o)
+			if err != nil {
+				return err
+			}
+			d.Aggregates = append(d.Aggregates, a)
+		}
+	}
+	for _, t := range s.Tables {
+		for _, p := range policies(t) {
+			d.Policies = append(d.Policies, policySpec(t, p))
 		}
 	}
 	return nil
@@ -992,8 +981,8 @@ func excludeSpec(*sqlspec.Table, *sqlspec.Index, *schema.Index, *Constraint) err
 }
 
 const (
-	// Query to list tables information.
-	// Note, 'attrs' are not supported in this version.
+	// Query to list tables information. The 'attrs' column holds the
+	// row-level security configuration and the policies of the table.
 	tablesQuery = `
 SELECT
 	t3.oid,
@@ -1003,7 +992,15 @@ SELECT
 	t4.partattrs AS partition_attrs,
 	t4.partstrat AS partition_strategy,
 	pg_get_expr(t4.partexprs, t4.partrelid) AS partition_exprs,
-	'{}' AS attrs
+	json_build_object(
+		'row_security', t3.relrowsecurity,
+		'force_row_security', t3.relforcerowsecurity,
+		'policies', (
+			SELECT json_agg(json_build_object('name', p.policyname, 'as', p.permissive, 'for', p.cmd, 'to', p.roles, 'using', p.qual, 'check', p.with_check) ORDER BY p.policyname)
+			FROM pg_catalog.pg_policies AS p
+			WHERE p.schemaname = t1.table_schema AND p.tablename = t1.table_name
+		)
+	) AS attrs
 FROM
 	INFORMATION_SCHEMA.TABLES AS t1
 	JOIN pg_catalog.pg_namespace AS t2 ON t2.nspname = t1.table_schema
@@ -1019,7 +1016,6 @@ ORDER BY
 	t1.table_schema, t1.table_name
 `
 	// Query to list tables by their names.
-	// Note, 'attrs' are not supported in this version.
 	tablesQueryArgs = `
 SELECT
 	t3.oid,
@@ -1029,7 +1025,15 @@ SELECT
 	t4.partattrs AS partition_attrs,
 	t4.partstrat AS partition_strategy,
 	pg_get_expr(t4.partexprs, t4.partrelid) AS partition_exprs,
-	'{}' AS attrs
+	json_build_object(
+		'row_security', t3.relrowsecurity,
+		'force_row_security', t3.relforcerowsecurity,
+		'policies', (
+			SELECT json_agg(json_build_object('name', p.policyname, 'as', p.permissive, 'for', p.cmd, 'to', p.roles, 'using', p.qual, 'check', p.with_check) ORDER BY p.policyname)
+			FROM pg_catalog.pg_policies AS p
+			WHERE p.schemaname = t1.table_schema AND p.tablename = t1.table_name
+		)
+	) AS attrs
 FROM
 	INFORMATION_SCHEMA.TABLES AS t1
 	JOIN pg_catalog.pg_namespace AS t2 ON t2.nspname = t1.table_schema
@@ -13,7 +13,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -265,10 +267,58 @@ func (i *inspect) tables(ctx context.Context, realm *schema.Realm, opts *schema.
 				exprs: partexprs.String,
 			})
 		}
+		if sqlx.ValidString(extra) {
+			if err := tableAttrs(t, extra.String); err != nil {
+				return err
+			}
+		}
 	}
 	return rows.Err()
 }
 
+// tableAttrs sets the row-level security configuration
+// and the policies of the table from their JSON form.
+func tableAttrs(t *schema.Table, attrs string) error {
+	var v struct {
+		RowSecurity      bool `json:"row_security"`
+		ForceRowSecurity bool `json:"force_row_security"`
+		Policies         []struct {
+			Name  string   `json:"name"`
+			As    string   `json:"as"`
+			For   string   `json:"for"`
+			To    []string `json:"to"`
+			Using string   `json:"using"`
+			Check string   `json:"check"`
+		} `json:"policies"`
+	}
+	if err := json.Unmarshal([]byte(attrs), &v); err != nil {
+		return fmt.Errorf("postgres: parsing attributes of table %q: %w", t.Name, err)
+	}
+	if v.RowSecurity || v.ForceRowSecurity {
+		t.AddAttrs(&RowSecurity{Enabled: v.RowSecurity, Enforced: v.ForceRowSecurity})
+	}
+	for _, p := range v.Policies {
+		to := make([]string, len(p.To))
+		for i, r := range p.To {
+			// The PUBLIC pseudo-role is returned in lowercase.
+			if r == "public" {
+				r = "PUBLIC"
+			}
+			to[i] = r
+		}
+		t.AddAttrs(&Policy{
+			Name:  p.Name,
+			Table: t,
+			As:    p.As,
+			For:   p.For,
+			To:    to,
+			Using: p.Using,
+			Check: p.Check,
+		})
+	}
+	return nil
+}
+
 // columns queries and appends the columns of the given table.
 func (i *inspect) columns(ctx context.Context, s *schema.Schema) error {
 	query := columnsQuery
@@ -423,6 +473,8 @@ func (i *inspect) underlyingType(s *schema.Schema, u *UserDefinedType) schema.Ty
 				return d
 			} else if c, ok := o.(*CompositeType); ok && c.T == name {
 				return c
+			} else if r, ok := o.(*RangeType); ok && r.T == name {
+				return r
 			}
 		}
 	}
@@ -430,6 +482,210 @@ func (i *inspect) underlyingType(s *schema.Schema, u *UserDefinedType) schema.Ty
 	return u
 }
 
+// inspectTypes queries and appends the user-defined types of the schemas,
+// such as domains, range and composite types.
+func (i *inspect) inspectTypes(ctx context.Context, r *schema.Realm, _ *schema.InspectOptions) error {
+	if err := i.inspectDomains(ctx, r); err != nil {
+		return err
+	}
+	if err := i.inspectRanges(ctx, r); err != nil {
+		return err
+	}
+	return i.inspectComposites(ctx, r)
+}
+
+// inspectRanges queries and appends the range types of the schemas.
+func (i *inspect) inspectRanges(ctx context.Context, r *schema.Realm) error {
+	// User-defined range types are not supported by CockroachDB.
+	if len(r.Schemas) == 0 || i.crdb {
+		return nil
+	}
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(rangesQuery, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying range types: %w", err)
+	}
+	defer rows.Close()
+	var (
+		ranges   []*RangeType
+		subtypes []string
+	)
+	for rows.Next() {
+		var (
+			oid               int64
+			ns, name, subtype string
+			comment           sql.NullString
+		)
+		if err := rows.Scan(&oid, &ns, &name, &subtype, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning range type information: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for range type %q was not found in realm", ns, name)
+		}
+		rt := &RangeType{T: name, Schema: s, Attrs: []schema.Attr{&OID{V: oid}}}
+		if sqlx.ValidString(comment) {
+			rt.Attrs = append(rt.Attrs, &schema.Comment{Text: comment.String})
+		}
+		s.AddObjects(rt)
+		ranges, subtypes = append(ranges, rt), append(subtypes, subtype)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	for idx, rt := range ranges {
+		t, err := i.parseType(rt.Schema, subtypes[idx])
+		if err != nil {
+			return fmt.Errorf("postgres: parsing subtype of range type %q: %w", rt.T, err)
+		}
+		rt.Subtype = t
+	}
+	return nil
+}
+
+// inspectComposites queries and appends the composite types of the schemas.
+func (i *inspect) inspectComposites(ctx context.Context, r *schema.Realm) error {
+	// Composite types are not supported by CockroachDB.
+	if len(r.Schemas) == 0 || i.crdb {
+		return nil
+	}
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(compositesQuery, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying composite types: %w", err)
+	}
+	defer rows.Close()
+	// Field types are parsed after all composite types were
+	// added, as a field can be defined using another composite.
+	var (
+		composites []*CompositeType
+		types      [][]string
+	)
+	for rows.Next() {
+		var (
+			oid             int64
+			ns, name        string
+			fields, comment sql.NullString
+		)
+		if err := rows.Scan(&oid, &ns, &name, &fields, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning composite type information: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for composite type %q was not found in realm", ns, name)
+		}
+		c := &CompositeType{T: name, Schema: s, Attrs: []schema.Attr{&OID{V: oid}}}
+		var fs []struct{ Name, Type, Collation string }
+		if sqlx.ValidString(fields) {
+			if err := json.Unmarshal([]byte(fields.String), &fs); err != nil {
+				return fmt.Errorf("postgres: parsing fields of composite type %q: %w", name, err)
+			}
+		}
+		ts := make([]string, 0, len(fs))
+		for _, f := range fs {
+			fc := &schema.Column{Name: f.Name, Type: &schema.ColumnType{Raw: f.Type, Null: true}}
+			if f.Collation != "" {
+				fc.SetCollation(f.Collation)
+			}
+			c.Fields, ts = append(c.Fields, fc), append(ts, f.Type)
+		}
+		if sqlx.ValidString(comment) {
+			c.Attrs = append(c.Attrs, &schema.Comment{Text: comment.String})
+		}
+		s.AddObjects(c)
+		composites, types = append(composites, c), append(types, ts)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	for idx, c := range composites {
+		for j, f := range c.Fields {
+			t, err := i.parseType(c.Schema, types[idx][j])
+			if err != nil {
+				return fmt.Errorf("postgres: parsing type of field %q in composite type %q: %w", f.Name, c.T, err)
+			}
+			f.Type.Type = t
+		}
+	}
+	return nil
+}
+
+// inspectDomains queries and appends the domain types of the schemas.
+func (i *inspect) inspectDomains(ctx context.Context, r *schema.Realm) error {
+	// Domains are not supported by CockroachDB.
+	if len(r.Schemas) == 0 || i.crdb {
+		return nil
+	}
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(domainsQuery, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying domains: %w", err)
+	}
+	defer rows.Close()
+	// Base types are parsed after all domains were added,
+	// as a domain can be defined on top of another domain.
+	var (
+		domains []*DomainType
+		types   []string
+	)
+	for rows.Next() {
+		var (
+			oid                       int64
+			notNull                   bool
+			ns, name, typ             string
+			defaults, checks, comment sql.NullString
+		)
+		if err := rows.Scan(&oid, &ns, &name, &typ, &notNull, &defaults, &checks, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning domain information: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for domain %q was not found in realm", ns, name)
+		}
+		d := &DomainType{T: name, Schema: s, Null: !notNull, Attrs: []schema.Attr{&OID{V: oid}}}
+		if sqlx.ValidString(defaults) {
+			d.Default = &schema.RawExpr{X: defaults.String}
+		}
+		if sqlx.ValidString(checks) {
+			var cks []struct{ Name, Expr string }
+			if err := json.Unmarshal([]byte(checks.String), &cks); err != nil {
+				return fmt.Errorf("postgres: parsing checks of domain %q: %w", name, err)
+			}
+			for _, c := range cks {
+				d.Checks = append(d.Checks, &schema.Check{Name: c.Name, Expr: c.Expr})
+			}
+		}
+		if sqlx.ValidString(comment) {
+			d.Attrs = append(d.Attrs, &schema.Comment{Text: comment.String})
+		}
+		s.AddObjects(d)
+		domains, types = append(domains, d), append(types, typ)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	for idx, d := range domains {
+		t, err := i.parseType(d.Schema, types[idx])
+		if err != nil {
+			return fmt.Errorf("postgres: parsing base type of domain %q: %w", d.T, err)
+		}
+		d.Type = t
+		if x, ok := d.Default.(*schema.RawExpr); ok {
+			d.Default = defaultExpr(t, x.X)
+		}
+	}
+	return nil
+}
+
 // enumValues fills enum columns with their values from the database.
 func (i *inspect) inspectEnums(ctx context.Context, r *schema.Realm) error {
 	var (
@@ -777,119 +1033,900 @@ func (i *inspect) addChecks(s *schema.Schema, rows *sql.Rows) error {
 	return nil
 }
 
-// schemas returns the list of the schemas in the database.
-func (i *inspect) schemas(ctx context.Context, opts *schema.InspectRealmOption) ([]*schema.Schema, error) {
+// inspectViews queries and appends the views and materialized views of the given realm.
+func (i *inspect) inspectViews(ctx context.Context, r *schema.Realm, opts *schema.InspectOptions) error {
 	var (
 		args  []any
-		query = schemasQuery
+		query = fmt.Sprintf(viewsQuery, nArgs(0, len(r.Schemas)))
 	)
-	if opts != nil {
-		switch n := len(opts.Schemas); {
-		case n == 1 && opts.Schemas[0] == "":
-			query = fmt.Sprintf(schemasQueryArgs, "= CURRENT_SCHEMA()")
-		case n == 1 && opts.Schemas[0] != "":
-			query = fmt.Sprintf(schemasQueryArgs, "= $1")
-			args = append(args, opts.Schemas[0])
-		case n > 0:
-			query = fmt.Sprintf(schemasQueryArgs, "IN ("+nArgs(0, len(opts.Schemas))+")")
-			for _, s := range opts.Schemas {
-				args = append(args, s)
-			}
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	if opts != nil && len(opts.Tables) > 0 {
+		for _, t := range opts.Tables {
+			args = append(args, t)
 		}
+		query = fmt.Sprintf(viewsQueryArgs, nArgs(0, len(r.Schemas)), nArgs(len(r.Schemas), len(opts.Tables)))
 	}
 	rows, err := i.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("postgres: querying schemas: %w", err)
+		return fmt.Errorf("postgres: querying views: %w", err)
+	}
+	if err := i.addViews(r, rows); err != nil {
+		return err
+	}
+	for _, s := range r.Schemas {
+		if len(s.Views) == 0 {
+			continue
+		}
+		if err := i.viewColumns(ctx, s); err != nil {
+			return err
+		}
+		if err := i.viewIndexes(ctx, s); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// addViews scans the rows and adds the views to their schemas.
+func (i *inspect) addViews(r *schema.Realm, rows *sql.Rows) error {
 	defer rows.Close()
-	var schemas []*schema.Schema
 	for rows.Next() {
 		var (
-			name    string
-			comment sql.NullString
+			oid                                   sql.NullInt64
+			materialized                          bool
+			vSchema, name, def, checkOpt, comment sql.NullString
 		)
-		if err := rows.Scan(&name, &comment); err != nil {
-			return nil, err
+		if err := rows.Scan(&oid, &vSchema, &name, &def, &materialized, &checkOpt, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning view information: %w", err)
 		}
-		s := schema.New(name)
-		if comment.Valid {
-			s.SetComment(comment.String)
+		if !sqlx.ValidString(vSchema) || !sqlx.ValidString(name) {
+			return fmt.Errorf("postgres: invalid schema or view name: %q.%q", vSchema.String, name.String)
+		}
+		s, ok := r.Schema(vSchema.String)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for view %q was not found in realm", vSchema.String, name.String)
+		}
+		v := schema.NewView(name.String, sqlx.TrimViewExtra(def.String)).
+			SetMaterialized(materialized)
+		s.AddViews(v)
+		if oid.Valid {
+			v.AddAttrs(&OID{V: oid.Int64})
+		}
+		if sqlx.ValidString(checkOpt) && checkOpt.String != schema.ViewCheckOptionNone {
+			v.SetCheckOption(checkOpt.String)
+		}
+		if sqlx.ValidString(comment) {
+			v.SetComment(comment.String)
 		}
-		schemas = append(schemas, s)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
 	}
-	return schemas, nil
+	return rows.Close()
 }
 
-func (i *inspect) querySchema(ctx context.Context, query string, s *schema.Schema) (*sql.Rows, error) {
-	args := []any{s.Name}
-	for _, t := range s.Tables {
-		args = append(args, t.Name)
+// viewColumns queries and appends the columns of the views in the given schema.
+// Unlike tables, the columns are queried from pg_attribute, because the
+// information_schema.columns view does not include materialized views.
+func (i *inspect) viewColumns(ctx context.Context, s *schema.Schema) error {
+	rows, err := i.queryViews(ctx, viewColumnsQuery, s, s.Views)
+	if err != nil {
+		return fmt.Errorf("postgres: querying schema %q view columns: %w", s.Name, err)
 	}
-	return i.QueryContext(ctx, fmt.Sprintf(query, nArgs(1, len(s.Tables))), args...)
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			notnull                     bool
+			view, name, fmtype, comment sql.NullString
+		)
+		if err := rows.Scan(&view, &name, &fmtype, &notnull, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning view column: %w", err)
+		}
+		v, ok := viewByName(s, view.String)
+		if !ok {
+			return fmt.Errorf("postgres: view %q was not found in schema %q", view.String, s.Name)
+		}
+		t, err := i.parseType(s, fmtype.String)
+		if err != nil {
+			return fmt.Errorf("postgres: parsing type %q of view column %q: %w", fmtype.String, name.String, err)
+		}
+		c := &schema.Column{
+			Name: name.String,
+			Type: &schema.ColumnType{Type: t, Raw: fmtype.String, Null: !notnull},
+		}
+		if sqlx.ValidString(comment) {
+			c.SetComment(comment.String)
+		}
+		v.AddColumns(c)
+	}
+	return rows.Err()
 }
 
-func nArgs(start, n int) string {
-	var b strings.Builder
-	for i := 1; i <= n; i++ {
-		if i > 1 {
-			b.WriteString(", ")
+// viewIndexes queries and appends the indexes of the materialized views in the given schema.
+func (i *inspect) viewIndexes(ctx context.Context, s *schema.Schema) error {
+	var views []*schema.View
+	for _, v := range s.Views {
+		if v.Materialized() {
+			views = append(views, v)
 		}
-		b.WriteByte('$')
-		b.WriteString(strconv.Itoa(start + i))
 	}
-	return b.String()
+	// Indexes on materialized views are not supported by CockroachDB.
+	if len(views) == 0 || i.crdb {
+		return nil
+	}
+	rows, err := i.queryViews(ctx, i.indexesQuery(), s, views)
+	if err != nil {
+		return fmt.Errorf("postgres: querying schema %q materialized view indexes: %w", s.Name, err)
+	}
+	defer rows.Close()
+	if err := i.addIndexes(s, rows, queryScope{
+		hasT: func(tv string) bool {
+			_, ok := s.Materialized(tv)
+			return ok
+		},
+		setPK: func(tv string, _ *schema.Index) error {
+			return fmt.Errorf("postgres: unexpected primary key on materialized view %q", tv)
+		},
+		addIndex: func(tv string, idx *schema.Index) error {
+			if v, ok := s.Materialized(tv); ok {
+				v.AddIndexes(idx)
+				return nil
+			}
+			return fmt.Errorf("postgres: materialized view %q for index was not found in schema", tv)
+		},
+		column: func(tv, name string) (*schema.Column, bool) {
+			if v, ok := s.Materialized(tv); ok {
+				return v.Column(name)
+			}
+			return nil, false
+		},
+	}); err != nil {
+		return err
+	}
+	return rows.Err()
 }
 
-// A regexp to extracts the sequence name from a "nextval" expression.
-// nextval('<optional (quoted) schema>.<sequence name>'::regclass).
-var reNextval = regexp.MustCompile(`(?i) *nextval\('(?:"?[\w$]+"?\.)?"?([\w$]+_[\w$]+_seq)"?'(?:::regclass)*\) *$`)
-
-func columnDefault(c *schema.Column, s string) {
-	switch m := reNextval.FindStringSubmatch(s); {
-	// The definition of "<column> <serial type>" is equivalent to specifying:
-	// "<column> <int type> NOT NULL DEFAULT nextval('<table>_<column>_seq')".
-	// https://postgresql.org/docs/current/datatype-numeric.html#DATATYPE-SERIAL.
-	case len(m) == 2:
-		tt, ok := c.Type.Type.(*schema.IntegerType)
-		if !ok {
-			return
-		}
-		st := &SerialType{SequenceName: m[1]}
-		st.SetType(tt)
-		c.Type.Raw = st.T
-		c.Type.Type = st
-	default:
-		c.Default = defaultExpr(c.Type.Type, s)
+// queryViews is like querySchema, but for the given views.
+func (i *inspect) queryViews(ctx context.Context, query string, s *schema.Schema, views []*schema.View) (*sql.Rows, error) {
+	args := []any{s.Name}
+	for _, v := range views {
+		args = append(args, v.Name)
 	}
+	return i.QueryContext(ctx, fmt.Sprintf(query, nArgs(1, len(views))), args...)
 }
 
-func defaultExpr(t schema.Type, s string) schema.Expr {
-	switch {
-	case sqlx.IsLiteralBool(s), sqlx.IsLiteralNumber(s), sqlx.IsQuoted(s, '\''):
-		return &schema.Literal{V: s}
-	default:
-		var x schema.Expr = &schema.RawExpr{X: s}
-		// Try casting or fallback to raw expressions (e.g. column text[] has the default of '{}':text[]).
-		if v, ok := canConvert(t, s); ok {
-			x = &schema.Literal{V: v}
-		}
-		return x
+// viewByName returns the view or materialized view with the given name. Note,
+// views and materialized views share the same namespace in PostgreSQL.
+func viewByName(s *schema.Schema, name string) (*schema.View, bool) {
+	if v, ok := s.View(name); ok {
+		return v, true
 	}
+	return s.Materialized(name)
 }
 
-func canConvert(t schema.Type, x string) (string, bool) {
-	i := strings.LastIndex(x, "::")
-	if i == -1 || !sqlx.IsQuoted(x[:i], '\'') {
-		return "", false
+// inspectFuncs queries and appends the functions and procedures of the realm schemas.
+func (i *inspect) inspectFuncs(ctx context.Context, r *schema.Realm, _ *schema.InspectOptions) error {
+	// User-defined functions catalog is not compatible in CockroachDB.
+	if len(r.Schemas) == 0 || i.crdb {
+		return nil
 	}
-	q := x[0:i]
-	x = x[1 : i-1]
-	switch t.(type) {
-	case *schema.EnumType:
-		return q, true
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	kind, body := funcsKindBelow11, "NULL"
+	if i.supportsProcKind() {
+		kind = "p.prokind"
+	}
+	if i.supportsSQLBody() {
+		body = funcsSQLBody
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(funcsQuery, kind, body, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying functions: %w", err)
+	}
+	oids, byOID, err := i.addFuncs(r, rows)
+	if err != nil || len(oids) == 0 {
+		return err
+	}
+	return i.funcArgs(ctx, oids, byOID)
+}
+
+// addFuncs scans the rows and adds the functions and procedures to their schemas.
+// It returns the scanned oids (in order) and their corresponding objects.
+func (i *inspect) addFuncs(r *schema.Realm, rows *sql.Rows) ([]any, map[int64]schema.Object, error) {
+	defer rows.Close()
+	var (
+		oids  []any
+		byOID = make(map[int64]schema.Object)
+	)
+	for rows.Next() {
+		var (
+			oid                                     int64
+			retset, secdef                          bool
+			fSchema, name, kind, lang, src, sqlbody sql.NullString
+			rettype, volatility, comment            sql.NullString
+			attrs                                   []schema.Attr
+		)
+		if err := rows.Scan(&oid, &fSchema, &name, &kind, &lang, &src, &sqlbody, &rettype, &retset, &volatility, &secdef, &comment); err != nil {
+			return nil, nil, fmt.Errorf("postgres: scanning function information: %w", err)
+		}
+		s, ok := r.Schema(fSchema.String)
+		if !ok {
+			return nil, nil, fmt.Errorf("postgres: schema %q for function %q was not found in realm", fSchema.String, name.String)
+		}
+		body := src.String
+		if sqlx.ValidString(sqlbody) {
+			body = sqlbody.String
+		}
+		attrs = append(attrs, &OID{V: oid})
+		switch volatility.String {
+		case "i":
+			attrs = append(attrs, &FuncVolatility{V: FuncVolatilityImmutable})
+		case "s":
+			attrs = append(attrs, &FuncVolatility{V: FuncVolatilityStable})
+		}
+		if secdef {
+			attrs = append(attrs, &FuncSecurity{V: FuncSecurityDefiner})
+		}
+		if sqlx.ValidString(comment) {
+			attrs = append(attrs, &schema.Comment{Text: comment.String})
+		}
+		switch kind.String {
+		case "p":
+			p := &schema.Proc{Name: name.String, Schema: s, Lang: lang.String, Body: body, Attrs: attrs}
+			s.AddProcs(p)
+			byOID[oid] = p
+		case "f":
+			f := &schema.Func{Name: name.String, Schema: s, Lang: lang.String, Body: body, Attrs: attrs}
+			if retset {
+				// Set-returning functions are kept as-is (e.g., SETOF integer).
+				f.Ret = &UserDefinedType{T: "SETOF " + rettype.String}
+			} else {
+				t, err := i.parseType(s, rettype.String)
+				if err != nil {
+					return nil, nil, fmt.Errorf("postgres: parsing return type %q of function %q: %w", rettype.String, name.String, err)
+				}
+				f.Ret = t
+			}
+			s.AddFuncs(f)
+			byOID[oid] = f
+		default:
+			continue // Aggregates and window functions.
+		}
+		oids = append(oids, oid)
+	}
+	return oids, byOID, rows.Close()
+}
+
+// funcArgs queries and appends the arguments of the given functions, procedures and aggregates.
+func (i *inspect) funcArgs(ctx context.Context, oids []any, byOID map[int64]schema.Object) error {
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(funcArgsQuery, nArgs(0, len(oids))), oids...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying function arguments: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			oid                       int64
+			name, typ, mode, defaultX sql.NullString
+			s                         *schema.Schema
+			args                      *[]*schema.FuncArg
+		)
+		if err := rows.Scan(&oid, &name, &typ, &mode, &defaultX); err != nil {
+			return fmt.Errorf("postgres: scanning function argument: %w", err)
+		}
+		switch f := byOID[oid].(type) {
+		case *schema.Func:
+			s, args = f.Schema, &f.Args
+		case *schema.Proc:
+			s, args = f.Schema, &f.Args
+		case *Aggregate:
+			s, args = f.Schema, &f.Args
+		default:
+			return fmt.Errorf("postgres: function with oid %d was not found", oid)
+		}
+		t, err := i.parseType(s, typ.String)
+		if err != nil {
+			return fmt.Errorf("postgres: parsing type %q of function argument %q: %w", typ.String, name.String, err)
+		}
+		a := &schema.FuncArg{Name: name.String, Type: t, Mode: schema.FuncArgModeIn}
+		switch mode.String {
+		case "o", "t":
+			a.Mode = schema.FuncArgModeOut
+		case "b":
+			a.Mode = schema.FuncArgModeInOut
+		case "v":
+			a.Mode = schema.FuncArgModeVariadic
+		}
+		if sqlx.ValidString(defaultX) {
+			a.Default = &schema.RawExpr{X: defaultX.String}
+		}
+		*args = append(*args, a)
+	}
+	return rows.Close()
+}
+
+// inspectTriggers queries and appends the triggers of the realm tables and views.
+func (i *inspect) inspectTriggers(ctx context.Context, r *schema.Realm, _ *schema.InspectOptions) error {
+	if len(r.Schemas) == 0 || i.crdb {
+		return nil
+	}
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(triggersQuery, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying triggers: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			typ                                   int64
+			tSchema, tName, tKind, name, updateOf sql.NullString
+			when, fSchema, fName, fArgs, comment  sql.NullString
+		)
+		if err := rows.Scan(&tSchema, &tName, &tKind, &name, &typ, &updateOf, &when, &fSchema, &fName, &fArgs, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning trigger information: %w", err)
+		}
+		s, ok := r.Schema(tSchema.String)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for trigger %q was not found in realm", tSchema.String, name.String)
+		}
+		tr := &schema.Trigger{Name: name.String, ActionTime: schema.TriggerTimeAfter, For: schema.TriggerForStmt}
+		var columns interface {
+			Column(string) (*schema.Column, bool)
+		}
+		switch tKind.String {
+		case "v", "m":
+			v, ok := s.View(tName.String)
+			if !ok {
+				continue // Views that were not inspected or excluded.
+			}
+			tr.View, columns = v, v
+			v.Triggers = append(v.Triggers, tr)
+		default:
+			t, ok := s.Table(tName.String)
+			if !ok {
+				continue // Partitions or tables that were not inspected.
+			}
+			tr.Table, columns = t, t
+			t.Triggers = append(t.Triggers, tr)
+		}
+		switch {
+		case typ&triggerTypeBefore != 0:
+			tr.ActionTime = schema.TriggerTimeBefore
+		case typ&triggerTypeInstead != 0:
+			tr.ActionTime = schema.TriggerTimeInstead
+		}
+		if typ&triggerTypeRow != 0 {
+			tr.For = schema.TriggerForRow
+		}
+		if typ&triggerTypeInsert != 0 {
+			tr.Events = append(tr.Events, schema.TriggerEventInsert)
+		}
+		if typ&triggerTypeDelete != 0 {
+			tr.Events = append(tr.Events, schema.TriggerEventDelete)
+		}
+		if typ&triggerTypeUpdate != 0 {
+			e := schema.TriggerEventUpdate
+			if sqlx.ValidString(updateOf) {
+				var (
+					names []string
+					cols  []*schema.Column
+				)
+				if err := json.Unmarshal([]byte(updateOf.String), &names); err != nil {
+					return fmt.Errorf("postgres: parsing columns of trigger %q: %w", name.String, err)
+				}
+				for _, n := range names {
+					c, ok := columns.Column(n)
+					if !ok {
+						return fmt.Errorf("postgres: column %q of trigger %q was not found", n, name.String)
+					}
+					cols = append(cols, c)
+				}
+				e = schema.TriggerEventUpdateOf(cols...)
+			}
+			tr.Events = append(tr.Events, e)
+		}
+		if typ&triggerTypeTruncate != 0 {
+			tr.Events = append(tr.Events, schema.TriggerEventTruncate)
+		}
+		if sqlx.ValidString(when) {
+			tr.Attrs = append(tr.Attrs, &TriggerWhen{X: when.String})
+		}
+		exec := &TriggerFunc{Args: triggerArgs(fArgs.String)}
+		if fs, ok := r.Schema(fSchema.String); ok {
+			exec.F, _ = fs.Func(fName.String)
+		}
+		if exec.F != nil {
+			tr.Deps = append(tr.Deps, exec.F)
+		} else {
+			exec.F = &schema.Func{Name: fName.String, Schema: schema.New(fSchema.String)}
+		}
+		tr.Attrs = append(tr.Attrs, exec)
+		if sqlx.ValidString(comment) {
+			tr.Attrs = append(tr.Attrs, &schema.Comment{Text: comment.String})
+		}
+	}
+	return rows.Close()
+}
+
+// inspectObjects queries and appends the schema-level objects, such as sequences and aggregates.
+func (i *inspect) inspectObjects(ctx context.Context, r *schema.Realm, _ *schema.InspectOptions) error {
+	if err := i.inspectSequences(ctx, r); err != nil {
+		return err
+	}
+	return i.inspectAggregates(ctx, r)
+}
+
+// inspectAggregates queries and appends the user-defined aggregates of the schemas. Support
+// functions that were not inspected (e.g., builtins) are kept as references by their names.
+func (i *inspect) inspectAggregates(ctx context.Context, r *schema.Realm) error {
+	// User-defined aggregates catalog is not compatible in CockroachDB.
+	if len(r.Schemas) == 0 || i.crdb {
+		return nil
+	}
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(aggregatesQuery, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying aggregates: %w", err)
+	}
+	defer rows.Close()
+	var (
+		oids  []any
+		byOID = make(map[int64]schema.Object)
+	)
+	for rows.Next() {
+		var (
+			oid                                     int64
+			ns, name, sSchema, sName, stype         sql.NullString
+			fSchema, fName, init, parallel, comment sql.NullString
+		)
+		if err := rows.Scan(&oid, &ns, &name, &sSchema, &sName, &stype, &fSchema, &fName, &init, &parallel, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning aggregate information: %w", err)
+		}
+		s, ok := r.Schema(ns.String)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for aggregate %q was not found in realm", ns.String, name.String)
+		}
+		a := &Aggregate{
+			Name:      name.String,
+			Schema:    s,
+			StateFunc: realmFunc(r, sSchema.String, sName.String),
+			InitCond:  init.String,
+			Attrs:     []schema.Attr{&OID{V: oid}},
+		}
+		if a.StateType, err = i.parseType(s, stype.String); err != nil {
+			return fmt.Errorf("postgres: parsing state type %q of aggregate %q: %w", stype.String, name.String, err)
+		}
+		if sqlx.ValidString(fName) {
+			a.FinalFunc = realmFunc(r, fSchema.String, fName.String)
+		}
+		switch parallel.String {
+		case "s":
+			a.Parallel = AggregateParallelSafe
+		case "r":
+			a.Parallel = AggregateParallelRestricted
+		}
+		if sqlx.ValidString(comment) {
+			a.Attrs = append(a.Attrs, &schema.Comment{Text: comment.String})
+		}
+		s.AddObjects(a)
+		oids = append(oids, oid)
+		byOID[oid] = a
+	}
+	if err := rows.Close(); err != nil || len(oids) == 0 {
+		return err
+	}
+	return i.funcArgs(ctx, oids, byOID)
+}
+
+// realmFunc returns the function with the given name from the realm, or a
+// reference to it in case it was not inspected (e.g., builtin functions).
+func realmFunc(r *schema.Realm, ns, name string) *schema.Func {
+	if s, ok := r.Schema(ns); ok {
+		if f, ok := s.Func(name); ok {
+			return f
+		}
+	}
+	return &schema.Func{Name: name, Schema: schema.New(ns)}
+}
+
+// inspectSequences queries and appends the standalone sequences of the schemas.
+// Sequences that were created for IDENTITY or serial columns are skipped, as
+// they are managed by their columns.
+func (i *inspect) inspectSequences(ctx context.Context, r *schema.Realm) error {
+	if len(r.Schemas) == 0 || i.crdb {
+		return nil
+	}
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(sequencesQuery, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying sequences: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			cycle                                  bool
+			start, incr, minV, maxV, cache         int64
+			last                                   sql.NullInt64
+			ns, name, typ, ownerT, ownerC, comment sql.NullString
+		)
+		if err := rows.Scan(&ns, &name, &typ, &start, &incr, &minV, &maxV, &cache, &cycle, &last, &ownerT, &ownerC, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning sequence information: %w", err)
+		}
+		s, ok := r.Schema(ns.String)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for sequence %q was not found in realm", ns.String, name.String)
+		}
+		t, err := ParseType(typ.String)
+		if err != nil {
+			return fmt.Errorf("postgres: parsing type of sequence %q: %w", name.String, err)
+		}
+		seq := &Sequence{
+			Name:      name.String,
+			Schema:    s,
+			Type:      t,
+			Start:     start,
+			Increment: incr,
+			Cache:     cache,
+			Cycle:     cycle,
+			Last:      last.Int64,
+		}
+		if dmin, dmax := seqLimits(seq); minV != dmin || maxV != dmax {
+			seq.Min, seq.Max = &minV, &maxV
+		}
+		if sqlx.ValidString(ownerT) {
+			t, ok := s.Table(ownerT.String)
+			if !ok {
+				continue // Owned by a table that was not inspected.
+			}
+			c, ok := t.Column(ownerC.String)
+			if !ok {
+				return fmt.Errorf("postgres: owner column %q of sequence %q was not found", ownerC.String, name.String)
+			}
+			// Sequences created by serial columns.
+			if st, ok := c.Type.Type.(*SerialType); ok && st.sequence(t, c) == seq.Name {
+				continue
+			}
+			seq.Owner.T, seq.Owner.C = t, c
+		}
+		if sqlx.ValidString(comment) {
+			seq.Attrs = append(seq.Attrs, &schema.Comment{Text: comment.String})
+		}
+		s.AddObjects(seq)
+	}
+	return rows.Close()
+}
+
+// inspectRealmObjects queries and appends the realm-level objects, such as extensions and event triggers.
+func (i *inspect) inspectRealmObjects(ctx context.Context, r *schema.Realm, _ *schema.InspectOptions) error {
+	// Extensions and event triggers are not supported by CockroachDB.
+	if i.crdb {
+		return nil
+	}
+	if err := i.inspectExtensions(ctx, r); err != nil {
+		return err
+	}
+	rows, err := i.QueryContext(ctx, eventTriggersQuery)
+	if err != nil {
+		return fmt.Errorf("postgres: querying event triggers: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			oid                                        int64
+			name, event, tags, fSchema, fName, comment sql.NullString
+		)
+		if err := rows.Scan(&oid, &name, &event, &tags, &fSchema, &fName, &comment); err != nil {
+			return fmt.Errorf("postgres: scanning event trigger information: %w", err)
+		}
+		e := &EventTrigger{Name: name.String, Event: event.String, Attrs: []schema.Attr{&OID{V: oid}}}
+		if sqlx.ValidString(tags) {
+			if err := json.Unmarshal([]byte(tags.String), &e.Tags); err != nil {
+				return fmt.Errorf("postgres: parsing tags of event trigger %q: %w", name.String, err)
+			}
+		}
+		e.F = realmFunc(r, fSchema.String, fName.String)
+		if sqlx.ValidString(comment) {
+			e.Attrs = append(e.Attrs, &schema.Comment{Text: comment.String})
+		}
+		r.AddObjects(e)
+	}
+	return rows.Close()
+}
+
+// inspectDeps queries the dependencies between the inspected tables, views, functions and
+// procedures, and attaches them to the dependent objects. Views depend on the relations and
+// functions they reference, tables depend on the functions used by their defaults and checks,
+// and functions with SQL-standard bodies depend on the objects they reference.
+func (i *inspect) inspectDeps(ctx context.Context, r *schema.Realm, _ *schema.InspectOptions) error {
+	if i.crdb {
+		return nil
+	}
+	var (
+		args  []any
+		deps  bool
+		byOID = make(map[depKey]schema.Object)
+	)
+	add := func(o schema.Object, attrs []schema.Attr, routine bool) {
+		if id := (OID{}); sqlx.Has(attrs, &id) {
+			args = append(args, id.V)
+			byOID[depKey{oid: id.V, routine: routine}] = o
+		}
+	}
+	for _, s := range r.Schemas {
+		// Dependencies are attached only to views and routines,
+		// or to tables that might depend on routines.
+		deps = deps || len(s.Views) > 0 || len(s.Funcs) > 0 || len(s.Procs) > 0
+		for _, t := range s.Tables {
+			add(t, t.Attrs, false)
+		}
+		for _, v := range s.Views {
+			add(v, v.Attrs, false)
+		}
+		for _, f := range s.Funcs {
+			add(f, f.Attrs, true)
+		}
+		for _, p := range s.Procs {
+			add(p, p.Attrs, true)
+		}
+	}
+	if !deps || len(args) == 0 {
+		return nil
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(depsQuery, nArgs(0, len(args))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying dependencies: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var k1, k2 depKey
+		if err := rows.Scan(&k1.oid, &k1.routine, &k2.oid, &k2.routine); err != nil {
+			return fmt.Errorf("postgres: scanning dependency: %w", err)
+		}
+		o, ok1 := byOID[k1]
+		dep, ok2 := byOID[k2]
+		// Skip objects that were not inspected, such as
+		// builtin functions or tables in other schemas.
+		if !ok1 || !ok2 {
+			continue
+		}
+		switch o := o.(type) {
+		case *schema.Table:
+			o.AddDeps(dep)
+		case *schema.View:
+			o.AddDeps(dep)
+		case *schema.Func:
+			o.AddDeps(dep)
+		case *schema.Proc:
+			o.AddDeps(dep)
+		}
+	}
+	return rows.Close()
+}
+
+// depKey identifies a relation or a routine by its OID. Relations and routines
+// are stored in different catalogs, and therefore are identified separately.
+type depKey struct {
+	oid     int64
+	routine bool
+}
+
+// inspectExtensions queries and appends the extensions that are installed in the
+// inspected schemas. Extensions that are installed in system schemas (e.g., plpgsql)
+// are ignored, as they cannot be managed by the user.
+func (i *inspect) inspectExtensions(ctx context.Context, r *schema.Realm) error {
+	if len(r.Schemas) == 0 {
+		return nil
+	}
+	args := make([]any, 0, len(r.Schemas))
+	for _, s := range r.Schemas {
+		args = append(args, s.Name)
+	}
+	rows, err := i.QueryContext(ctx, fmt.Sprintf(extensionsQuery, nArgs(0, len(r.Schemas))), args...)
+	if err != nil {
+		return fmt.Errorf("postgres: querying extensions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			oid               int64
+			name, ns, version sql.NullString
+		)
+		if err := rows.Scan(&oid, &name, &ns, &version); err != nil {
+			return fmt.Errorf("postgres: scanning extension information: %w", err)
+		}
+		s, ok := r.Schema(ns.String)
+		if !ok {
+			return fmt.Errorf("postgres: schema %q for extension %q was not found in realm", ns.String, name.String)
+		}
+		r.AddObjects(&Extension{Name: name.String, Schema: s, Version: version.String, Attrs: []schema.Attr{&OID{V: oid}}})
+	}
+	return rows.Close()
+}
+
+// Trigger types as defined in pg_trigger.tgtype.
+const (
+	triggerTypeRow      = 1 << 0
+	triggerTypeBefore   = 1 << 1
+	triggerTypeInsert   = 1 << 2
+	triggerTypeDelete   = 1 << 3
+	triggerTypeUpdate   = 1 << 4
+	triggerTypeTruncate = 1 << 5
+	triggerTypeInstead  = 1 << 6
+)
+
+// triggerArgs decodes the trigger arguments as returned by encode(tgargs, 'escape').
+// Arguments are null-terminated, and backslashes are escaped by doubling them.
+func triggerArgs(s string) []string {
+	if s == "" {
+		return nil
+	}
+	args := strings.Split(strings.TrimSuffix(s, `\000`), `\000`)
+	for i := range args {
+		args[i] = strings.ReplaceAll(args[i], `\\`, `\`)
+	}
+	return args
+}
+
+// schemas returns the list of the schemas in the database.
+func (i *inspect) schemas(ctx context.Context, opts *schema.InspectRealmOption) ([]*schema.Schema, error) {
+	var (
+		args  []any
+		query = schemasQuery
+	)
+	if opts != nil {
+		switch n := len(opts.Schemas); {
+		case n == 1 && opts.Schemas[0] == "":
+			query = fmt.Sprintf(schemasQueryArgs, "= CURRENT_SCHEMA()")
+		case n == 1 && opts.Schemas[0] != "":
+			query = fmt.Sprintf(schemasQueryArgs, "= $1")
+			args = append(args, opts.Schemas[0])
+		case n > 0:
+			query = fmt.Sprintf(schemasQueryArgs, "IN ("+nArgs(0, len(opts.Schemas))+")")
+			for _, s := range opts.Schemas {
+				args = append(args, s)
+			}
+		}
+	}
+	rows, err := i.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres: querying schemas: %w", err)
+	}
+	defer rows.Close()
+	var schemas []*schema.Schema
+	for rows.Next() {
+		var (
+			name    string
+			comment sql.NullString
+		)
+		if err := rows.Scan(&name, &comment); err != nil {
+			return nil, err
+		}
+		s := schema.New(name)
+		if comment.Valid {
+			s.SetComment(comment.String)
+		}
+		schemas = append(schemas, s)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	return schemas, nil
+}
+
+func (i *inspect) querySchema(ctx context.Context, query string, s *schema.Schema) (*sql.Rows, error) {
+	args := []any{s.Name}
+	for _, t := range s.Tables {
+		args = append(args, t.Name)
+	}
+	return i.QueryContext(ctx, fmt.Sprintf(query, nArgs(1, len(s.Tables))), args...)
+}
+
+func nArgs(start, n int) string {
+	var b strings.Builder
+	for i := 1; i <= n; i++ {
+		if i > 1 {
+			b.WriteString(", ")
+		}
+		b.WriteByte('$')
+		b.WriteString(strconv.Itoa(start + i))
+	}
+	return b.String()
+}
+
+// A regexp to extracts the sequence name from a "nextval" expression.
+// nextval('<optional (quoted) schema>.<sequence name>'::regclass).
+var reNextval = regexp.MustCompile(`(?i) *nextval\('(?:"?[\w$]+"?\.)?"?([\w$]+_[\w$]+_seq)"?'(?:::regclass)*\) *$`)
+
+// A regexp to extract the (optionally qualified) sequence name from a "nextval" call.
+var reNextvalSeq = regexp.MustCompile(`(?i)^\s*nextval\(\s*'(?:("[^"]+"|[^."']+)\.)?("[^"]+"|[^."']+)'(?:::regclass)*\s*\)\s*$`)
+
+// nextvalSeq returns the schema and the name of the sequence used by the given
+// nextval expression. The schema is empty in case the sequence is not qualified.
+func nextvalSeq(x string) (ns, name string, ok bool) {
+	m := reNextvalSeq.FindStringSubmatch(x)
+	if len(m) != 3 {
+		return "", "", false
+	}
+	unquote := func(s string) string {
+		if strings.HasPrefix(s, `"`) {
+			return strings.Trim(s, `"`)
+		}
+		return strings.ToLower(s)
+	}
+	return unquote(m[1]), unquote(m[2]), true
+}
+
+// seqLimits returns the default minimum and maximum values of the sequence,
+// based on its type and its direction (ascending or descending).
+func seqLimits(s *Sequence) (int64, int64) {
+	maxV := int64(math.MaxInt64)
+	if t, ok := s.Type.(*schema.IntegerType); ok {
+		switch strings.ToLower(t.T) {
+		case TypeSmallInt, TypeInt2:
+			maxV = math.MaxInt16
+		case TypeInteger, TypeInt4, TypeInt:
+			maxV = math.MaxInt32
+		}
+	}
+	if s.Increment < 0 {
+		return -maxV - 1, -1
+	}
+	return 1, maxV
+}
+
+func columnDefault(c *schema.Column, s string) {
+	switch m := reNextval.FindStringSubmatch(s); {
+	// The definition of "<column> <serial type>" is equivalent to specifying:
+	// "<column> <int type> NOT NULL DEFAULT nextval('<table>_<column>_seq')".
+	// https://postgresql.org/docs/current/datatype-numeric.html#DATATYPE-SERIAL.
+	case len(m) == 2:
+		tt, ok := c.Type.Type.(*schema.IntegerType)
+		if !ok {
+			return
+		}
+		st := &SerialType{SequenceName: m[1]}
+		st.SetType(tt)
+		c.Type.Raw = st.T
+		c.Type.Type = st
+	default:
+		c.Default = defaultExpr(c.Type.Type, s)
+	}
+}
+
+func defaultExpr(t schema.Type, s string) schema.Expr {
+	switch {
+	case sqlx.IsLiteralBool(s), sqlx.IsLiteralNumber(s), sqlx.IsQuoted(s, '\''):
+		return &schema.Literal{V: s}
+	default:
+		var x schema.Expr = &schema.RawExpr{X: s}
+		// Try casting or fallback to raw expressions (e.g. column text[] has the default of '{}':text[]).
+		if v, ok := canConvert(t, s); ok {
+			x = &schema.Literal{V: v}
+		}
+		return x
+	}
+}
+
+func canConvert(t schema.Type, x string) (string, bool) {
+	i := strings.LastIndex(x, "::")
+	if i == -1 || !sqlx.IsQuoted(x[:i], '\'') {
+		return "", false
+	}
+	q := x[0:i]
+	x = x[1 : i-1]
+	switch t.(type) {
+	case *schema.EnumType:
+		return q, true
 	case *schema.BoolType:
 		if sqlx.IsLiteralBool(x) {
 			return x, true
@@ -970,7 +2007,7 @@ type (
 		Schema *schema.Schema   // Optional schema.
 		Fields []*schema.Column // Type fields, also known as attributes/columns.
 		Attrs  []schema.Attr    // Extra attributes, such as OID.
-		Deps   []schema.Object  // Objects this domain depends on.
+		Deps   []schema.Object  // Objects this type depends on.
 	}
 
 	// IntervalType defines an interval type.
@@ -996,11 +2033,18 @@ type (
 		T string
 	}
 
-	// A RangeType defines a range type.
+	// A RangeType defines a range type. Built-in range types (e.g., int4range)
+	// are represented only by their name, while user-defined range types are
+	// also schema objects that hold their schema and subtype.
 	// https://www.postgresql.org/docs/current/rangetypes.html
 	RangeType struct {
 		schema.Type
-		T string
+		schema.Object
+		T       string          // Type name.
+		Schema  *schema.Schema  // Optional schema. Set for user-defined ranges.
+		Subtype schema.Type     // Element type. Set for user-defined ranges.
+		Attrs   []schema.Attr   // Extra attributes, such as OID.
+		Deps    []schema.Object // Objects this range depends on.
 	}
 
 	// A SerialType defines a serial type.
@@ -1166,6 +2210,14 @@ type (
 		schema.Clause
 	}
 
+	// CurrentTable holds the current state of a modified table. The differ attaches it
+	// to ModifyTable changes of tables that views depend on in the current state, which
+	// allows the planner to recreate these views when their columns are altered.
+	CurrentTable struct {
+		schema.Clause
+		T *schema.Table
+	}
+
 	// NotValid describes the NOT VALID clause for the creation
 	// of check and foreign-key constraints.
 	NotValid struct {
@@ -1183,35 +2235,120 @@ type (
 	// on migration.
 	CheckColumns struct {
 		schema.Attr
-		Columns []string
+		Columns []string
+	}
+
+	// Partition defines the spec of a partitioned table.
+	Partition struct {
+		schema.Attr
+		// T defines the type/strategy of the partition.
+		// Can be one of: RANGE, LIST, HASH.
+		T string
+		// Partition parts. The additional attributes
+		// on each part can be used to control collation.
+		Parts []*PartitionPart
+
+		// Internal info returned from pg_partitioned_table.
+		start, attrs, exprs string
+	}
+
+	// An PartitionPart represents an index part that
+	// can be either an expression or a column.
+	PartitionPart struct {
+		X     schema.Expr
+		C     *schema.Column
+		Attrs []schema.Attr
+	}
+
+	// Cascade describes that a CASCADE clause should be added to the DROP [TABLE|SCHEMA]
+	// operation. Note, this clause is automatically added to DROP SCHEMA by the planner.
+	Cascade struct {
+		schema.Clause
+	}
+
+	// FuncVolatility describes the volatility category of a function.
+	// VOLATILE is the default category, and it is omitted on inspection.
+	FuncVolatility struct {
+		schema.Attr
+		V string // IMMUTABLE, STABLE or VOLATILE.
+	}
+
+	// FuncSecurity describes the SECURITY clause of functions and
+	// procedures. INVOKER is the default, and it is omitted on inspection.
+	FuncSecurity struct {
+		schema.Attr
+		V string // INVOKER or DEFINER.
+	}
+
+	// TriggerWhen describes the WHEN condition of a trigger.
+	TriggerWhen struct {
+		schema.Attr
+		X string
+	}
+
+	// TriggerFunc describes the function executed by a trigger and its arguments.
+	// The function is not part of the realm in case it was defined in a schema
+	// that was not inspected, or it is a builtin (e.g., tsvector_update_trigger).
+	TriggerFunc struct {
+		schema.Attr
+		F    *schema.Func
+		Args []string
+	}
+
+	// RowSecurity describes the row-level security configuration of a table.
+	RowSecurity struct {
+		schema.Attr
+		Enabled  bool // ENABLE ROW LEVEL SECURITY.
+		Enforced bool // FORCE ROW LEVEL SECURITY. Policies apply also to the table owner.
 	}
 
-	// Partition defines the spec of a partitioned table.
-	Partition struct {
+	// Policy defines a row-level security policy of a table.
+	// https://www.postgresql.org/docs/current/sql-createpolicy.html
+	Policy struct {
 		schema.Attr
-		// T defines the type/strategy of the partition.
-		// Can be one of: RANGE, LIST, HASH.
-		T string
-		// Partition parts. The additional attributes
-		// on each part can be used to control collation.
-		Parts []*PartitionPart
+		Name  string
+		Table *schema.Table
+		As    string   // PERMISSIVE (default) or RESTRICTIVE.
+		For   string   // ALL (default), SELECT, INSERT, UPDATE or DELETE.
+		To    []string // Roles the policy applies to. Defaults to PUBLIC.
+		Using string   // Optional USING expression.
+		Check string   // Optional WITH CHECK expression.
+	}
 
-		// Internal info returned from pg_partitioned_table.
-		start, attrs, exprs string
+	// Extension defines a database-level extension, installed in a specific schema.
+	// https://www.postgresql.org/docs/current/sql-createextension.html
+	Extension struct {
+		schema.Object
+		Name    string
+		Schema  *schema.Schema // Optional schema the extension objects are installed in.
+		Version string         // Optional version. Empty means the default version.
+		Attrs   []schema.Attr  // Extra attributes, such as OID.
 	}
 
-	// An PartitionPart represents an index part that
-	// can be either an expression or a column.
-	PartitionPart struct {
-		X     schema.Expr
-		C     *schema.Column
-		Attrs []schema.Attr
+	// EventTrigger defines a database-level event trigger.
+	// https://www.postgresql.org/docs/current/event-triggers.html
+	EventTrigger struct {
+		schema.Object
+		Name  string
+		Event string        // ddl_command_start, ddl_command_end, table_rewrite, sql_drop or login.
+		Tags  []string      // Optional filter on command tags (e.g., CREATE TABLE).
+		F     *schema.Func  // Executed function.
+		Attrs []schema.Attr // Extra attributes, such as OID and comment.
 	}
 
-	// Cascade describes that a CASCADE clause should be added to the DROP [TABLE|SCHEMA]
-	// operation. Note, this clause is automatically added to DROP SCHEMA by the planner.
-	Cascade struct {
-		schema.Clause
+	// Aggregate defines a user-defined aggregate function.
+	// https://www.postgresql.org/docs/current/sql-createaggregate.html
+	Aggregate struct {
+		schema.Object
+		Name      string
+		Schema    *schema.Schema
+		Args      []*schema.FuncArg
+		StateFunc *schema.Func  // State transition function (SFUNC).
+		StateType schema.Type   // State value data type (STYPE).
+		FinalFunc *schema.Func  // Optional final function (FINALFUNC).
+		InitCond  string        // Optional initial value of the state (INITCOND).
+		Parallel  string        // SAFE, RESTRICTED or UNSAFE (default).
+		Attrs     []schema.Attr // Extra attributes, such as OID and comment.
 	}
 
 	// ReferenceOption describes the ON DELETE and ON UPDATE options for foreign keys.
@@ -1240,6 +2377,203 @@ func (d *DomainType) Underlying() schema.Type {
 	return d.Type
 }
 
+// DependsOn reports if the domain change depends on the other change.
+// Creating a domain depends on the creation of its base type.
+func (d *DomainType) DependsOn(change, other schema.Change) bool {
+	if _, ok := change.(*schema.AddObject); !ok {
+		return false
+	}
+	o, ok := other.(*schema.AddObject)
+	if !ok {
+		return false
+	}
+	t, ok := o.O.(schema.Type)
+	return ok && d.Type != nil && schema.IsType(d.Type, t)
+}
+
+// DependencyOf reports if the other change depends on the domain change.
+// Dropping the base type of a domain depends on dropping the domain first.
+func (d *DomainType) DependencyOf(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); !ok {
+		return false
+	}
+	o, ok := other.(*schema.DropObject)
+	if !ok {
+		return false
+	}
+	t, ok := o.O.(schema.Type)
+	return ok && d.Type != nil && schema.IsType(d.Type, t)
+}
+
+var _ specutil.RefNamer = (*Sequence)(nil)
+
+// Ref returns a reference to the sequence.
+func (s *Sequence) Ref() *schemahcl.Ref {
+	return specutil.ObjectRef(s.Schema, s)
+}
+
+// SpecType returns the type of the sequence.
+func (s *Sequence) SpecType() string {
+	return "sequence"
+}
+
+// SpecName returns the name of the sequence.
+func (s *Sequence) SpecName() string {
+	return s.Name
+}
+
+// DependsOn reports if the sequence change depends on the other change. Creating
+// a sequence depends on the creation of its owner table, unless the table uses the
+// sequence in its column defaults. In this case, the sequence is created first and
+// its owner is set after the table is created (see detachSeqOwners). Dropping a
+// sequence depends on the removal of the tables that use it.
+func (s *Sequence) DependsOn(change, other schema.Change) bool {
+	switch change.(type) {
+	case *schema.AddObject:
+		switch o := other.(type) {
+		case *schema.AddSchema:
+			return s.Schema != nil && s.Schema.Name == o.S.Name
+		case *schema.AddTable:
+			return s.Owner.T != nil && sqlx.SameTable(s.Owner.T, o.T) && !s.usedBy(o.T, o.T.Columns...)
+		case *schema.ModifyTable:
+			return s.Owner.C != nil && sqlx.SameTable(s.Owner.T, o.T) && !s.usedBy(o.T, o.T.Columns...) &&
+				slices.ContainsFunc(o.Changes, func(c schema.Change) bool {
+					a, ok := c.(*schema.AddColumn)
+					return ok && a.C.Name == s.Owner.C.Name
+				})
+		}
+	case *schema.ModifyObject:
+		// Setting the owner of a sequence depends on the creation or modification of its table.
+		switch o := other.(type) {
+		case *schema.AddTable:
+			return s.Owner.T != nil && sqlx.SameTable(s.Owner.T, o.T)
+		case *schema.ModifyTable:
+			return s.Owner.T != nil && sqlx.SameTable(s.Owner.T, o.T)
+		}
+	case *schema.DropObject:
+		switch o := other.(type) {
+		case *schema.DropTable:
+			return s.usedBy(o.T, o.T.Columns...)
+		case *schema.ModifyTable:
+			return slices.ContainsFunc(o.Changes, func(c schema.Change) bool {
+				switch c := c.(type) {
+				case *schema.DropColumn:
+					return s.usedBy(o.T, c.C)
+				case *schema.ModifyColumn:
+					return s.usedBy(o.T, c.From)
+				}
+				return false
+			})
+		}
+	}
+	return false
+}
+
+// DependencyOf reports if the other change depends on the sequence change.
+// Tables that use the sequence in their column defaults depend on its creation.
+func (s *Sequence) DependencyOf(change, other schema.Change) bool {
+	if _, ok := change.(*schema.AddObject); !ok {
+		return false
+	}
+	switch o := other.(type) {
+	case *schema.AddTable:
+		return s.usedBy(o.T, o.T.Columns...)
+	case *schema.ModifyTable:
+		return slices.ContainsFunc(o.Changes, func(c schema.Change) bool {
+			switch c := c.(type) {
+			case *schema.AddColumn:
+				return s.usedBy(o.T, c.C)
+			case *schema.ModifyColumn:
+				return s.usedBy(o.T, c.To)
+			}
+			return false
+		})
+	}
+	return false
+}
+
+// usedBy reports if one of the table columns uses the sequence in its default value.
+func (s *Sequence) usedBy(t *schema.Table, columns ...*schema.Column) bool {
+	return slices.ContainsFunc(columns, func(c *schema.Column) bool {
+		x, ok := c.Default.(*schema.RawExpr)
+		if !ok {
+			return false
+		}
+		ns, name, ok := nextvalSeq(x.X)
+		if !ok || name != s.Name {
+			return false
+		}
+		// Unqualified sequences are resolved to the schema of the table.
+		if ns == "" && t.Schema != nil {
+			ns = t.Schema.Name
+		}
+		return ns == "" || s.Schema == nil || s.Schema.Name == "" || s.Schema.Name == ns
+	})
+}
+
+// SpecType returns the type of the extension.
+func (e *Extension) SpecType() string {
+	return "extension"
+}
+
+// SpecName returns the name of the extension.
+func (e *Extension) SpecName() string {
+	return e.Name
+}
+
+// DependsOn reports if the extension change depends on the other change.
+// Installing an extension (or moving it) depends on the creation of its schema.
+func (e *Extension) DependsOn(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); ok {
+		return false
+	}
+	if m, ok := change.(*schema.ModifyObject); ok {
+		e = m.To.(*Extension)
+	}
+	add, ok := other.(*schema.AddSchema)
+	return ok && e.Schema != nil && e.Schema.Name == add.S.Name
+}
+
+// DependencyOf reports if the other change depends on the extension change.
+// Dropping the schema of an extension depends on dropping the extension first.
+func (e *Extension) DependencyOf(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); !ok {
+		return false
+	}
+	drop, ok := other.(*schema.DropSchema)
+	return ok && e.Schema != nil && e.Schema.Name == drop.S.Name
+}
+
+// SpecType returns the type of the event trigger.
+func (e *EventTrigger) SpecType() string {
+	return "event_trigger"
+}
+
+// SpecName returns the name of the event trigger.
+func (e *EventTrigger) SpecName() string {
+	return e.Name
+}
+
+// DependsOn reports if the event trigger change depends on the other change.
+// Creating an event trigger depends on the creation of its function.
+func (e *EventTrigger) DependsOn(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); ok {
+		return false
+	}
+	add, ok := other.(*schema.AddFunc)
+	return ok && e.F != nil && e.F.Name == add.F.Name && sqlx.SameSchema(e.F.Schema, add.F.Schema)
+}
+
+// DependencyOf reports if the other change depends on the event trigger change.
+// Dropping the function of an event trigger depends on dropping the trigger first.
+func (e *EventTrigger) DependencyOf(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); !ok {
+		return false
+	}
+	drop, ok := other.(*schema.DropFunc)
+	return ok && e.F != nil && e.F.Name == drop.F.Name && sqlx.SameSchema(e.F.Schema, drop.F.Schema)
+}
+
 // SpecType returns the type of the composite type.
 func (c *CompositeType) SpecType() string {
 	return "composite"
@@ -1250,6 +2584,154 @@ func (c *CompositeType) SpecName() string {
 	return c.T
 }
 
+// Ref returns a reference to the composite type.
+func (c *CompositeType) Ref() *schemahcl.Ref {
+	return specutil.ObjectRef(c.Schema, c)
+}
+
+// DependsOn reports if the composite type change depends on the other change.
+// Creating or altering a composite type depends on the creation of its field types.
+func (c *CompositeType) DependsOn(change, other schema.Change) bool {
+	switch change.(type) {
+	case *schema.AddObject, *schema.ModifyObject:
+	default:
+		return false
+	}
+	o, ok := other.(*schema.AddObject)
+	if !ok {
+		return false
+	}
+	t, ok := o.O.(schema.Type)
+	return ok && slices.ContainsFunc(c.Fields, func(f *schema.Column) bool {
+		return f.Type != nil && schema.IsType(f.Type.Type, t)
+	})
+}
+
+// DependencyOf reports if the other change depends on the composite type change.
+// Dropping the type of a field depends on dropping the composite type first.
+func (c *CompositeType) DependencyOf(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); !ok {
+		return false
+	}
+	o, ok := other.(*schema.DropObject)
+	if !ok {
+		return false
+	}
+	t, ok := o.O.(schema.Type)
+	return ok && slices.ContainsFunc(c.Fields, func(f *schema.Column) bool {
+		return f.Type != nil && schema.IsType(f.Type.Type, t)
+	})
+}
+
+var _ specutil.RefNamer = (*RangeType)(nil)
+
+// Ref returns a reference to the range type.
+func (r *RangeType) Ref() *schemahcl.Ref {
+	return specutil.ObjectRef(r.Schema, r)
+}
+
+// SpecType returns the type of the range type.
+func (r *RangeType) SpecType() string {
+	return "range"
+}
+
+// SpecName returns the name of the range type.
+func (r *RangeType) SpecName() string {
+	return r.T
+}
+
+// DependsOn reports if the range type change depends on the other change.
+// Creating a range type depends on the creation of its subtype.
+func (r *RangeType) DependsOn(change, other schema.Change) bool {
+	if _, ok := change.(*schema.AddObject); !ok {
+		return false
+	}
+	o, ok := other.(*schema.AddObject)
+	if !ok {
+		return false
+	}
+	t, ok := o.O.(schema.Type)
+	return ok && r.Subtype != nil && schema.IsType(r.Subtype, t)
+}
+
+// DependencyOf reports if the other change depends on the range type change.
+// Dropping the subtype of a range depends on dropping the range first.
+func (r *RangeType) DependencyOf(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); !ok {
+		return false
+	}
+	o, ok := other.(*schema.DropObject)
+	if !ok {
+		return false
+	}
+	t, ok := o.O.(schema.Type)
+	return ok && r.Subtype != nil && schema.IsType(r.Subtype, t)
+}
+
+// SpecType returns the type of the aggregate.
+func (a *Aggregate) SpecType() string {
+	return "aggregate"
+}
+
+// SpecName returns the name of the aggregate.
+func (a *Aggregate) SpecName() string {
+	return a.Name
+}
+
+// DependsOn reports if the aggregate change depends on the other change. Creating
+// an aggregate depends on the creation of its support functions and types.
+func (a *Aggregate) DependsOn(change, other schema.Change) bool {
+	switch change.(type) {
+	case *schema.AddObject, *schema.ModifyObject:
+	default:
+		return false
+	}
+	if m, ok := change.(*schema.ModifyObject); ok {
+		a = m.To.(*Aggregate)
+	}
+	switch o := other.(type) {
+	case *schema.AddFunc:
+		return a.usesFunc(o.F)
+	case *schema.AddObject:
+		t, ok := o.O.(schema.Type)
+		return ok && a.usesType(t)
+	}
+	return false
+}
+
+// DependencyOf reports if the other change depends on the aggregate change. Dropping
+// the support functions or types of an aggregate depends on dropping it first.
+func (a *Aggregate) DependencyOf(change, other schema.Change) bool {
+	if _, ok := change.(*schema.DropObject); !ok {
+		return false
+	}
+	switch o := other.(type) {
+	case *schema.DropFunc:
+		return a.usesFunc(o.F)
+	case *schema.DropObject:
+		t, ok := o.O.(schema.Type)
+		return ok && a.usesType(t)
+	}
+	return false
+}
+
+// usesFunc reports if the given function is the state or the final function of the aggregate.
+func (a *Aggregate) usesFunc(f *schema.Func) bool {
+	return slices.ContainsFunc([]*schema.Func{a.StateFunc, a.FinalFunc}, func(af *schema.Func) bool {
+		return af != nil && af.Name == f.Name && sqlx.SameSchema(af.Schema, f.Schema)
+	})
+}
+
+// usesType reports if the given type is the state type or an argument type of the aggregate.
+func (a *Aggregate) usesType(t schema.Type) bool {
+	if a.StateType != nil && schema.IsType(a.StateType, t) {
+		return true
+	}
+	return slices.ContainsFunc(a.Args, func(arg *schema.FuncArg) bool {
+		return arg.Type != nil && schema.IsType(arg.Type, t)
+	})
+}
+
 // Underlying returns the underlying type of the array.
 func (a *ArrayType) Underlying() schema.Type {
 	return a.Type
@@ -1583,8 +3065,10 @@ FROM
 	pg_enum e
 	JOIN pg_type t ON e.enumtypid = t.oid
 	JOIN pg_namespace n ON t.typnamespace = n.oid
+	LEFT JOIN pg_depend AS d ON d.classid = 'pg_catalog.pg_type'::regclass::oid AND d.objid = t.oid AND d.deptype = 'e'
 WHERE
     n.nspname IN (%s)
+    AND d.objid IS NULL
 ORDER BY
     n.nspname, e.enumtypid, e.enumsortorder
 `
@@ -1655,6 +3139,384 @@ WHERE
 ORDER BY
 	t1.conname, array_position(t1.conkey, t2.attnum)
 `
+
+	// Query to list views and materialized views.
+	viewsQuery = `
+SELECT
+	t3.oid,
+	t1.schemaname,
+	t1.viewname,
+	t1.definition,
+	t1.materialized,
+	t4.check_option,
+	pg_catalog.obj_description(t3.oid, 'pg_class') AS comment
+FROM
+	(
+		SELECT schemaname, viewname, definition, false AS materialized FROM pg_catalog.pg_views
+		UNION ALL
+		SELECT schemaname, matviewname, definition, true AS materialized FROM pg_catalog.pg_matviews
+	) AS t1
+	JOIN pg_catalog.pg_namespace AS t2 ON t2.nspname = t1.schemaname
+	JOIN pg_catalog.pg_class AS t3 ON t3.relnamespace = t2.oid AND t3.relname = t1.viewname
+	LEFT JOIN information_schema.views AS t4 ON t4.table_schema = t1.schemaname AND t4.table_name = t1.viewname
+	LEFT JOIN pg_depend AS t5 ON t5.classid = 'pg_catalog.pg_class'::regclass::oid AND t5.objid = t3.oid AND t5.deptype = 'e'
+WHERE
+	t1.schemaname IN (%s)
+	AND t5.objid IS NULL
+ORDER BY
+	t1.schemaname, t1.viewname
+`
+
+	// Query to list views and materialized views by their names.
+	viewsQueryArgs = `
+SELECT
+	t3.oid,
+	t1.schemaname,
+	t1.viewname,
+	t1.definition,
+	t1.materialized,
+	t4.check_option,
+	pg_catalog.obj_description(t3.oid, 'pg_class') AS comment
+FROM
+	(
+		SELECT schemaname, viewname, definition, false AS materialized FROM pg_catalog.pg_views
+		UNION ALL
+		SELECT schemaname, matviewname, definition, true AS materialized FROM pg_catalog.pg_matviews
+	) AS t1
+	JOIN pg_catalog.pg_namespace AS t2 ON t2.nspname = t1.schemaname
+	JOIN pg_catalog.pg_class AS t3 ON t3.relnamespace = t2.oid AND t3.relname = t1.viewname
+	LEFT JOIN information_schema.views AS t4 ON t4.table_schema = t1.schemaname AND t4.table_name = t1.viewname
+	LEFT JOIN pg_depend AS t5 ON t5.classid = 'pg_catalog.pg_class'::regclass::oid AND t5.objid = t3.oid AND t5.deptype = 'e'
+WHERE
+	t1.schemaname IN (%s)
+	AND t1.viewname IN (%s)
+	AND t5.objid IS NULL
+ORDER BY
+	t1.schemaname, t1.viewname
+`
+
+	// Query to list view and materialized view columns.
+	viewColumnsQuery = `
+SELECT
+	t1.relname AS view_name,
+	t3.attname AS column_name,
+	pg_catalog.format_type(t3.atttypid, t3.atttypmod) AS format_type,
+	t3.attnotnull AS not_null,
+	pg_catalog.col_description(t1.oid, t3.attnum) AS comment
+FROM
+	pg_catalog.pg_class AS t1
+	JOIN pg_catalog.pg_namespace AS t2 ON t2.oid = t1.relnamespace
+	JOIN pg_catalog.pg_attribute AS t3 ON t3.attrelid = t1.oid
+WHERE
+	t2.nspname = $1
+	AND t1.relname IN (%s)
+	AND t1.relkind IN ('v', 'm')
+	AND t3.attnum > 0
+	AND NOT t3.attisdropped
+ORDER BY
+	t1.relname, t3.attnum
+`
+
+	// Query to list functions and procedures. The first argument is the function
+	// kind expression, the second is the SQL-standard body expression (PG14+).
+	funcsQuery = `
+SELECT
+	p.oid,
+	n.nspname AS schema_name,
+	p.proname AS func_name,
+	%[1]s AS func_kind,
+	l.lanname AS func_lang,
+	p.prosrc AS func_src,
+	%[2]s AS func_sqlbody,
+	pg_catalog.format_type(p.prorettype, NULL) AS ret_type,
+	p.proretset AS ret_set,
+	p.provolatile AS volatility,
+	p.prosecdef AS security_definer,
+	pg_catalog.obj_description(p.oid, 'pg_proc') AS comment
+FROM
+	pg_catalog.pg_proc AS p
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = p.pronamespace
+	JOIN pg_catalog.pg_language AS l ON l.oid = p.prolang
+	LEFT JOIN pg_depend AS d ON d.classid = 'pg_catalog.pg_proc'::regclass::oid AND d.objid = p.oid AND d.deptype = 'e'
+WHERE
+	n.nspname IN (%[3]s)
+	AND %[1]s IN ('f', 'p')
+	AND d.objid IS NULL
+ORDER BY
+	n.nspname, p.proname, p.oid
+`
+
+	// Query to list the triggers of tables and views. The WHEN condition
+	// is extracted from the trigger definition, as pg_trigger.tgqual
+	// cannot be decompiled using pg_get_expr.
+	triggersQuery = `
+SELECT
+	n.nspname AS table_schema,
+	c.relname AS table_name,
+	c.relkind AS table_kind,
+	t.tgname AS trigger_name,
+	t.tgtype AS trigger_type,
+	(SELECT json_agg(a.attname ORDER BY a.attnum) FROM pg_catalog.pg_attribute AS a WHERE a.attrelid = t.tgrelid AND a.attnum = ANY(t.tgattr)) AS update_of,
+	substring(pg_catalog.pg_get_triggerdef(t.oid) FROM 'WHEN \((.+)\) EXECUTE (?:FUNCTION|PROCEDURE)') AS trigger_when,
+	fn.nspname AS func_schema,
+	p.proname AS func_name,
+	pg_catalog.encode(t.tgargs, 'escape') AS func_args,
+	pg_catalog.obj_description(t.oid, 'pg_trigger') AS comment
+FROM
+	pg_catalog.pg_trigger AS t
+	JOIN pg_catalog.pg_class AS c ON c.oid = t.tgrelid
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = c.relnamespace
+	JOIN pg_catalog.pg_proc AS p ON p.oid = t.tgfoid
+	JOIN pg_catalog.pg_namespace AS fn ON fn.oid = p.pronamespace
+WHERE
+	n.nspname IN (%s)
+	AND NOT t.tgisinternal
+ORDER BY
+	n.nspname, c.relname, t.tgname
+`
+
+	// Query to list the domain types of the schemas, with their check constraints.
+	domainsQuery = `
+SELECT
+	t.oid,
+	n.nspname AS schema_name,
+	t.typname AS domain_name,
+	pg_catalog.format_type(t.typbasetype, t.typtypmod) AS data_type,
+	t.typnotnull AS not_null,
+	t.typdefault AS domain_default,
+	(
+		SELECT json_agg(json_build_object('name', c.conname, 'expr', pg_catalog.pg_get_expr(c.conbin, 0)) ORDER BY c.conname)
+		FROM pg_catalog.pg_constraint AS c
+		WHERE c.contypid = t.oid AND c.contype = 'c'
+	) AS checks,
+	pg_catalog.obj_description(t.oid, 'pg_type') AS comment
+FROM
+	pg_catalog.pg_type AS t
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = t.typnamespace
+	LEFT JOIN pg_depend AS d ON d.classid = 'pg_catalog.pg_type'::regclass::oid AND d.objid = t.oid AND d.deptype = 'e'
+WHERE
+	t.typtype = 'd'
+	AND n.nspname IN (%s)
+	AND d.objid IS NULL
+ORDER BY
+	n.nspname, t.typname
+`
+
+	// Query to list the user-defined range types of the schemas.
+	rangesQuery = `
+SELECT
+	t.oid,
+	n.nspname AS schema_name,
+	t.typname AS range_name,
+	pg_catalog.format_type(r.rngsubtype, NULL) AS subtype,
+	pg_catalog.obj_description(t.oid, 'pg_type') AS comment
+FROM
+	pg_catalog.pg_type AS t
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = t.typnamespace
+	JOIN pg_catalog.pg_range AS r ON r.rngtypid = t.oid
+	LEFT JOIN pg_depend AS d ON d.classid = 'pg_catalog.pg_type'::regclass::oid AND d.objid = t.oid AND d.deptype = 'e'
+WHERE
+	t.typtype = 'r'
+	AND n.nspname IN (%s)
+	AND d.objid IS NULL
+ORDER BY
+	n.nspname, t.typname
+`
+
+	// Query to list the composite types of the schemas, with their fields.
+	// Row types of tables and views are excluded, as their relkind is not 'c'.
+	compositesQuery = `
+SELECT
+	t.oid,
+	n.nspname AS schema_name,
+	t.typname AS composite_name,
+	(
+		SELECT json_agg(
+			json_build_object(
+				'name', a.attname,
+				'type', pg_catalog.format_type(a.atttypid, a.atttypmod),
+				'collation', (SELECT co.collname FROM pg_catalog.pg_collation AS co JOIN pg_catalog.pg_type AS at ON at.oid = a.atttypid WHERE co.oid = a.attcollation AND a.attcollation <> at.typcollation)
+			) ORDER BY a.attnum
+		)
+		FROM pg_catalog.pg_attribute AS a
+		WHERE a.attrelid = t.typrelid AND a.attnum > 0 AND NOT a.attisdropped
+	) AS fields,
+	pg_catalog.obj_description(t.oid, 'pg_type') AS comment
+FROM
+	pg_catalog.pg_type AS t
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = t.typnamespace
+	JOIN pg_catalog.pg_class AS c ON c.oid = t.typrelid
+	LEFT JOIN pg_depend AS d ON d.classid = 'pg_catalog.pg_type'::regclass::oid AND d.objid = t.oid AND d.deptype = 'e'
+WHERE
+	t.typtype = 'c'
+	AND c.relkind = 'c'
+	AND n.nspname IN (%s)
+	AND d.objid IS NULL
+ORDER BY
+	n.nspname, t.typname
+`
+
+	// Query to list the standalone sequences of the schemas. Sequences
+	// that belong to identity columns or extensions are excluded.
+	sequencesQuery = `
+SELECT
+	s.schemaname AS schema_name,
+	s.sequencename AS sequence_name,
+	s.data_type::text AS data_type,
+	s.start_value,
+	s.increment_by,
+	s.min_value,
+	s.max_value,
+	s.cache_size,
+	s.cycle,
+	s.last_value,
+	t.relname AS owner_table,
+	a.attname AS owner_column,
+	pg_catalog.obj_description(c.oid, 'pg_class') AS comment
+FROM
+	pg_catalog.pg_sequences AS s
+	JOIN pg_catalog.pg_namespace AS n ON n.nspname = s.schemaname
+	JOIN pg_catalog.pg_class AS c ON c.relnamespace = n.oid AND c.relname = s.sequencename
+	LEFT JOIN pg_catalog.pg_depend AS d ON d.classid = 'pg_catalog.pg_class'::regclass AND d.objid = c.oid AND d.refclassid = 'pg_catalog.pg_class'::regclass AND d.deptype = 'a'
+	LEFT JOIN pg_catalog.pg_class AS t ON t.oid = d.refobjid
+	LEFT JOIN pg_catalog.pg_attribute AS a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+WHERE
+	s.schemaname IN (%s)
+	AND NOT EXISTS (
+		SELECT 1 FROM pg_catalog.pg_depend AS e
+		WHERE e.classid = 'pg_catalog.pg_class'::regclass AND e.objid = c.oid AND e.deptype IN ('i', 'e')
+	)
+ORDER BY
+	s.schemaname, s.sequencename
+`
+
+	// Query to list the extensions installed in the schemas.
+	extensionsQuery = `
+SELECT
+	e.oid,
+	e.extname AS name,
+	n.nspname AS schema_name,
+	e.extversion AS version
+FROM
+	pg_catalog.pg_extension AS e
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = e.extnamespace
+WHERE
+	n.nspname IN (%s)
+ORDER BY
+	e.extname
+`
+
+	// Query to list the event triggers of the database.
+	eventTriggersQuery = `
+SELECT
+	e.oid,
+	e.evtname AS name,
+	e.evtevent AS event,
+	array_to_json(e.evttags)::text AS tags,
+	n.nspname AS func_schema,
+	p.proname AS func_name,
+	pg_catalog.obj_description(e.oid, 'pg_event_trigger') AS comment
+FROM
+	pg_catalog.pg_event_trigger AS e
+	JOIN pg_catalog.pg_proc AS p ON p.oid = e.evtfoid
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = p.pronamespace
+	LEFT JOIN pg_depend AS d ON d.classid = 'pg_catalog.pg_event_trigger'::regclass::oid AND d.objid = e.oid AND d.deptype = 'e'
+WHERE
+	d.objid IS NULL
+ORDER BY
+	e.evtname
+`
+
+	// Query to list the user-defined (normal) aggregates of the schemas. Ordered-set
+	// and hypothetical-set aggregates, and those owned by extensions are skipped.
+	aggregatesQuery = `
+SELECT
+	p.oid,
+	n.nspname AS schema_name,
+	p.proname AS aggregate_name,
+	sn.nspname AS state_func_schema,
+	sf.proname AS state_func_name,
+	pg_catalog.format_type(a.aggtranstype, NULL) AS state_type,
+	fn.nspname AS final_func_schema,
+	ff.proname AS final_func_name,
+	a.agginitval AS initial_condition,
+	p.proparallel AS parallel,
+	pg_catalog.obj_description(p.oid, 'pg_proc') AS comment
+FROM
+	pg_catalog.pg_aggregate AS a
+	JOIN pg_catalog.pg_proc AS p ON p.oid = a.aggfnoid
+	JOIN pg_catalog.pg_namespace AS n ON n.oid = p.pronamespace
+	JOIN pg_catalog.pg_proc AS sf ON sf.oid = a.aggtransfn
+	JOIN pg_catalog.pg_namespace AS sn ON sn.oid = sf.pronamespace
+	LEFT JOIN pg_catalog.pg_proc AS ff ON ff.oid = a.aggfinalfn
+	LEFT JOIN pg_catalog.pg_namespace AS fn ON fn.oid = ff.pronamespace
+	LEFT JOIN pg_depend AS d ON d.classid = 'pg_catalog.pg_proc'::regclass::oid AND d.objid = p.oid AND d.deptype = 'e'
+WHERE
+	n.nspname IN (%s)
+	AND a.aggkind = 'n'
+	AND d.objid IS NULL
+ORDER BY
+	n.nspname, p.proname, p.oid
+`
+
+	// Function kind expression for versions that do not support pg_proc.prokind.
+	funcsKindBelow11 = "(CASE WHEN p.proisagg THEN 'a' WHEN p.proiswindow THEN 'w' ELSE 'f' END)"
+
+	// SQL-standard function body expression (BEGIN ATOMIC or RETURN).
+	funcsSQLBody = "(CASE WHEN p.prosqlbody IS NOT NULL THEN pg_catalog.pg_get_function_sqlbody(p.oid) END)"
+
+	// Query to list the dependencies between relations (tables and views) and routines
+	// (functions and procedures), as recorded in pg_depend. Views depend on their rewrite
+	// rules, and tables depend on their column defaults and check constraints.
+	depsQuery = `
+SELECT DISTINCT
+	d.objid,
+	d.obj_routine,
+	d.refobjid,
+	d.refclassid = 'pg_catalog.pg_proc'::regclass AS ref_routine
+FROM (
+	SELECT r.ev_class AS objid, false AS obj_routine, d.refobjid, d.refclassid
+	FROM pg_catalog.pg_rewrite AS r
+	JOIN pg_catalog.pg_depend AS d ON d.classid = 'pg_catalog.pg_rewrite'::regclass AND d.objid = r.oid
+	WHERE d.refclassid IN ('pg_catalog.pg_class'::regclass, 'pg_catalog.pg_proc'::regclass) AND d.refobjid <> r.ev_class
+	UNION ALL
+	SELECT a.adrelid, false, d.refobjid, d.refclassid
+	FROM pg_catalog.pg_attrdef AS a
+	JOIN pg_catalog.pg_depend AS d ON d.classid = 'pg_catalog.pg_attrdef'::regclass AND d.objid = a.oid
+	WHERE d.refclassid = 'pg_catalog.pg_proc'::regclass
+	UNION ALL
+	SELECT c.conrelid, false, d.refobjid, d.refclassid
+	FROM pg_catalog.pg_constraint AS c
+	JOIN pg_catalog.pg_depend AS d ON d.classid = 'pg_catalog.pg_constraint'::regclass AND d.objid = c.oid
+	WHERE c.contype = 'c' AND d.refclassid = 'pg_catalog.pg_proc'::regclass
+	UNION ALL
+	SELECT d.objid, true, d.refobjid, d.refclassid
+	FROM pg_catalog.pg_depend AS d
+	WHERE d.classid = 'pg_catalog.pg_proc'::regclass AND d.deptype = 'n' AND d.refclassid IN ('pg_catalog.pg_class'::regclass, 'pg_catalog.pg_proc'::regclass) AND d.objid <> d.refobjid
+) AS d
+WHERE
+	d.objid IN (%s)
+ORDER BY
+	d.objid, d.refobjid
+`
+
+	// Query to list the arguments of functions and procedures,
+	// including the OUT and TABLE arguments.
+	funcArgsQuery = `
+SELECT
+	p.oid,
+	p.proargnames[a.ord] AS arg_name,
+	pg_catalog.format_type(a.typ, NULL) AS arg_type,
+	COALESCE(p.proargmodes[a.ord], 'i') AS arg_mode,
+	pg_catalog.pg_get_function_arg_default(p.oid, a.ord::int) AS arg_default
+FROM
+	pg_catalog.pg_proc AS p
+	CROSS JOIN LATERAL unnest(COALESCE(p.proallargtypes, p.proargtypes::oid[])) WITH ORDINALITY AS a(typ, ord)
+WHERE
+	p.oid IN (%s)
+ORDER BY
+	p.oid, a.ord
+`
 )
 
 var (
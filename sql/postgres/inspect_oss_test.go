@@ -8,6 +8,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"testing"
 
@@ -24,6 +25,10 @@ import (
 var (
 	queryFKs         = sqltest.Escape(fmt.Sprintf(fksQuery, "$2"))
 	queryEnums       = sqltest.Escape(fmt.Sprintf(enumsQuery, "$1"))
+	queryDomains     = sqltest.Escape(fmt.Sprintf(domainsQuery, "$1"))
+	queryRanges      = sqltest.Escape(fmt.Sprintf(rangesQuery, "$1"))
+	queryComposites  = sqltest.Escape(fmt.Sprintf(compositesQuery, "$1"))
+	queryAggregates  = sqltest.Escape(fmt.Sprintf(aggregatesQuery, "$1"))
 	queryTables      = sqltest.Escape(fmt.Sprintf(tablesQuery, "$1"))
 	queryChecks      = sqltest.Escape(fmt.Sprintf(checksQuery, "$2"))
 	queryColumns     = sqltest.Escape(fmt.Sprintf(columnsQuery, "$2"))
@@ -50,6 +55,7 @@ func TestDriver_InspectTable(t *testing.T) {
  public      |   16774 |  state  | off
  public      |   16775 |  status | unknown
 `))
+				m.noTypes()
 				m.tableExists("public", "users", true)
 				m.ExpectQuery(queryColumns).
 					WithArgs("public", "users").
@@ -166,6 +172,7 @@ func TestDriver_InspectTable(t *testing.T) {
 			name: "table indexes",
 			before: func(m mock) {
 				m.noEnums()
+				m.noTypes()
 				m.tableExists("public", "users", true)
 				m.ExpectQuery(queryColumns).
 					WithArgs("public", "users").
@@ -241,6 +248,7 @@ users           | tsx             | gist        | ts          | f        | f
 			name: "fks",
 			before: func(m mock) {
 				m.noEnums()
+				m.noTypes()
 				m.tableExists("public", "users", true)
 				m.ExpectQuery(queryColumns).
 					WithArgs("public", "users").
@@ -289,6 +297,7 @@ self_reference  | users      | uid         | public       | users
 			name: "check",
 			before: func(m mock) {
 				m.noEnums()
+				m.noTypes()
 				m.tableExists("public", "users", true)
 				m.ExpectQuery(queryColumns).
 					WithArgs("public", "users").
@@ -377,6 +386,7 @@ func TestDriver_InspectPartitionedTable(t *testing.T) {
  public      | nil
 `))
 	mk.noEnums()
+	mk.noTypes()
 	m.ExpectQuery(sqltest.Escape(fmt.Sprintf(tablesQuery, "$1"))).
 		WithArgs("public").
 		WillReturnRows(sqltest.Rows(`
@@ -434,6 +444,59 @@ logs3      | c5         | integer   | integer   | NO          |                |
 	}, key.Parts)
 }
 
+func TestDriver_InspectPolicies(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= CURRENT_SCHEMA()"))).
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	m.ExpectQuery(sqltest.Escape(fmt.Sprintf(tablesQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ oid | table_schema | table_name | comment | partition_attrs | partition_strategy | partition_exprs | extra
+-----+--------------+------------+---------+-----------------+--------------------+-----------------+-------
+ 112 | public       | users      |         |                 |                    |                 | {"row_security": true, "force_row_security": false, "policies": [{"name": "tenant", "as": "PERMISSIVE", "for": "ALL", "to": ["public"], "using": "(tenant_id = 1)", "check": null}, {"name": "admins", "as": "RESTRICTIVE", "for": "UPDATE", "to": ["admin", "auditor"], "using": null, "check": "(tenant_id > 0)"}]}
+ 113 | public       | logs       |         |                 |                    |                 | {"row_security": true, "force_row_security": true, "policies": null}
+ 114 | public       | pets       |         |                 |                    |                 | {"row_security": false, "force_row_security": false, "policies": null}
+`))
+	m.ExpectQuery(sqltest.Escape(fmt.Sprintf(columnsQuery, "$2, $3, $4"))).
+		WithArgs("public", "users", "logs", "pets").
+		WillReturnRows(sqlmock.NewRows([]string{"table_name", "column_name", "data_type", "formatted", "is_nullable", "column_default", "character_maximum_length", "numeric_precision", "datetime_precision", "numeric_scale", "interval_type", "character_set_name", "collation_name", "is_identity", "identity_start", "identity_increment", "identity_last", "identity_generation", "generation_expression", "comment", "typtype", "typelem", "oid", "attnum"}))
+	m.ExpectQuery(sqltest.Escape(fmt.Sprintf(indexesAbove15, "$2, $3, $4"))).
+		WillReturnRows(sqlmock.NewRows([]string{"table_name", "index_name", "column_name", "primary", "unique", "constraint_type", "predicate", "expression", "options", "indnullsnotdistinct"}))
+	m.ExpectQuery(sqltest.Escape(fmt.Sprintf(fksQuery, "$2, $3, $4"))).
+		WillReturnRows(sqlmock.NewRows([]string{"constraint_name", "table_name", "column_name", "referenced_table_name", "referenced_column_name", "referenced_table_schema", "update_rule", "delete_rule"}))
+	m.ExpectQuery(sqltest.Escape(fmt.Sprintf(checksQuery, "$2, $3, $4"))).
+		WillReturnRows(sqlmock.NewRows([]string{"table_name", "constraint_name", "expression", "column_name", "column_indexes"}))
+	s, err := drv.InspectSchema(context.Background(), "", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectTables,
+	})
+	require.NoError(t, err)
+
+	users, ok := s.Table("users")
+	require.True(t, ok)
+	require.Equal(t, []schema.Attr{
+		&OID{V: 112},
+		&RowSecurity{Enabled: true},
+		&Policy{Name: "tenant", Table: users, As: PolicyAsPermissive, For: PolicyForAll, To: []string{"PUBLIC"}, Using: "(tenant_id = 1)"},
+		&Policy{Name: "admins", Table: users, As: PolicyAsRestrictive, For: PolicyForUpdate, To: []string{"admin", "auditor"}, Check: "(tenant_id > 0)"},
+	}, users.Attrs)
+	logs, ok := s.Table("logs")
+	require.True(t, ok)
+	require.Equal(t, []schema.Attr{&OID{V: 113}, &RowSecurity{Enabled: true, Enforced: true}}, logs.Attrs)
+	pets, ok := s.Table("pets")
+	require.True(t, ok)
+	require.Equal(t, []schema.Attr{&OID{V: 114}}, pets.Attrs)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
 func TestDriver_InspectCRDBSchema(t *testing.T) {
 	db, m, err := sqlmock.New()
 	require.NoError(t, err)
@@ -537,6 +600,599 @@ func TestDriver_InspectSchema(t *testing.T) {
 	}(), s)
 }
 
+func TestDriver_InspectViews(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= $1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewsQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schemaname | viewname |           definition            | materialized | check_option |  comment
+-------+------------+----------+---------------------------------+--------------+--------------+-----------
+ 16390 | public     | m1       |  SELECT users.id FROM users;    | t            | nil          | nil
+ 16385 | public     | v1       |  SELECT users.id FROM users;    | f            | LOCAL        | users view
+ 16388 | public     | v2       |  SELECT 1 AS one;               | f            | NONE         | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewColumnsQuery, "$2, $3, $4"))).
+		WithArgs("public", "m1", "v1", "v2").
+		WillReturnRows(sqltest.Rows(`
+ view_name | column_name | format_type | not_null | comment
+-----------+-------------+-------------+----------+---------
+ m1        | id          | integer     | f        | nil
+ v1        | id          | integer     | f        | user id
+ v2        | one         | integer     | f        | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(indexesAbove15, "$2"))).
+		WithArgs("public", "m1").
+		WillReturnRows(sqltest.Rows(`
+ table_name | index_name | index_type | column_name | included | primary | unique | opexpr | constraints | predicate | expression | desc | nulls_first | nulls_last | comment | options | opclass_name | opclass_schema | opclass_default | opclass_params | indnullsnotdistinct
+------------+------------+------------+-------------+----------+---------+--------+--------+-------------+-----------+------------+------+-------------+------------+---------+---------+--------------+----------------+-----------------+----------------+---------------------
+ m1         | m1_id      | btree      | id          | f        | f       | t      |        |             |           | id         | f    | f           | f          |         |         | int4_ops     | pg_catalog     | t               |                | f
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(depsQuery, "$1, $2, $3"))).
+		WithArgs(16390, 16385, 16388).
+		WillReturnRows(sqltest.Rows(`
+ objid | obj_routine | refobjid | ref_routine
+-------+-------------+----------+-------------
+ 16390 | f           | 16385    | f
+ 16390 | f           | 16380    | f
+`))
+	s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectViews,
+	})
+	require.NoError(t, err)
+	require.Len(t, s.Views, 3)
+
+	m1, ok := s.Materialized("m1")
+	require.True(t, ok)
+	require.Equal(t, "SELECT users.id FROM users", m1.Def)
+	require.Len(t, m1.Columns, 1)
+	require.Equal(t, "id", m1.Columns[0].Name)
+	require.Equal(t, &schema.ColumnType{Type: &schema.IntegerType{T: "integer"}, Raw: "integer", Null: true}, m1.Columns[0].Type)
+	require.Len(t, m1.Indexes, 1)
+	require.Equal(t, "m1_id", m1.Indexes[0].Name)
+	require.True(t, m1.Indexes[0].Unique)
+	require.Equal(t, m1, m1.Indexes[0].View)
+	require.Equal(t, m1.Columns[0], m1.Indexes[0].Parts[0].C)
+
+	v1, ok := s.View("v1")
+	require.True(t, ok)
+	// Dependencies on objects that were not inspected are skipped.
+	require.Equal(t, []schema.Object{v1}, m1.Deps)
+	require.Equal(t, []schema.Attr{&OID{V: 16385}, &schema.ViewCheckOption{V: schema.ViewCheckOptionLocal}, &schema.Comment{Text: "users view"}}, v1.Attrs)
+	require.Equal(t, []schema.Attr{&schema.Comment{Text: "user id"}}, v1.Columns[0].Attrs)
+	require.Empty(t, v1.Indexes)
+
+	v2, ok := s.View("v2")
+	require.True(t, ok)
+	require.Equal(t, "SELECT 1 AS one", v2.Def)
+	require.Equal(t, []schema.Attr{&OID{V: 16388}}, v2.Attrs)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectFuncs(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= $1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(funcsQuery, "p.prokind", funcsSQLBody, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schema_name | func_name | func_kind | func_lang |     func_src       |     func_sqlbody     | ret_type | ret_set | volatility | security_definer | comment
+-------+-------------+-----------+-----------+-----------+--------------------+----------------------+----------+---------+------------+------------------+---------
+ 16400 | public      | add       | f         | sql       |                    | RETURN (a + b)       | integer  | f       | i          | f                | sum
+ 16401 | public      | ids       | f         | plpgsql   | BEGIN END;         | nil                  | integer  | t       | s          | t                | nil
+ 16402 | public      | agg       | a         | internal  | agg                | nil                  | integer  | f       | i          | f                | nil
+ 16403 | public      | reset     | p         | plpgsql   | BEGIN END;         | nil                  | nil      | f       | v          | f                | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(funcArgsQuery, "$1, $2, $3"))).
+		WithArgs(16400, 16401, 16403).
+		WillReturnRows(sqltest.Rows(`
+  oid  | arg_name | arg_type | arg_mode | arg_default
+-------+----------+----------+----------+-------------
+ 16400 | a        | integer  | i        | nil
+ 16400 | b        | integer  | i        | 1
+ 16401 | id       | integer  | t        | nil
+ 16403 | force    | boolean  | b        | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(depsQuery, "$1, $2, $3"))).
+		WithArgs(16400, 16401, 16403).
+		WillReturnRows(sqltest.Rows(`
+ objid | obj_routine | refobjid | ref_routine
+-------+-------------+----------+-------------
+ 16401 | t           | 16400    | t
+ 16403 | t           | 16401    | t
+ 16403 | t           | 16380    | f
+`))
+	s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectFuncs,
+	})
+	require.NoError(t, err)
+	require.Len(t, s.Funcs, 2)
+	require.Len(t, s.Procs, 1)
+
+	add, ids := s.Funcs[0], s.Funcs[1]
+	require.Equal(t, "add", add.Name)
+	require.Equal(t, "sql", add.Lang)
+	require.Equal(t, "RETURN (a + b)", add.Body)
+	require.Equal(t, &schema.IntegerType{T: "integer"}, add.Ret)
+	require.Equal(t, []*schema.FuncArg{
+		{Name: "a", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeIn},
+		{Name: "b", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeIn, Default: &schema.RawExpr{X: "1"}},
+	}, add.Args)
+	require.Equal(t, []schema.Attr{&OID{V: 16400}, &FuncVolatility{V: FuncVolatilityImmutable}, &schema.Comment{Text: "sum"}}, add.Attrs)
+
+	require.Equal(t, "ids", ids.Name)
+	require.Equal(t, "BEGIN END;", ids.Body)
+	require.Equal(t, &UserDefinedType{T: "SETOF integer"}, ids.Ret)
+	require.Equal(t, []*schema.FuncArg{{Name: "id", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeOut}}, ids.Args)
+	require.Equal(t, []schema.Attr{&OID{V: 16401}, &FuncVolatility{V: FuncVolatilityStable}, &FuncSecurity{V: FuncSecurityDefiner}}, ids.Attrs)
+	require.Equal(t, []schema.Object{add}, ids.Deps)
+
+	reset := s.Procs[0]
+	require.Equal(t, "reset", reset.Name)
+	require.Equal(t, "plpgsql", reset.Lang)
+	require.Equal(t, []*schema.FuncArg{{Name: "force", Type: &schema.BoolType{T: "boolean"}, Mode: schema.FuncArgModeInOut}}, reset.Args)
+	require.Equal(t, []schema.Attr{&OID{V: 16403}}, reset.Attrs)
+	require.Equal(t, []schema.Object{ids}, reset.Deps)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectTriggers(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= $1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewsQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schemaname | viewname |           definition            | materialized | check_option |  comment
+-------+------------+----------+---------------------------------+--------------+--------------+-----------
+ 16385 | public     | v1       |  SELECT users.id FROM users;    | f            | NONE         | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(viewColumnsQuery, "$2"))).
+		WithArgs("public", "v1").
+		WillReturnRows(sqltest.Rows(`
+ view_name | column_name | format_type | not_null | comment
+-----------+-------------+-------------+----------+---------
+ v1        | id          | integer     | f        | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(triggersQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ table_schema | table_name | relkind | trigger_name | trigger_type | update_of | trigger_when         | func_schema | func_name       | func_args    | comment
+--------------+------------+---------+--------------+--------------+-----------+----------------------+-------------+-----------------+--------------+---------
+ public       | users      | r       | users_audit  | 17           | ["id"]    | nil                  | public      | audit           | nil          | nil
+ public       | v1         | v       | v1_insert    | 85           | nil       | nil                  | public      | v1_insert       | nil          | insert
+ public       | v1         | v       | v1_stmt      | 14           | nil       | (current_user <> '') | ext         | log_stmt        | a\000b\\c\000 | nil
+`))
+	mk.noDeps(16385)
+	s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectViews | schema.InspectTriggers,
+	})
+	require.NoError(t, err)
+	v1, ok := s.View("v1")
+	require.True(t, ok)
+	require.Len(t, v1.Triggers, 2)
+	require.Equal(t, &schema.Trigger{
+		Name:       "v1_insert",
+		View:       v1,
+		ActionTime: schema.TriggerTimeInstead,
+		For:        schema.TriggerForRow,
+		Events:     []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventUpdate},
+		Attrs: []schema.Attr{
+			&TriggerFunc{F: &schema.Func{Name: "v1_insert", Schema: schema.New("public")}},
+			&schema.Comment{Text: "insert"},
+		},
+	}, v1.Triggers[0])
+	require.Equal(t, &schema.Trigger{
+		Name:       "v1_stmt",
+		View:       v1,
+		ActionTime: schema.TriggerTimeBefore,
+		For:        schema.TriggerForStmt,
+		Events:     []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventDelete},
+		Attrs: []schema.Attr{
+			&TriggerWhen{X: "(current_user <> '')"},
+			&TriggerFunc{F: &schema.Func{Name: "log_stmt", Schema: schema.New("ext")}, Args: []string{"a", `b\c`}},
+		},
+	}, v1.Triggers[1])
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectEventTriggers(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape("SELECT current_setting('search_path'), set_config('search_path', '', false)")).
+		WillReturnRows(sqltest.Rows(`
+ current_setting | set_config
+-----------------+------------
+       public    |
+`))
+	mk.ExpectQuery(sqltest.Escape(schemasQuery)).
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(sequencesQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqlmock.NewRows([]string{"schema_name", "sequence_name", "data_type", "start_value", "increment_by", "min_value", "max_value", "cache_size", "cycle", "last_value", "owner_table", "owner_column", "comment"}))
+	mk.noAggregates()
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(extensionsQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqlmock.NewRows([]string{"oid", "name", "schema_name", "version"}))
+	mk.ExpectQuery(sqltest.Escape(eventTriggersQuery)).
+		WillReturnRows(sqltest.Rows(`
+  oid  | evtname  | evtevent        | tags                              | func_schema | func_name | comment
+-------+----------+-----------------+-----------------------------------+-------------+-----------+---------
+ 16500 | ddl_log  | ddl_command_end | ["CREATE TABLE", "ALTER TABLE"]   | public      | log_ddl   | log ddl
+ 16501 | no_drops | sql_drop        | nil                               | public      | no_drops  | nil
+`))
+	mk.ExpectQuery(sqltest.Escape("SELECT set_config('search_path', $1, false)")).
+		WithArgs("public").
+		WillReturnRows(sqlmock.NewRows(nil))
+	r, err := drv.InspectRealm(context.Background(), &schema.InspectRealmOption{
+		Mode: schema.InspectSchemas | schema.InspectObjects,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []schema.Object{
+		&EventTrigger{
+			Name:  "ddl_log",
+			Event: "ddl_command_end",
+			Tags:  []string{"CREATE TABLE", "ALTER TABLE"},
+			F:     &schema.Func{Name: "log_ddl", Schema: schema.New("public")},
+			Attrs: []schema.Attr{&OID{V: 16500}, &schema.Comment{Text: "log ddl"}},
+		},
+		&EventTrigger{
+			Name:  "no_drops",
+			Event: "sql_drop",
+			F:     &schema.Func{Name: "no_drops", Schema: schema.New("public")},
+			Attrs: []schema.Attr{&OID{V: 16501}},
+		},
+	}, r.Objects)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectExtensions(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape("SELECT current_setting('search_path'), set_config('search_path', '', false)")).
+		WillReturnRows(sqltest.Rows(`
+ current_setting | set_config
+-----------------+------------
+       public    |
+`))
+	mk.ExpectQuery(sqltest.Escape(schemasQuery)).
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+ extensions  | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(sequencesQuery, "$1, $2"))).
+		WithArgs("public", "extensions").
+		WillReturnRows(sqlmock.NewRows([]string{"schema_name", "sequence_name", "data_type", "start_value", "increment_by", "min_value", "max_value", "cache_size", "cycle", "last_value", "owner_table", "owner_column", "comment"}))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(aggregatesQuery, "$1, $2"))).
+		WithArgs("public", "extensions").
+		WillReturnRows(sqlmock.NewRows([]string{"oid", "schema_name", "aggregate_name", "state_func_schema", "state_func_name", "state_type", "final_func_schema", "final_func_name", "initial_condition", "parallel", "comment"}))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(extensionsQuery, "$1, $2"))).
+		WithArgs("public", "extensions").
+		WillReturnRows(sqltest.Rows(`
+  oid  | name    | schema_name | version
+-------+---------+-------------+---------
+ 16384 | hstore  | public      | 1.8
+ 16390 | postgis | extensions  | 3.4.2
+`))
+	mk.ExpectQuery(sqltest.Escape(eventTriggersQuery)).
+		WillReturnRows(sqlmock.NewRows([]string{"oid", "name", "event", "tags", "func_schema", "func_name", "comment"}))
+	mk.ExpectQuery(sqltest.Escape("SELECT set_config('search_path', $1, false)")).
+		WithArgs("public").
+		WillReturnRows(sqlmock.NewRows(nil))
+	r, err := drv.InspectRealm(context.Background(), &schema.InspectRealmOption{
+		Mode: schema.InspectSchemas | schema.InspectObjects,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []schema.Object{
+		&Extension{Name: "hstore", Schema: r.Schemas[0], Version: "1.8", Attrs: []schema.Attr{&OID{V: 16384}}},
+		&Extension{Name: "postgis", Schema: r.Schemas[1], Version: "3.4.2", Attrs: []schema.Attr{&OID{V: 16390}}},
+	}, r.Objects)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectDomains(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= $1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.noEnums()
+	mk.ExpectQuery(queryDomains).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schema_name | domain_name | data_type              | not_null | domain_default  | checks                                                                        | comment
+-------+-------------+-------------+------------------------+----------+-----------------+-------------------------------------------------------------------------------+---------
+ 16390 | public      | email       | character varying(255) | true     | nil             | [{"name": "email_check", "expr": "((VALUE)::text ~~ '%@%'::text)"}]           | emails
+ 16391 | public      | money_amt   | numeric(12,2)          | false    | 0               | [{"name": "non_negative", "expr": "(VALUE >= (0)::numeric)"}]                 | nil
+ 16392 | public      | work_email  | public.email           | false    | nil             | nil                                                                           | nil
+`))
+	mk.noRanges()
+	mk.noComposites()
+	s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectTypes,
+	})
+	require.NoError(t, err)
+	email := &DomainType{
+		T:      "email",
+		Schema: s,
+		Type:   &schema.StringType{T: TypeCharVar, Size: 255},
+		Checks: []*schema.Check{{Name: "email_check", Expr: "((VALUE)::text ~~ '%@%'::text)"}},
+		Attrs:  []schema.Attr{&OID{V: 16390}, &schema.Comment{Text: "emails"}},
+	}
+	require.Equal(t, []schema.Object{
+		email,
+		&DomainType{
+			T:       "money_amt",
+			Schema:  s,
+			Type:    &schema.DecimalType{T: TypeNumeric, Precision: 12, Scale: 2},
+			Null:    true,
+			Default: &schema.Literal{V: "0"},
+			Checks:  []*schema.Check{{Name: "non_negative", Expr: "(VALUE >= (0)::numeric)"}},
+			Attrs:   []schema.Attr{&OID{V: 16391}},
+		},
+		&DomainType{
+			T:      "work_email",
+			Schema: s,
+			Type:   email,
+			Null:   true,
+			Attrs:  []schema.Attr{&OID{V: 16392}},
+		},
+	}, s.Objects)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectCompositesAndRanges(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= $1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.ExpectQuery(queryEnums).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | enum_id | type    | enum_value
+-------------+---------+---------+------------
+ public      |   16774 |  status | active
+ public      |   16774 |  status | inactive
+`))
+	mk.noDomains()
+	mk.ExpectQuery(queryRanges).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schema_name | range_name | subtype          | comment
+-------+-------------+------------+------------------+--------------
+ 16400 | public      | floatrange | double precision | float ranges
+`))
+	mk.ExpectQuery(queryComposites).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schema_name | composite_name | fields                                                                                                                                                                                   | comment
+-------+-------------+----------------+------------------------------------------------------------------------------------------------------------------------------------------------------------------------------------------+---------
+ 16410 | public      | address        | [{"name": "street", "type": "text", "collation": "C"}, {"name": "status", "type": "status", "collation": null}, {"name": "bounds", "type": "floatrange", "collation": null}] | nil
+`))
+	s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectTypes,
+	})
+	require.NoError(t, err)
+	status := &schema.EnumType{T: "status", Schema: s, Values: []string{"active", "inactive"}}
+	fr := &RangeType{
+		T:       "floatrange",
+		Schema:  s,
+		Subtype: &schema.FloatType{T: TypeDouble},
+		Attrs:   []schema.Attr{&OID{V: 16400}, &schema.Comment{Text: "float ranges"}},
+	}
+	require.Equal(t, []schema.Object{
+		status,
+		fr,
+		&CompositeType{
+			T:      "address",
+			Schema: s,
+			Fields: []*schema.Column{
+				{Name: "street", Type: &schema.ColumnType{Type: &schema.StringType{T: TypeText}, Raw: "text", Null: true}, Attrs: []schema.Attr{&schema.Collation{V: "C"}}},
+				{Name: "status", Type: &schema.ColumnType{Type: status, Raw: "status", Null: true}},
+				{Name: "bounds", Type: &schema.ColumnType{Type: fr, Raw: "floatrange", Null: true}},
+			},
+			Attrs: []schema.Attr{&OID{V: 16410}},
+		},
+	}, s.Objects)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectSequences(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= $1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(sequencesQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | sequence_name | data_type | start_value | increment_by | min_value | max_value           | cache_size | cycle | last_value | owner_table | owner_column | comment
+-------------+---------------+-----------+-------------+--------------+-----------+---------------------+------------+-------+------------+-------------+--------------+---------
+ public      | s1            | bigint    | 1           | 1            | 1         | 9223372036854775807 | 1          | false | nil        | nil         | nil          | nil
+ public      | s2            | integer   | 100         | -2           | -50       | 1000                | 10         | true  | 98         | nil         | nil          | counter
+ public      | s3            | smallint  | 1           | 1            | 1         | 32767               | 1          | false | nil        | users       | id           | nil
+`))
+	mk.noAggregates()
+	s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectObjects,
+	})
+	require.NoError(t, err)
+	minV, maxV := int64(-50), int64(1000)
+	// Sequences owned by tables that were not inspected are skipped.
+	require.Equal(t, []schema.Object{
+		&Sequence{
+			Name:      "s1",
+			Schema:    s,
+			Type:      &schema.IntegerType{T: TypeBigInt},
+			Start:     1,
+			Increment: 1,
+			Cache:     1,
+		},
+		&Sequence{
+			Name:      "s2",
+			Schema:    s,
+			Type:      &schema.IntegerType{T: TypeInteger},
+			Start:     100,
+			Increment: -2,
+			Min:       &minV,
+			Max:       &maxV,
+			Cache:     10,
+			Cycle:     true,
+			Last:      98,
+			Attrs:     []schema.Attr{&schema.Comment{Text: "counter"}},
+		},
+	}, s.Objects)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
+func TestDriver_InspectAggregates(t *testing.T) {
+	db, m, err := sqlmock.New()
+	require.NoError(t, err)
+	mk := mock{m}
+	mk.version("150000")
+	drv, err := Open(db)
+	require.NoError(t, err)
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(schemasQueryArgs, "= $1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+ schema_name | comment
+-------------+---------
+ public      | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(funcsQuery, "p.prokind", funcsSQLBody, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schema_name | func_name    | func_kind | func_lang | func_src | func_sqlbody           | ret_type         | ret_set | volatility | security_definer | comment
+-------+-------------+--------------+-----------+-----------+----------+------------------------+------------------+---------+------------+------------------+---------
+ 16400 | public      | sum_sq_state | f         | sql       |          | RETURN ((s + (v * v))) | double precision | f       | i          | f                | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(funcArgsQuery, "$1"))).
+		WithArgs(16400).
+		WillReturnRows(sqltest.Rows(`
+  oid  | arg_name | arg_type         | arg_mode | arg_default
+-------+----------+------------------+----------+-------------
+ 16400 | s        | double precision | i        | nil
+ 16400 | v        | double precision | i        | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(sequencesQuery, "$1"))).
+		WithArgs("public").
+		WillReturnRows(sqlmock.NewRows([]string{"schema_name", "sequence_name", "data_type", "start_value", "increment_by", "min_value", "max_value", "cache_size", "cycle", "last_value", "owner_table", "owner_column", "comment"}))
+	mk.ExpectQuery(queryAggregates).
+		WithArgs("public").
+		WillReturnRows(sqltest.Rows(`
+  oid  | schema_name | aggregate_name | state_func_schema | state_func_name | state_type       | final_func_schema | final_func_name | initial_condition | parallel | comment
+-------+-------------+----------------+-------------------+-----------------+------------------+-------------------+-----------------+-------------------+----------+-------------
+ 16410 | public      | l2_norm        | public            | sum_sq_state    | double precision | pg_catalog        | sqrt            | 0                 | s        | vector norm
+ 16411 | public      | total          | pg_catalog        | int4pl          | integer          | nil               | nil             | nil               | u        | nil
+`))
+	mk.ExpectQuery(sqltest.Escape(fmt.Sprintf(funcArgsQuery, "$1, $2"))).
+		WithArgs(16410, 16411).
+		WillReturnRows(sqltest.Rows(`
+  oid  | arg_name | arg_type         | arg_mode | arg_default
+-------+----------+------------------+----------+-------------
+ 16410 | nil      | double precision | i        | nil
+ 16411 | nil      | integer          | i        | nil
+`))
+	mk.noDeps(16400)
+	s, err := drv.InspectSchema(context.Background(), "public", &schema.InspectOptions{
+		Mode: schema.InspectSchemas | schema.InspectFuncs | schema.InspectObjects,
+	})
+	require.NoError(t, err)
+	require.Len(t, s.Funcs, 1)
+	require.Equal(t, []schema.Object{
+		&Aggregate{
+			Name:      "l2_norm",
+			Schema:    s,
+			Args:      []*schema.FuncArg{{Type: &schema.FloatType{T: TypeDouble}, Mode: schema.FuncArgModeIn}},
+			StateFunc: s.Funcs[0],
+			StateType: &schema.FloatType{T: TypeDouble},
+			FinalFunc: &schema.Func{Name: "sqrt", Schema: schema.New("pg_catalog")},
+			InitCond:  "0",
+			Parallel:  AggregateParallelSafe,
+			Attrs:     []schema.Attr{&OID{V: 16410}, &schema.Comment{Text: "vector norm"}},
+		},
+		&Aggregate{
+			Name:      "total",
+			Schema:    s,
+			Args:      []*schema.FuncArg{{Type: &schema.IntegerType{T: TypeInteger}, Mode: schema.FuncArgModeIn}},
+			StateFunc: &schema.Func{Name: "int4pl", Schema: schema.New("pg_catalog")},
+			StateType: &schema.IntegerType{T: TypeInteger},
+			Attrs:     []schema.Attr{&OID{V: 16411}},
+		},
+	}, s.Objects)
+	require.NoError(t, m.ExpectationsWereMet())
+}
+
 func TestDriver_Realm(t *testing.T) {
 	db, m, err := sqlmock.New()
 	require.NoError(t, err)
@@ -754,3 +1410,36 @@ func (m mock) noEnums() {
 	m.ExpectQuery(queryEnums).
 		WillReturnRows(sqlmock.NewRows([]string{"schema_name", "enum_name", "comment", "enum_type", "enum_value"}))
 }
+
+func (m mock) noDomains() {
+	m.ExpectQuery(queryDomains).
+		WillReturnRows(sqlmock.NewRows([]string{"oid", "schema_name", "domain_name", "data_type", "not_null", "domain_default", "checks", "comment"}))
+}
+
+func (m mock) noRanges() {
+	m.ExpectQuery(queryRanges).
+		WillReturnRows(sqlmock.NewRows([]string{"oid", "schema_name", "range_name", "subtype", "comment"}))
+}
+
+func (m mock) noComposites() {
+	m.ExpectQuery(queryComposites).
+		WillReturnRows(sqlmock.NewRows([]string{"oid", "schema_name", "composite_name", "fields", "comment"}))
+}
+
+func (m mock) noAggregates() {
+	m.ExpectQuery(queryAggregates).
+		WillReturnRows(sqlmock.NewRows([]string{"oid", "schema_name", "aggregate_name", "state_func_schema", "state_func_name", "state_type", "final_func_schema", "final_func_name", "initial_condition", "parallel", "comment"}))
+}
+
+func (m mock) noDeps(oids ...driver.Value) {
+	m.ExpectQuery(sqltest.Escape(fmt.Sprintf(depsQuery, nArgs(0, len(oids))))).
+		WithArgs(oids...).
+		WillReturnRows(sqlmock.NewRows([]string{"objid", "obj_routine", "refobjid", "ref_routine"}))
+}
+
+// noTypes expects the queries of the user-defined types to return no rows.
+func (m mock) noTypes() {
+	m.noDomains()
+	m.noRanges()
+	m.noComposites()
+}
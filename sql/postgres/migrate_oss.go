@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -80,6 +81,8 @@ func (s *state) plan(changes []schema.Change) error {
 		return err
 	}
 	if s.PlanOptions.Mode != migrate.PlanModeUnsortedDump {
+		planned = detachSeqOwners(planned)
+		planned = recreateDependentViews(planned)
 		if planned, err = s.detachCycles(planned); err != nil {
 			return err
 		}
@@ -95,6 +98,38 @@ func (s *state) plan(changes []schema.Change) error {
 			s.renameTable(c)
 		case *schema.DropTable:
 			err = s.dropTable(c)
+		case *schema.AddView:
+			err = s.addView(c)
+		case *schema.DropView:
+			err = s.dropView(c)
+		case *schema.ModifyView:
+			err = s.modifyView(c)
+		case *schema.RenameView:
+			s.renameView(c)
+		case *schema.AddFunc:
+			err = s.addFunc(c)
+		case *schema.DropFunc:
+			err = s.dropFunc(c)
+		case *schema.ModifyFunc:
+			err = s.modifyFunc(c)
+		case *schema.RenameFunc:
+			err = s.renameFunc(c)
+		case *schema.AddProc:
+			err = s.addProc(c)
+		case *schema.DropProc:
+			err = s.dropProc(c)
+		case *schema.ModifyProc:
+			err = s.modifyProc(c)
+		case *schema.RenameProc:
+			err = s.renameProc(c)
+		case *schema.AddTrigger:
+			err = s.addTrigger(c)
+		case *schema.DropTrigger:
+			err = s.dropTrigger(c)
+		case *schema.ModifyTrigger:
+			err = s.modifyTrigger(c)
+		case *schema.RenameTrigger:
+			err = s.renameTrigger(c)
 		case *schema.AddObject:
 			err = s.addObject(c)
 		case *schema.ModifyObject:
@@ -306,22 +341,38 @@ func (s *state) modifyTable(modify *schema.ModifyTable) error {
 		alter   []schema.Change
 		addI    []*schema.AddIndex
 		dropI   []*schema.DropIndex
+		dropP   []*migrate.Change
 		changes []*migrate.Change
 	)
 	for _, change := range skipAutoChanges(modify.Changes) {
 		switch change := change.(type) {
 		case *schema.ModifyAttr:
-			if _, ok := change.From.(*schema.Comment); !ok {
+			switch p := change.From.(type) {
+			case *schema.Comment:
+				from, to, err := commentChange(change)
+				if err != nil {
+					return err
+				}
+				// Comments are not part of the ALTER command.
+				changes = append(changes, s.tableComment(modify, modify.T, to, from))
+			case *Policy:
+				to, ok := change.To.(*Policy)
+				if !ok {
+					return fmt.Errorf("unexpected policy modification: (%T, %T)", change.From, change.To)
+				}
+				// Policies that are recreated are dropped before the ALTER command.
+				drop, add := s.modifyPolicy(modify, modify.T, p, to)
+				dropP = append(dropP, drop...)
+				changes = append(changes, add...)
+			default:
 				alter = append(alter, change)
-				continue
-			}
-			from, to, err := commentChange(change)
-			if err != nil {
-				return err
 			}
-			// Comments are not part of the ALTER command.
-			changes = append(changes, s.tableComment(modify, modify.T, to, from))
 		case *schema.AddAttr:
+			if p, ok := change.A.(*Policy); ok {
+				// Policies are created after the ALTER command, as they may reference new columns.
+				changes = append(changes, s.addPolicy(modify, modify.T, p))
+				continue
+			}
 			from, to, err := commentChange(change)
 			if err != nil {
 				return err
@@ -329,7 +380,12 @@ func (s *state) modifyTable(modify *schema.ModifyTable) error {
 			// Comments are not part of the ALTER command.
 			changes = append(changes, s.tableComment(modify, modify.T, to, from))
 		case *schema.DropAttr:
-			return fmt.Errorf("unsupported change type: %T", change)
+			p, ok := change.A.(*Policy)
+			if !ok {
+				return fmt.Errorf("unsupported change type: %T", change)
+			}
+			// Policies are dropped before the ALTER command, as they may reference dropped columns.
+			dropP = append(dropP, s.dropPolicy(modify, modify.T, p))
 		case *schema.AddIndex:
 			if c := (schema.Comment{}); sqlx.Has(change.I.Attrs, &c) {
 				changes = append(changes, s.indexComment(modify, modify.T, change.I, c.Text, ""))
@@ -441,6 +497,7 @@ func (s *state) modifyTable(modify *schema.ModifyTable) error {
 			alter = append(alter, change)
 		}
 	}
+	s.append(dropP...)
 	if err := s.dropIndexes(modify, modify.T, dropI...); err != nil {
 		return err
 	}
@@ -635,6 +692,132 @@ func (s *state) alterTable(t *schema.Table, changes []schema.Change) error {
 	return nil
 }
 
+// addTableAttrs enables the row-level security of the table and creates its policies.
+func (s *state) addTableAttrs(add *schema.AddTable) {
+	if r := rowSecurity(add.T); r.Enabled || r.Enforced {
+		b := s.Build("ALTER TABLE").Table(add.T)
+		rb := b.Clone()
+		alterRowSecurity(b, &RowSecurity{}, r)
+		alterRowSecurity(rb, r, &RowSecurity{})
+		s.append(&migrate.Change{
+			Cmd:     b.String(),
+			Source:  add,
+			Comment: fmt.Sprintf("enable row-level security on %q table", add.T.Name),
+			Reverse: rb.String(),
+		})
+	}
+	for _, p := range policies(add.T) {
+		s.append(s.addPolicy(add, add.T, p))
+	}
+}
+
+// alterTableAttr writes the clauses for altering the row-level security of the table.
+func (s *state) alterTableAttr(b *sqlx.Builder, m *schema.ModifyAttr) {
+	from, ok1 := m.From.(*RowSecurity)
+	to, ok2 := m.To.(*RowSecurity)
+	if ok1 && ok2 {
+		alterRowSecurity(b, from, to)
+	}
+}
+
+// alterRowSecurity writes the clauses for moving the row-level security from one state to the other.
+func alterRowSecurity(b *sqlx.Builder, from, to *RowSecurity) {
+	var clauses []string
+	switch {
+	case !from.Enabled && to.Enabled:
+		clauses = append(clauses, "ENABLE ROW LEVEL SECURITY")
+	case from.Enabled && !to.Enabled:
+		clauses = append(clauses, "DISABLE ROW LEVEL SECURITY")
+	}
+	switch {
+	case !from.Enforced && to.Enforced:
+		clauses = append(clauses, "FORCE ROW LEVEL SECURITY")
+	case from.Enforced && !to.Enforced:
+		clauses = append(clauses, "NO FORCE ROW LEVEL SECURITY")
+	}
+	b.P(strings.Join(clauses, ", "))
+}
+
+// addPolicy returns the change for creating a row-level security policy on the table.
+func (s *state) addPolicy(src schema.Change, t *schema.Table, p *Policy) *migrate.Change {
+	b := s.Build("CREATE POLICY").Ident(p.Name).P("ON").Table(t)
+	if as := policyAs(p); as != PolicyAsPermissive {
+		b.P("AS", as)
+	}
+	if cmd := policyFor(p); cmd != PolicyForAll {
+		b.P("FOR", cmd)
+	}
+	if len(p.To) > 0 {
+		s.policyRoles(b, p.To)
+	}
+	if p.Using != "" {
+		b.P("USING", sqlx.MayWrap(p.Using))
+	}
+	if p.Check != "" {
+		b.P("WITH CHECK", sqlx.MayWrap(p.Check))
+	}
+	return &migrate.Change{
+		Cmd:     b.String(),
+		Source:  src,
+		Comment: fmt.Sprintf("create policy %q on %q table", p.Name, t.Name),
+		Reverse: s.Build("DROP POLICY").Ident(p.Name).P("ON").Table(t).String(),
+	}
+}
+
+// dropPolicy returns the change for dropping a row-level security policy from the table.
+func (s *state) dropPolicy(src schema.Change, t *schema.Table, p *Policy) *migrate.Change {
+	return &migrate.Change{
+		Cmd:     s.Build("DROP POLICY").Ident(p.Name).P("ON").Table(t).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("drop policy %q from %q table", p.Name, t.Name),
+		Reverse: s.addPolicy(src, t, p).Cmd,
+	}
+}
+
+// modifyPolicy returns the changes for modifying a row-level security policy. The type and the
+// command of a policy cannot be altered, and its expressions cannot be removed. In these cases,
+// the policy is recreated and the returned drop change should be executed before the others.
+func (s *state) modifyPolicy(src schema.Change, t *schema.Table, from, to *Policy) (drop, changes []*migrate.Change) {
+	if policyAs(from) != policyAs(to) || policyFor(from) != policyFor(to) ||
+		(from.Using != "" && to.Using == "") || (from.Check != "" && to.Check == "") {
+		return []*migrate.Change{s.dropPolicy(src, t, from)}, []*migrate.Change{s.addPolicy(src, t, to)}
+	}
+	alter := func(from, to *Policy) string {
+		b := s.Build("ALTER POLICY").Ident(to.Name).P("ON").Table(t)
+		if !slices.Equal(policyRoles(from), policyRoles(to)) {
+			s.policyRoles(b, policyRoles(to))
+		}
+		if exprChanged(from.Using, to.Using) {
+			b.P("USING", sqlx.MayWrap(to.Using))
+		}
+		if exprChanged(from.Check, to.Check) {
+			b.P("WITH CHECK", sqlx.MayWrap(to.Check))
+		}
+		return b.String()
+	}
+	change := &migrate.Change{
+		Cmd:     alter(from, to),
+		Source:  src,
+		Comment: fmt.Sprintf("modify policy %q on %q table", to.Name, t.Name),
+	}
+	// Expressions that were added cannot be removed by the reverse command.
+	if (from.Using != "" || to.Using == "") && (from.Check != "" || to.Check == "") {
+		change.Reverse = alter(to, from)
+	}
+	return nil, []*migrate.Change{change}
+}
+
+// policyRoles writes the TO clause of a policy. Role keywords are written as is.
+func (s *state) policyRoles(b *sqlx.Builder, roles []string) {
+	b.P("TO").MapComma(roles, func(i int, b *sqlx.Builder) {
+		if isRoleKeyword(roles[i]) {
+			b.WriteString(strings.ToUpper(roles[i]))
+		} else {
+			b.Ident(roles[i])
+		}
+	})
+}
+
 // changeGroup describes an alter table migrate.Change where its main command
 // can be supported by additional statements before and after it is executed.
 type changeGroup struct {
@@ -695,111 +878,1849 @@ func (s *state) alterColumn(b *sqlx.Builder, alter *changeGroup, t *schema.Table
 		default: // e.g. schema.ChangeComment.
 			return fmt.Errorf("unexpected column change: %d", k)
 		}
-		if !k.Is(schema.NoChange) {
-			b.Comma()
+		if !k.Is(schema.NoChange) {
+			b.Comma()
+		}
+	}
+	return nil
+}
+
+// alterType appends the clause(s) to alter the column type and assuming the
+// "ALTER COLUMN <Name>" was called before by the alterColumn function.
+func (s *state) alterType(b *sqlx.Builder, alter *changeGroup, t *schema.Table, c *schema.ModifyColumn) error {
+	// Commands for creating and dropping serial sequences.
+	createDropSeq := func(st *SerialType) (string, string, string) {
+		seq := fmt.Sprintf(`%s%q`, s.schemaPrefix(t.Schema), st.sequence(t, c.To))
+		drop := s.Build("DROP SEQUENCE IF EXISTS").P(seq).String()
+		create := s.Build("CREATE SEQUENCE IF NOT EXISTS").P(seq, "OWNED BY").
+			P(fmt.Sprintf(`%s%q.%q`, s.schemaPrefix(t.Schema), t.Name, c.To.Name)).
+			String()
+		return create, drop, seq
+	}
+	toS, toHas := c.To.Type.Type.(*SerialType)
+	fromS, fromHas := c.From.Type.Type.(*SerialType)
+	switch {
+	// Sequence was dropped.
+	case fromHas && !toHas:
+		b.P("DROP DEFAULT")
+		create, drop, _ := createDropSeq(fromS)
+		// Sequence should be deleted after it was dropped
+		// from the DEFAULT value.
+		alter.after = append(alter.after, &migrate.Change{
+			Source:  c,
+			Comment: fmt.Sprintf("drop sequence used by serial column %q", c.From.Name),
+			Cmd:     drop,
+			Reverse: create,
+		})
+		toT, err := FormatType(c.To.Type.Type)
+		if err != nil {
+			return err
+		}
+		fromT, err := FormatType(fromS.IntegerType())
+		if err != nil {
+			return err
+		}
+		// Underlying type was changed. e.g. serial to bigint.
+		if toT != fromT {
+			b.Comma().P("ALTER COLUMN").Ident(c.To.Name).P("TYPE", toT)
+		}
+	// Sequence was added.
+	case !fromHas && toHas:
+		create, drop, seq := createDropSeq(toS)
+		// Sequence should be created before it is used by the
+		// column DEFAULT value.
+		alter.before = append(alter.before, &migrate.Change{
+			Source:  c,
+			Comment: fmt.Sprintf("create sequence for serial column %q", c.To.Name),
+			Cmd:     create,
+			Reverse: drop,
+		})
+		b.P("SET DEFAULT", fmt.Sprintf("nextval('%s')", seq))
+		toT, err := FormatType(toS.IntegerType())
+		if err != nil {
+			return err
+		}
+		fromT, err := FormatType(c.From.Type.Type)
+		if err != nil {
+			return err
+		}
+		// Underlying type was changed. e.g. integer to bigserial (bigint).
+		if toT != fromT {
+			b.Comma().P("ALTER COLUMN").Ident(c.To.Name).P("TYPE", toT)
+		}
+	// Serial type was changed. e.g. serial to bigserial.
+	case fromHas && toHas:
+		f, err := FormatType(toS.IntegerType())
+		if err != nil {
+			return err
+		}
+		b.P("TYPE", f)
+	default:
+		f, err := s.formatType(c.To.Type.Type)
+		if err != nil {
+			return err
+		}
+		b.P("TYPE", f)
+	}
+	if collate := (schema.Collation{}); sqlx.Has(c.To.Attrs, &collate) {
+		b.P("COLLATE").Ident(collate.V)
+	}
+	if using := (ConvertUsing{}); sqlx.Has(c.Extra, &using) {
+		b.P("USING", using.X)
+	}
+	return nil
+}
+
+func (s *state) renameTable(c *schema.RenameTable) {
+	s.append(&migrate.Change{
+		Source:  c,
+		Comment: fmt.Sprintf("rename a table from %q to %q", c.From.Name, c.To.Name),
+		Cmd:     s.Build("ALTER TABLE").Table(c.From).P("RENAME TO").Table(c.To).String(),
+		Reverse: s.Build("ALTER TABLE").Table(c.To).P("RENAME TO").Table(c.From).String(),
+	})
+}
+
+// addView builds and executes the query for creating a view or a materialized view.
+func (s *state) addView(add *schema.AddView) error {
+	b := s.Build("CREATE", viewType(add.V))
+	if add.V.Materialized() && sqlx.Has(add.Extra, &schema.IfNotExists{}) {
+		b.P("IF NOT EXISTS")
+	}
+	s.viewDef(b, add.V)
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  add,
+		Comment: fmt.Sprintf("create %q %s", add.V.Name, strings.ToLower(viewType(add.V))),
+		Reverse: s.Build("DROP", viewType(add.V)).View(add.V).String(),
+	})
+	if len(add.V.Indexes) > 0 {
+		adds := make([]*schema.AddIndex, len(add.V.Indexes))
+		for i, idx := range add.V.Indexes {
+			adds[i] = &schema.AddIndex{I: idx}
+		}
+		if err := s.addIndexes(add, add.V.AsTable(), adds...); err != nil {
+			return err
+		}
+	}
+	s.addViewComments(add, add.V)
+	return nil
+}
+
+// dropView builds and executes the query for dropping a view or a materialized view.
+func (s *state) dropView(drop *schema.DropView) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addView(&schema.AddView{V: drop.V}); err != nil {
+		return fmt.Errorf("calculate reverse for drop view %q: %w", drop.V.Name, err)
+	}
+	b := s.Build("DROP", viewType(drop.V))
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	b.View(drop.V)
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop %q %s", drop.V.Name, strings.ToLower(viewType(drop.V))),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyView builds the statements that bring the view into its modified state. Views are
+// replaced using CREATE OR REPLACE when their columns are compatible, and materialized views
+// or views that cannot be replaced are dropped and recreated.
+func (s *state) modifyView(modify *schema.ModifyView) error {
+	from, to := modify.From, modify.To
+	replace := sqlx.BodyDefChanged(from.Def, to.Def) || viewCheckOption(from).V != viewCheckOption(to).V || s.viewColumnsChanged(from, to)
+	switch {
+	case !replace:
+	case !to.Materialized() && s.viewReplaceable(from, to):
+		b, r := s.Build("CREATE OR REPLACE VIEW"), s.Build("CREATE OR REPLACE VIEW")
+		s.viewDef(b, to)
+		s.viewDef(r, from)
+		var reverse any = r.String()
+		// Columns cannot be dropped using CREATE OR REPLACE VIEW.
+		if len(to.Columns) > len(from.Columns) {
+			reverse = []string{s.Build("DROP VIEW").View(to).String(), s.viewDef(s.Build("CREATE VIEW"), from).String()}
+		}
+		s.append(&migrate.Change{
+			Cmd:     b.String(),
+			Source:  modify,
+			Comment: fmt.Sprintf("replace %q view", to.Name),
+			Reverse: reverse,
+		})
+	default:
+		// Recreating the view also recreates its
+		// indexes and comments. Hence, other changes are skipped.
+		if err := s.dropView(&schema.DropView{V: from}); err != nil {
+			return err
+		}
+		return s.addView(&schema.AddView{V: to})
+	}
+	var (
+		addI  []*schema.AddIndex
+		dropI []*schema.DropIndex
+	)
+	for _, change := range modify.Changes {
+		switch change := change.(type) {
+		case *schema.AddAttr, *schema.ModifyAttr:
+			// Check option changes are part of the view definition.
+			if m, ok := change.(*schema.ModifyAttr); ok {
+				if _, ok := m.To.(*schema.ViewCheckOption); ok {
+					continue
+				}
+			}
+			from, to, err := commentChange(change)
+			if err != nil {
+				return err
+			}
+			s.append(s.viewComment(modify, modify.To, to, from))
+		case *schema.ModifyColumn:
+			if !change.Change.Is(schema.ChangeComment) {
+				return fmt.Errorf("unsupported view column change: %v", change.Change)
+			}
+			from, to, err := commentChange(sqlx.CommentDiff(change.From.Attrs, change.To.Attrs))
+			if err != nil {
+				return err
+			}
+			s.append(s.viewColumnComment(modify, modify.To, change.To, to, from))
+		case *schema.AddIndex:
+			if c := (schema.Comment{}); sqlx.Has(change.I.Attrs, &c) {
+				s.append(s.indexComment(modify, modify.To.AsTable(), change.I, c.Text, ""))
+			}
+			addI = append(addI, change)
+		case *schema.DropIndex:
+			dropI = append(dropI, change)
+		case *schema.ModifyIndex:
+			k := change.Change
+			if change.Change.Is(schema.ChangeComment) {
+				from, to, err := commentChange(sqlx.CommentDiff(change.From.Attrs, change.To.Attrs))
+				if err != nil {
+					return err
+				}
+				s.append(s.indexComment(modify, modify.To.AsTable(), change.To, to, from))
+				// If only the comment of the index was changed.
+				if k &= ^schema.ChangeComment; k.Is(schema.NoChange) {
+					continue
+				}
+			}
+			// Index modification requires rebuilding the index.
+			dropI = append(dropI, &schema.DropIndex{I: change.From})
+			addI = append(addI, &schema.AddIndex{I: change.To})
+		default:
+			return fmt.Errorf("unsupported view change: %T", change)
+		}
+	}
+	if err := s.dropIndexes(modify, modify.To.AsTable(), dropI...); err != nil {
+		return err
+	}
+	return s.addIndexes(modify, modify.To.AsTable(), addI...)
+}
+
+// renameView builds and executes the query for renaming a view or a materialized view.
+func (s *state) renameView(c *schema.RenameView) {
+	s.append(&migrate.Change{
+		Source:  c,
+		Comment: fmt.Sprintf("rename a %s from %q to %q", strings.ToLower(viewType(c.From)), c.From.Name, c.To.Name),
+		Cmd:     s.Build("ALTER", viewType(c.From)).View(c.From).P("RENAME TO").Ident(c.To.Name).String(),
+		Reverse: s.Build("ALTER", viewType(c.From)).View(c.To).P("RENAME TO").Ident(c.From.Name).String(),
+	})
+}
+
+// routine represents a function or a procedure in the planner.
+type routine struct {
+	kind   string // FUNCTION or PROCEDURE.
+	name   string
+	schema *schema.Schema
+	args   []*schema.FuncArg
+	ret    schema.Type // Functions only.
+	lang   string
+	body   string
+	attrs  []schema.Attr
+}
+
+func funcRoutine(f *schema.Func) *routine {
+	return &routine{kind: "FUNCTION", name: f.Name, schema: f.Schema, args: f.Args, ret: f.Ret, lang: f.Lang, body: f.Body, attrs: f.Attrs}
+}
+
+func procRoutine(p *schema.Proc) *routine {
+	return &routine{kind: "PROCEDURE", name: p.Name, schema: p.Schema, args: p.Args, lang: p.Lang, body: p.Body, attrs: p.Attrs}
+}
+
+// addFunc builds and executes the query for creating a function.
+func (s *state) addFunc(add *schema.AddFunc) error {
+	return s.addRoutine(add, funcRoutine(add.F))
+}
+
+// dropFunc builds and executes the query for dropping a function.
+func (s *state) dropFunc(drop *schema.DropFunc) error {
+	return s.dropRoutine(drop, funcRoutine(drop.F), drop.Extra)
+}
+
+// modifyFunc builds the statements that bring the function into its modified state.
+func (s *state) modifyFunc(modify *schema.ModifyFunc) error {
+	changed, err := funcChanged(modify.From, modify.To, s.schema)
+	if err != nil {
+		return err
+	}
+	return s.modifyRoutine(modify, funcRoutine(modify.From), funcRoutine(modify.To), changed, modify.Changes)
+}
+
+// renameFunc builds and executes the query for renaming a function.
+func (s *state) renameFunc(c *schema.RenameFunc) error {
+	return s.renameRoutine(c, funcRoutine(c.From), funcRoutine(c.To))
+}
+
+// addProc builds and executes the query for creating a procedure.
+func (s *state) addProc(add *schema.AddProc) error {
+	return s.addRoutine(add, procRoutine(add.P))
+}
+
+// dropProc builds and executes the query for dropping a procedure.
+func (s *state) dropProc(drop *schema.DropProc) error {
+	return s.dropRoutine(drop, procRoutine(drop.P), drop.Extra)
+}
+
+// modifyProc builds the statements that bring the procedure into its modified state.
+func (s *state) modifyProc(modify *schema.ModifyProc) error {
+	changed, err := procChanged(modify.From, modify.To, s.schema)
+	if err != nil {
+		return err
+	}
+	return s.modifyRoutine(modify, procRoutine(modify.From), procRoutine(modify.To), changed, modify.Changes)
+}
+
+// renameProc builds and executes the query for renaming a procedure.
+func (s *state) renameProc(c *schema.RenameProc) error {
+	return s.renameRoutine(c, procRoutine(c.From), procRoutine(c.To))
+}
+
+func (s *state) addRoutine(src schema.Change, r *routine) error {
+	cmd, err := s.routineDef(s.Build("CREATE", r.kind), r)
+	if err != nil {
+		return err
+	}
+	drop, err := s.routineSig(s.Build("DROP", r.kind), r)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Cmd:     cmd.String(),
+		Source:  src,
+		Comment: fmt.Sprintf("create %q %s", r.name, strings.ToLower(r.kind)),
+		Reverse: drop.String(),
+	})
+	if c := (schema.Comment{}); sqlx.Has(r.attrs, &c) && c.Text != "" {
+		cm, err := s.routineComment(src, r, c.Text, "")
+		if err != nil {
+			return err
+		}
+		s.append(cm)
+	}
+	return nil
+}
+
+func (s *state) dropRoutine(src schema.Change, r *routine, extra []schema.Clause) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addRoutine(src, r); err != nil {
+		return fmt.Errorf("calculate reverse for drop %s %q: %w", strings.ToLower(r.kind), r.name, err)
+	}
+	b := s.Build("DROP", r.kind)
+	if sqlx.Has(extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	if _, err := s.routineSig(b, r); err != nil {
+		return err
+	}
+	if sqlx.Has(extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  src,
+		Comment: fmt.Sprintf("drop %q %s", r.name, strings.ToLower(r.kind)),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyRoutine replaces the definition of a function or a procedure using CREATE OR REPLACE.
+// Changes that cannot be applied this way, such as changing the return type or the arguments
+// names or types, are planned by dropping and recreating the routine.
+func (s *state) modifyRoutine(src schema.Change, from, to *routine, changed bool, changes []schema.Change) error {
+	if changed {
+		_, replace, err := funcArgsChanged(from.args, to.args, s.schema)
+		if err != nil {
+			return err
+		}
+		retChanged, err := funcTypeChanged(from.ret, to.ret, s.schema)
+		if err != nil {
+			return err
+		}
+		if !replace || retChanged {
+			// Recreating the routine also sets its comment.
+			// Hence, other changes are skipped.
+			if err := s.dropRoutine(src, from, nil); err != nil {
+				return err
+			}
+			return s.addRoutine(src, to)
+		}
+		cmd, err := s.routineDef(s.Build("CREATE OR REPLACE", to.kind), to)
+		if err != nil {
+			return err
+		}
+		reverse, err := s.routineDef(s.Build("CREATE OR REPLACE", from.kind), from)
+		if err != nil {
+			return err
+		}
+		s.append(&migrate.Change{
+			Cmd:     cmd.String(),
+			Source:  src,
+			Comment: fmt.Sprintf("replace %q %s", to.name, strings.ToLower(to.kind)),
+			Reverse: reverse.String(),
+		})
+	}
+	for _, c := range changes {
+		switch c.(type) {
+		case *schema.AddAttr, *schema.ModifyAttr:
+			fromC, toC, err := commentChange(c)
+			if err != nil {
+				return err
+			}
+			cm, err := s.routineComment(src, to, toC, fromC)
+			if err != nil {
+				return err
+			}
+			s.append(cm)
+		default:
+			return fmt.Errorf("unsupported %s change: %T", strings.ToLower(to.kind), c)
+		}
+	}
+	return nil
+}
+
+func (s *state) renameRoutine(src schema.Change, from, to *routine) error {
+	cmd, err := s.routineSig(s.Build("ALTER", from.kind), from)
+	if err != nil {
+		return err
+	}
+	reverse, err := s.routineSig(s.Build("ALTER", to.kind), to)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Source:  src,
+		Comment: fmt.Sprintf("rename a %s from %q to %q", strings.ToLower(from.kind), from.name, to.name),
+		Cmd:     cmd.P("RENAME TO").Ident(to.name).String(),
+		Reverse: reverse.P("RENAME TO").Ident(from.name).String(),
+	})
+	return nil
+}
+
+func (s *state) routineComment(src schema.Change, r *routine, to, from string) (*migrate.Change, error) {
+	b, err := s.routineSig(s.Build("COMMENT ON", r.kind), r)
+	if err != nil {
+		return nil, err
+	}
+	b.P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to %s: %q", strings.ToLower(r.kind), r.name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}, nil
+}
+
+// routineSig writes the routine identifier and the types of its input arguments to the builder.
+func (s *state) routineSig(b *sqlx.Builder, r *routine) (*sqlx.Builder, error) {
+	s.routineIdent(b, r)
+	in := funcInArgs(r.args)
+	err := b.WrapErr(func(b *sqlx.Builder) error {
+		return b.MapCommaErr(in, func(i int, b *sqlx.Builder) error {
+			t, err := s.formatType(in[i].Type)
+			if err != nil {
+				return fmt.Errorf("format type of argument %q of %q: %w", in[i].Name, r.name, err)
+			}
+			b.P(t)
+			return nil
+		})
+	})
+	return b, err
+}
+
+// routineDef writes the routine identifier, its arguments and its definition to the builder.
+func (s *state) routineDef(b *sqlx.Builder, r *routine) (*sqlx.Builder, error) {
+	s.routineIdent(b, r)
+	err := b.WrapErr(func(b *sqlx.Builder) error {
+		return b.MapCommaErr(r.args, func(i int, b *sqlx.Builder) error {
+			a := r.args[i]
+			if m := funcArgMode(a); m != schema.FuncArgModeIn {
+				b.P(string(m))
+			}
+			if a.Name != "" {
+				b.Ident(a.Name)
+			}
+			t, err := s.formatType(a.Type)
+			if err != nil {
+				return fmt.Errorf("format type of argument %q of %q: %w", a.Name, r.name, err)
+			}
+			b.P(t)
+			if x := funcArgDefault(a); x != "" {
+				b.P("DEFAULT", x)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.ret != nil {
+		t, err := s.formatType(r.ret)
+		if err != nil {
+			return nil, fmt.Errorf("format return type of %q: %w", r.name, err)
+		}
+		b.P("RETURNS", t)
+	}
+	if r.lang != "" {
+		b.P("LANGUAGE", r.lang)
+	}
+	if v := funcVolatility(r.attrs); r.ret != nil && v != FuncVolatilityVolatile {
+		b.P(v)
+	}
+	if funcSecurity(r.attrs) == FuncSecurityDefiner {
+		b.P("SECURITY DEFINER")
+	}
+	return b.P(routineBody(r.lang, r.body)), nil
+}
+
+// routineIdent writes the routine identifier to the builder.
+func (s *state) routineIdent(b *sqlx.Builder, r *routine) {
+	if r.kind == "PROCEDURE" {
+		b.Proc(&schema.Proc{Name: r.name, Schema: r.schema})
+	} else {
+		b.Func(&schema.Func{Name: r.name, Schema: r.schema})
+	}
+}
+
+// routineBody returns the body clause of a routine. SQL-standard bodies (BEGIN ATOMIC
+// or RETURN) are written as-is, and others are quoted as dollar-quoted string constants.
+func routineBody(lang, body string) string {
+	if b := strings.TrimSpace(body); strings.EqualFold(lang, FuncLangSQL) {
+		if u := strings.ToUpper(b); strings.HasPrefix(u, "BEGIN ATOMIC") || strings.HasPrefix(u, "RETURN ") || strings.HasPrefix(u, "RETURN(") {
+			return b
+		}
+	}
+	tag := "$$"
+	for i := 1; strings.Contains(body, tag); i++ {
+		tag = "$" + strings.Repeat("_", i) + "$"
+	}
+	return "AS " + tag + body + tag
+}
+
+// addTrigger builds and executes the query for creating a trigger.
+func (s *state) addTrigger(add *schema.AddTrigger) error {
+	cmd, err := s.triggerDef(s.Build("CREATE TRIGGER"), add.T)
+	if err != nil {
+		return err
+	}
+	drop, err := s.triggerOn(s.Build("DROP TRIGGER").Ident(add.T.Name), add.T)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Cmd:     cmd.String(),
+		Source:  add,
+		Comment: fmt.Sprintf("create trigger %q", add.T.Name),
+		Reverse: drop.String(),
+	})
+	if c := (schema.Comment{}); sqlx.Has(add.T.Attrs, &c) && c.Text != "" {
+		cm, err := s.triggerComment(add, add.T, c.Text, "")
+		if err != nil {
+			return err
+		}
+		s.append(cm)
+	}
+	return nil
+}
+
+// dropTrigger builds and executes the query for dropping a trigger.
+func (s *state) dropTrigger(drop *schema.DropTrigger) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addTrigger(&schema.AddTrigger{T: drop.T}); err != nil {
+		return fmt.Errorf("calculate reverse for drop trigger %q: %w", drop.T.Name, err)
+	}
+	b := s.Build("DROP TRIGGER")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	if _, err := s.triggerOn(b.Ident(drop.T.Name), drop.T); err != nil {
+		return err
+	}
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop trigger %q", drop.T.Name),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyTrigger builds the statements that bring the trigger into its modified state. Triggers
+// are replaced using CREATE OR REPLACE TRIGGER if supported by the server, or dropped and recreated.
+func (s *state) modifyTrigger(modify *schema.ModifyTrigger) error {
+	from, to := modify.From, modify.To
+	switch {
+	case !triggerChanged(from, to, s.schema):
+	case s.supportsReplaceTrigger():
+		cmd, err := s.triggerDef(s.Build("CREATE OR REPLACE TRIGGER"), to)
+		if err != nil {
+			return err
+		}
+		reverse, err := s.triggerDef(s.Build("CREATE OR REPLACE TRIGGER"), from)
+		if err != nil {
+			return err
+		}
+		s.append(&migrate.Change{
+			Cmd:     cmd.String(),
+			Source:  modify,
+			Comment: fmt.Sprintf("replace trigger %q", to.Name),
+			Reverse: reverse.String(),
+		})
+	default:
+		// Recreating the trigger also sets its comment.
+		// Hence, other changes are skipped.
+		if err := s.dropTrigger(&schema.DropTrigger{T: from}); err != nil {
+			return err
+		}
+		return s.addTrigger(&schema.AddTrigger{T: to})
+	}
+	for _, c := range modify.Changes {
+		switch c.(type) {
+		case *schema.AddAttr, *schema.ModifyAttr:
+			fromC, toC, err := commentChange(c)
+			if err != nil {
+				return err
+			}
+			cm, err := s.triggerComment(modify, to, toC, fromC)
+			if err != nil {
+				return err
+			}
+			s.append(cm)
+		default:
+			return fmt.Errorf("unsupported trigger change: %T", c)
+		}
+	}
+	return nil
+}
+
+// renameTrigger builds and executes the query for renaming a trigger.
+func (s *state) renameTrigger(c *schema.RenameTrigger) error {
+	cmd, err := s.triggerOn(s.Build("ALTER TRIGGER").Ident(c.From.Name), c.From)
+	if err != nil {
+		return err
+	}
+	reverse, err := s.triggerOn(s.Build("ALTER TRIGGER").Ident(c.To.Name), c.To)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Source:  c,
+		Comment: fmt.Sprintf("rename a trigger from %q to %q", c.From.Name, c.To.Name),
+		Cmd:     cmd.P("RENAME TO").Ident(c.To.Name).String(),
+		Reverse: reverse.P("RENAME TO").Ident(c.From.Name).String(),
+	})
+	return nil
+}
+
+func (s *state) triggerComment(src schema.Change, t *schema.Trigger, to, from string) (*migrate.Change, error) {
+	b, err := s.triggerOn(s.Build("COMMENT ON TRIGGER").Ident(t.Name), t)
+	if err != nil {
+		return nil, err
+	}
+	b.P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to trigger: %q", t.Name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}, nil
+}
+
+// triggerOn writes the ON clause of the trigger to the builder.
+func (s *state) triggerOn(b *sqlx.Builder, t *schema.Trigger) (*sqlx.Builder, error) {
+	switch {
+	case t.Table != nil:
+		return b.P("ON").Table(t.Table), nil
+	case t.View != nil:
+		return b.P("ON").View(t.View), nil
+	default:
+		return nil, fmt.Errorf("trigger %q is not attached to a table or a view", t.Name)
+	}
+}
+
+// triggerDef writes the trigger name and its definition to the builder.
+func (s *state) triggerDef(b *sqlx.Builder, t *schema.Trigger) (*sqlx.Builder, error) {
+	if len(t.Events) == 0 {
+		return nil, fmt.Errorf("missing events for trigger %q", t.Name)
+	}
+	b.Ident(t.Name).P(string(t.ActionTime))
+	for i, e := range t.Events {
+		if i > 0 {
+			b.P("OR")
+		}
+		switch {
+		case e.Name == schema.TriggerEventUpdateOf().Name:
+			b.P("UPDATE OF")
+			b.MapComma(e.Columns, func(i int, b *sqlx.Builder) {
+				b.Ident(e.Columns[i].Name)
+			})
+		default:
+			b.P(e.Name)
+		}
+	}
+	if _, err := s.triggerOn(b, t); err != nil {
+		return nil, err
+	}
+	b.P("FOR EACH", string(triggerFor(t)))
+	if w := (TriggerWhen{}); sqlx.Has(t.Attrs, &w) && w.X != "" {
+		b.P("WHEN", sqlx.MayWrap(w.X))
+	}
+	f := TriggerFunc{}
+	if !sqlx.Has(t.Attrs, &f) || f.F == nil {
+		return nil, fmt.Errorf("missing function for trigger %q", t.Name)
+	}
+	s.execFunc(b, f.F, f.Args)
+	return b, nil
+}
+
+// addExtension builds and executes the query for installing an extension.
+func (s *state) addExtension(src schema.Change, e *Extension) {
+	b := s.Build("CREATE EXTENSION").Ident(e.Name)
+	if ns := s.extensionSchema(e); ns != "" {
+		b.P("WITH SCHEMA").Ident(ns)
+	}
+	if e.Version != "" {
+		b.P("VERSION", quote(e.Version))
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  src,
+		Comment: fmt.Sprintf("create extension %q", e.Name),
+		Reverse: s.Build("DROP EXTENSION").Ident(e.Name).String(),
+	})
+}
+
+// dropExtension builds and executes the query for dropping an extension.
+func (s *state) dropExtension(drop *schema.DropObject, e *Extension) {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	rs.addExtension(drop, e)
+	b := s.Build("DROP EXTENSION")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	b.Ident(e.Name)
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop extension %q", e.Name),
+		Reverse: rs.stmts(),
+	})
+}
+
+// modifyExtension updates the extension version or moves its objects to another schema.
+func (s *state) modifyExtension(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*Extension)
+	to, ok2 := modify.To.(*Extension)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unexpected extension modification: (%T, %T)", modify.From, modify.To)
+	}
+	if to.Version != "" && from.Version != to.Version {
+		b := s.Build("ALTER EXTENSION").Ident(to.Name).P("UPDATE TO")
+		s.append(&migrate.Change{
+			Cmd:     b.Clone().P(quote(to.Version)).String(),
+			Source:  modify,
+			Comment: fmt.Sprintf("update extension %q to version %q", to.Name, to.Version),
+			Reverse: b.Clone().P(quote(from.Version)).String(),
+		})
+	}
+	if to.Schema != nil && (from.Schema == nil || from.Schema.Name != to.Schema.Name) {
+		b := s.Build("ALTER EXTENSION").Ident(to.Name).P("SET SCHEMA")
+		change := &migrate.Change{
+			Cmd:     b.Clone().Ident(to.Schema.Name).String(),
+			Source:  modify,
+			Comment: fmt.Sprintf("move extension %q to schema %q", to.Name, to.Schema.Name),
+		}
+		if from.Schema != nil {
+			change.Reverse = b.Clone().Ident(from.Schema.Name).String()
+		}
+		s.append(change)
+	}
+	return nil
+}
+
+// extensionSchema returns the schema name the extension is installed in, based on the planner config.
+func (s *state) extensionSchema(e *Extension) string {
+	switch {
+	case s.SchemaQualifier != nil:
+		return *s.SchemaQualifier
+	case e.Schema != nil:
+		return e.Schema.Name
+	}
+	return ""
+}
+
+// addEventTrigger builds and executes the query for creating an event trigger.
+func (s *state) addEventTrigger(src schema.Change, e *EventTrigger) error {
+	b := s.Build("CREATE EVENT TRIGGER").Ident(e.Name).P("ON", e.Event)
+	if len(e.Tags) > 0 {
+		b.P("WHEN TAG IN").Wrap(func(b *sqlx.Builder) {
+			b.MapComma(e.Tags, func(i int, b *sqlx.Builder) {
+				b.WriteString(quote(e.Tags[i]))
+			})
+		})
+	}
+	if e.F == nil {
+		return fmt.Errorf("missing function for event trigger %q", e.Name)
+	}
+	s.execFunc(b, e.F, nil)
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  src,
+		Comment: fmt.Sprintf("create event trigger %q", e.Name),
+		Reverse: s.Build("DROP EVENT TRIGGER").Ident(e.Name).String(),
+	})
+	if c := (schema.Comment{}); sqlx.Has(e.Attrs, &c) && c.Text != "" {
+		s.append(s.eventTriggerComment(src, e, c.Text, ""))
+	}
+	return nil
+}
+
+// dropEventTrigger builds and executes the query for dropping an event trigger.
+func (s *state) dropEventTrigger(drop *schema.DropObject, e *EventTrigger) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addEventTrigger(drop, e); err != nil {
+		return fmt.Errorf("calculate reverse for drop event trigger %q: %w", e.Name, err)
+	}
+	b := s.Build("DROP EVENT TRIGGER")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	b.Ident(e.Name)
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop event trigger %q", e.Name),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyEventTrigger recreates the event trigger in case its definition was
+// changed, as PostgreSQL does not support replacing event triggers.
+func (s *state) modifyEventTrigger(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*EventTrigger)
+	to, ok2 := modify.To.(*EventTrigger)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unexpected event trigger modification: (%T, %T)", modify.From, modify.To)
+	}
+	if eventTriggerChanged(from, to, s.schema) {
+		// Recreating the event trigger also sets its comment.
+		if err := s.dropEventTrigger(&schema.DropObject{O: from}, from); err != nil {
+			return err
+		}
+		return s.addEventTrigger(modify, to)
+	}
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		fromC, toC, err := commentChange(change)
+		if err != nil {
+			return err
+		}
+		s.append(s.eventTriggerComment(modify, to, toC, fromC))
+	}
+	return nil
+}
+
+func (s *state) eventTriggerComment(src schema.Change, e *EventTrigger, to, from string) *migrate.Change {
+	b := s.Build("COMMENT ON EVENT TRIGGER").Ident(e.Name).P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to event trigger: %q", e.Name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}
+}
+
+// execFunc writes the EXECUTE clause of a trigger or an event trigger to the builder.
+func (s *state) execFunc(b *sqlx.Builder, f *schema.Func, args []string) {
+	if s.supportsExecFunc() {
+		b.P("EXECUTE FUNCTION")
+	} else {
+		b.P("EXECUTE PROCEDURE")
+	}
+	qargs := make([]string, len(args))
+	for i := range args {
+		qargs[i] = quote(args[i])
+	}
+	// Functions that are managed by the realm follow the schema qualifier
+	// of the plan, and others (e.g., builtins) are kept as defined.
+	if f.Schema != nil && slices.Contains(f.Schema.Funcs, f) {
+		b.FuncCall(f, qargs...)
+		return
+	}
+	name := strconv.Quote(f.Name)
+	if f.Schema != nil && f.Schema.Name != "" {
+		name = strconv.Quote(f.Schema.Name) + "." + name
+	}
+	b.P(name + "(" + strings.Join(qargs, ", ") + ")")
+}
+
+// addDomain builds and executes the query for creating a domain type.
+func (s *state) addDomain(add *schema.AddObject, d *DomainType) error {
+	if d.Type == nil {
+		return fmt.Errorf("missing base type for domain %q", d.T)
+	}
+	f, err := s.formatType(d.Type)
+	if err != nil {
+		return fmt.Errorf("format base type of domain %q: %w", d.T, err)
+	}
+	b := s.Build("CREATE DOMAIN").P(s.domainIdent(d), "AS", f)
+	s.columnDefault(b, domainColumn(d))
+	if !d.Null {
+		b.P("NOT NULL")
+	}
+	for _, c := range d.Checks {
+		check(b, c)
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  add,
+		Comment: fmt.Sprintf("create domain type %q", d.T),
+		Reverse: s.Build("DROP DOMAIN").P(s.domainIdent(d)).String(),
+	})
+	if c := (schema.Comment{}); sqlx.Has(d.Attrs, &c) && c.Text != "" {
+		s.append(s.domainComment(add, d, c.Text, ""))
+	}
+	return nil
+}
+
+// dropDomain builds and executes the query for dropping a domain type.
+func (s *state) dropDomain(drop *schema.DropObject, d *DomainType) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addDomain(&schema.AddObject{O: d}, d); err != nil {
+		return fmt.Errorf("calculate reverse for drop domain %q: %w", d.T, err)
+	}
+	b := s.Build("DROP DOMAIN")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	b.P(s.domainIdent(d))
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop domain type %q", d.T),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyDomain alters the default value, the nullability, the check
+// constraints and the comment of a domain. Changing the base type of
+// a domain is not supported by PostgreSQL.
+func (s *state) modifyDomain(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*DomainType)
+	to, ok2 := modify.To.(*DomainType)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unexpected domain modification: (%T, %T)", modify.From, modify.To)
+	}
+	if changed, err := funcTypeChanged(from.Type, to.Type, s.schema); err != nil {
+		return err
+	} else if changed {
+		return fmt.Errorf("changing the base type of domain %q is not supported", to.T)
+	}
+	alter := func() *sqlx.Builder {
+		return s.Build("ALTER DOMAIN").P(s.domainIdent(to))
+	}
+	setDefault := func(d *DomainType) string {
+		if d.Default == nil {
+			return alter().P("DROP DEFAULT").String()
+		}
+		b := alter().P("SET")
+		s.columnDefault(b, domainColumn(d))
+		return b.String()
+	}
+	setNull := func(d *DomainType) string {
+		if d.Null {
+			return alter().P("DROP NOT NULL").String()
+		}
+		return alter().P("SET NOT NULL").String()
+	}
+	comment := fmt.Sprintf("modify domain type %q", to.T)
+	if changed, err := (&diff{s.conn}).defaultChanged(domainColumn(from), domainColumn(to)); err != nil {
+		return err
+	} else if changed {
+		s.append(&migrate.Change{
+			Cmd:     setDefault(to),
+			Source:  modify,
+			Comment: comment,
+			Reverse: setDefault(from),
+		})
+	}
+	if from.Null != to.Null {
+		s.append(&migrate.Change{
+			Cmd:     setNull(to),
+			Source:  modify,
+			Comment: comment,
+			Reverse: setNull(from),
+		})
+	}
+	addCheck := func(c *schema.Check) string {
+		b := alter().P("ADD")
+		check(b, c)
+		return b.String()
+	}
+	dropCheck := func(c *schema.Check) string {
+		// Unnamed constraints cannot be dropped.
+		if c.Name == "" {
+			return ""
+		}
+		return alter().P("DROP CONSTRAINT").Ident(c.Name).String()
+	}
+	for _, c := range domainChecksDiff(from, to) {
+		switch c := c.(type) {
+		case *schema.DropCheck:
+			s.append(&migrate.Change{
+				Cmd:     dropCheck(c.C),
+				Source:  modify,
+				Comment: comment,
+				Reverse: addCheck(c.C),
+			})
+		case *schema.ModifyCheck:
+			s.append(
+				&migrate.Change{
+					Cmd:     dropCheck(c.From),
+					Source:  modify,
+					Comment: comment,
+					Reverse: addCheck(c.From),
+				},
+				&migrate.Change{
+					Cmd:     addCheck(c.To),
+					Source:  modify,
+					Comment: comment,
+					Reverse: dropCheck(c.To),
+				},
+			)
+		case *schema.AddCheck:
+			s.append(&migrate.Change{
+				Cmd:     addCheck(c.C),
+				Source:  modify,
+				Comment: comment,
+				Reverse: dropCheck(c.C),
+			})
+		}
+	}
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		fromC, toC, err := commentChange(change)
+		if err != nil {
+			return err
+		}
+		s.append(s.domainComment(modify, to, toC, fromC))
+	}
+	return nil
+}
+
+func (s *state) domainComment(src schema.Change, d *DomainType, to, from string) *migrate.Change {
+	b := s.Build("COMMENT ON DOMAIN").P(s.domainIdent(d)).P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to domain type: %q", d.T),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}
+}
+
+// addRange builds and executes the query for creating a range type.
+func (s *state) addRange(add *schema.AddObject, r *RangeType) error {
+	if r.Subtype == nil {
+		return fmt.Errorf("missing subtype for range type %q", r.T)
+	}
+	f, err := s.formatType(r.Subtype)
+	if err != nil {
+		return fmt.Errorf("format subtype of range type %q: %w", r.T, err)
+	}
+	s.append(&migrate.Change{
+		Cmd: s.Build("CREATE TYPE").P(s.rangeIdent(r), "AS RANGE").Wrap(func(b *sqlx.Builder) {
+			b.P("SUBTYPE =", f)
+		}).String(),
+		Source:  add,
+		Comment: fmt.Sprintf("create range type %q", r.T),
+		Reverse: s.Build("DROP TYPE").P(s.rangeIdent(r)).String(),
+	})
+	if c := (schema.Comment{}); sqlx.Has(r.Attrs, &c) && c.Text != "" {
+		s.append(s.typeComment(add, s.rangeIdent(r), r.T, c.Text, ""))
+	}
+	return nil
+}
+
+// dropRange builds and executes the query for dropping a range type.
+func (s *state) dropRange(drop *schema.DropObject, r *RangeType) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addRange(&schema.AddObject{O: r}, r); err != nil {
+		return fmt.Errorf("calculate reverse for drop range type %q: %w", r.T, err)
+	}
+	s.append(&migrate.Change{
+		Cmd:     s.dropType(drop, s.rangeIdent(r)),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop range type %q", r.T),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyRange alters the comment of a range type. Changing the
+// subtype of a range type is not supported by PostgreSQL.
+func (s *state) modifyRange(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*RangeType)
+	to, ok2 := modify.To.(*RangeType)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unexpected range type modification: (%T, %T)", modify.From, modify.To)
+	}
+	if changed, err := funcTypeChanged(from.Subtype, to.Subtype, s.schema); err != nil {
+		return err
+	} else if changed {
+		return fmt.Errorf("changing the subtype of range type %q is not supported", to.T)
+	}
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		fromC, toC, err := commentChange(change)
+		if err != nil {
+			return err
+		}
+		s.append(s.typeComment(modify, s.rangeIdent(to), to.T, toC, fromC))
+	}
+	return nil
+}
+
+// addComposite builds and executes the query for creating a composite type.
+func (s *state) addComposite(add *schema.AddObject, c *CompositeType) error {
+	b := s.Build("CREATE TYPE").P(s.compositeIdent(c), "AS")
+	if err := b.WrapErr(func(b *sqlx.Builder) error {
+		return b.MapCommaErr(c.Fields, func(i int, b *sqlx.Builder) error {
+			return s.compositeField(b, c.Fields[i])
+		})
+	}); err != nil {
+		return fmt.Errorf("format fields of composite type %q: %w", c.T, err)
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  add,
+		Comment: fmt.Sprintf("create composite type %q", c.T),
+		Reverse: s.Build("DROP TYPE").P(s.compositeIdent(c)).String(),
+	})
+	if cm := (schema.Comment{}); sqlx.Has(c.Attrs, &cm) && cm.Text != "" {
+		s.append(s.typeComment(add, s.compositeIdent(c), c.T, cm.Text, ""))
+	}
+	return nil
+}
+
+// dropComposite builds and executes the query for dropping a composite type.
+func (s *state) dropComposite(drop *schema.DropObject, c *CompositeType) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addComposite(&schema.AddObject{O: c}, c); err != nil {
+		return fmt.Errorf("calculate reverse for drop composite type %q: %w", c.T, err)
+	}
+	s.append(&migrate.Change{
+		Cmd:     s.dropType(drop, s.compositeIdent(c)),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop composite type %q", c.T),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyComposite adds, drops and alters the fields (attributes)
+// of a composite type, and modifies its comment.
+func (s *state) modifyComposite(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*CompositeType)
+	to, ok2 := modify.To.(*CompositeType)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unexpected composite type modification: (%T, %T)", modify.From, modify.To)
+	}
+	changes, err := (&diff{s.conn}).fieldsDiff(from, to)
+	if err != nil {
+		return err
+	}
+	if len(changes) > 0 {
+		var (
+			name  = s.compositeIdent(to)
+			b, rb = s.Build("ALTER TYPE").P(name), s.Build("ALTER TYPE").P(name)
+		)
+		addF := func(b *sqlx.Builder, f *schema.Column) error {
+			b.P("ADD ATTRIBUTE")
+			return s.compositeField(b, f)
+		}
+		dropF := func(b *sqlx.Builder, f *schema.Column) error {
+			b.P("DROP ATTRIBUTE").Ident(f.Name)
+			return nil
+		}
+		alterF := func(b *sqlx.Builder, f *schema.Column) error {
+			b.P("ALTER ATTRIBUTE").Ident(f.Name).P("TYPE")
+			f1 := *f
+			f1.Name = ""
+			return s.compositeField(b, &f1)
+		}
+		if err := b.MapCommaErr(changes, func(i int, b *sqlx.Builder) error {
+			switch c := changes[i].(type) {
+			case *schema.DropColumn:
+				return dropF(b, c.C)
+			case *schema.ModifyColumn:
+				return alterF(b, c.To)
+			case *schema.AddColumn:
+				return addF(b, c.C)
+			}
+			return fmt.Errorf("unexpected composite field change: %T", changes[i])
+		}); err != nil {
+			return fmt.Errorf("format fields of composite type %q: %w", to.T, err)
+		}
+		if err := rb.MapCommaErr(changes, func(i int, b *sqlx.Builder) error {
+			switch c := changes[i].(type) {
+			case *schema.DropColumn:
+				return addF(b, c.C)
+			case *schema.ModifyColumn:
+				return alterF(b, c.From)
+			case *schema.AddColumn:
+				return dropF(b, c.C)
+			}
+			return fmt.Errorf("unexpected composite field change: %T", changes[i])
+		}); err != nil {
+			return fmt.Errorf("format fields of composite type %q: %w", from.T, err)
+		}
+		s.append(&migrate.Change{
+			Cmd:     b.String(),
+			Source:  modify,
+			Comment: fmt.Sprintf("modify composite type %q", to.T),
+			Reverse: rb.String(),
+		})
+	}
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		fromC, toC, err := commentChange(change)
+		if err != nil {
+			return err
+		}
+		s.append(s.typeComment(modify, s.compositeIdent(to), to.T, toC, fromC))
+	}
+	return nil
+}
+
+// compositeField writes the definition of a composite type field to the builder.
+// The field name is omitted if it is empty, e.g., in ALTER ATTRIBUTE clauses.
+func (s *state) compositeField(b *sqlx.Builder, f *schema.Column) error {
+	if f.Type == nil || f.Type.Type == nil {
+		return fmt.Errorf("missing type for field %q", f.Name)
+	}
+	t, err := s.formatType(f.Type.Type)
+	if err != nil {
+		return err
+	}
+	if f.Name != "" {
+		b.Ident(f.Name)
+	}
+	b.P(t)
+	if collate := (schema.Collation{}); sqlx.Has(f.Attrs, &collate) {
+		b.P("COLLATE").Ident(collate.V)
+	}
+	return nil
+}
+
+// dropType returns the DROP TYPE statement for the given type identifier.
+func (s *state) dropType(drop *schema.DropObject, ident string) string {
+	b := s.Build("DROP TYPE")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	b.P(ident)
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	return b.String()
+}
+
+func (s *state) typeComment(src schema.Change, ident, name, to, from string) *migrate.Change {
+	b := s.Build("COMMENT ON TYPE").P(ident).P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to type: %q", name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}
+}
+
+// addAggregate builds and executes the query for creating an aggregate.
+func (s *state) addAggregate(src schema.Change, a *Aggregate) error {
+	if a.StateFunc == nil || a.StateType == nil {
+		return fmt.Errorf("missing state function or state type for aggregate %q", a.Name)
+	}
+	b, err := s.aggregateSig(s.Build("CREATE AGGREGATE"), a)
+	if err != nil {
+		return err
+	}
+	st, err := s.formatType(a.StateType)
+	if err != nil {
+		return fmt.Errorf("format state type of aggregate %q: %w", a.Name, err)
+	}
+	b.Wrap(func(b *sqlx.Builder) {
+		b.P("SFUNC =")
+		s.funcIdent(b, a.StateFunc)
+		b.Comma().P("STYPE =", st)
+		if a.FinalFunc != nil {
+			b.Comma().P("FINALFUNC =")
+			s.funcIdent(b, a.FinalFunc)
+		}
+		if a.InitCond != "" {
+			b.Comma().P("INITCOND =", quote(a.InitCond))
+		}
+		if p := aggregateParallel(a); p != AggregateParallelUnsafe {
+			b.Comma().P("PARALLEL =", p)
+		}
+	})
+	drop, err := s.aggregateSig(s.Build("DROP AGGREGATE"), a)
+	if err != nil {
+		return err
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  src,
+		Comment: fmt.Sprintf("create aggregate %q", a.Name),
+		Reverse: drop.String(),
+	})
+	if c := (schema.Comment{}); sqlx.Has(a.Attrs, &c) && c.Text != "" {
+		change, err := s.aggregateComment(src, a, c.Text, "")
+		if err != nil {
+			return err
+		}
+		s.append(change)
+	}
+	return nil
+}
+
+// dropAggregate builds and executes the query for dropping an aggregate.
+func (s *state) dropAggregate(drop *schema.DropObject, a *Aggregate) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addAggregate(drop, a); err != nil {
+		return fmt.Errorf("calculate reverse for drop aggregate %q: %w", a.Name, err)
+	}
+	b := s.Build("DROP AGGREGATE")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	b, err := s.aggregateSig(b, a)
+	if err != nil {
+		return err
+	}
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop aggregate %q", a.Name),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifyAggregate recreates the aggregate in case its definition was changed, as
+// ALTER AGGREGATE supports only changing the name, owner or schema of aggregates.
+func (s *state) modifyAggregate(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*Aggregate)
+	to, ok2 := modify.To.(*Aggregate)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unexpected aggregate modification: (%T, %T)", modify.From, modify.To)
+	}
+	changed, err := aggregateChanged(from, to, s.schema)
+	if err != nil {
+		return err
+	}
+	if changed {
+		// Recreating the aggregate also sets its comment.
+		if err := s.dropAggregate(&schema.DropObject{O: from}, from); err != nil {
+			return err
+		}
+		return s.addAggregate(modify, to)
+	}
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		fromC, toC, err := commentChange(change)
+		if err != nil {
+			return err
+		}
+		change, err := s.aggregateComment(modify, to, toC, fromC)
+		if err != nil {
+			return err
 		}
+		s.append(change)
 	}
 	return nil
 }
 
-// alterType appends the clause(s) to alter the column type and assuming the
-// "ALTER COLUMN <Name>" was called before by the alterColumn function.
-func (s *state) alterType(b *sqlx.Builder, alter *changeGroup, t *schema.Table, c *schema.ModifyColumn) error {
-	// Commands for creating and dropping serial sequences.
-	createDropSeq := func(st *SerialType) (string, string, string) {
-		seq := fmt.Sprintf(`%s%q`, s.schemaPrefix(t.Schema), st.sequence(t, c.To))
-		drop := s.Build("DROP SEQUENCE IF EXISTS").P(seq).String()
-		create := s.Build("CREATE SEQUENCE IF NOT EXISTS").P(seq, "OWNED BY").
-			P(fmt.Sprintf(`%s%q.%q`, s.schemaPrefix(t.Schema), t.Name, c.To.Name)).
-			String()
-		return create, drop, seq
+func (s *state) aggregateComment(src schema.Change, a *Aggregate, to, from string) (*migrate.Change, error) {
+	b, err := s.aggregateSig(s.Build("COMMENT ON AGGREGATE"), a)
+	if err != nil {
+		return nil, err
 	}
-	toS, toHas := c.To.Type.Type.(*SerialType)
-	fromS, fromHas := c.From.Type.Type.(*SerialType)
-	switch {
-	// Sequence was dropped.
-	case fromHas && !toHas:
-		b.P("DROP DEFAULT")
-		create, drop, _ := createDropSeq(fromS)
-		// Sequence should be deleted after it was dropped
-		// from the DEFAULT value.
-		alter.after = append(alter.after, &migrate.Change{
-			Source:  c,
-			Comment: fmt.Sprintf("drop sequence used by serial column %q", c.From.Name),
-			Cmd:     drop,
-			Reverse: create,
-		})
-		toT, err := FormatType(c.To.Type.Type)
+	b.P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to aggregate: %q", a.Name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}, nil
+}
+
+// aggregateSig writes the aggregate identifier and its argument types to the builder.
+// Aggregates without arguments are written with the "*" placeholder (e.g., count(*)).
+func (s *state) aggregateSig(b *sqlx.Builder, a *Aggregate) (*sqlx.Builder, error) {
+	if len(a.Args) == 0 {
+		return b.P(s.typeIdent(a.Schema, a.Name), "(*)"), nil
+	}
+	args := make([]string, len(a.Args))
+	for i, arg := range a.Args {
+		t, err := s.formatType(arg.Type)
 		if err != nil {
+			return nil, fmt.Errorf("format type of argument %q of aggregate %q: %w", arg.Name, a.Name, err)
+		}
+		if funcArgMode(arg) == schema.FuncArgModeVariadic {
+			t = string(schema.FuncArgModeVariadic) + " " + t
+		}
+		args[i] = t
+	}
+	return b.P(s.typeIdent(a.Schema, a.Name), "("+strings.Join(args, ", ")+")"), nil
+}
+
+// funcIdent writes the identifier of a referenced function to the builder. Functions that are
+// managed by the realm follow the schema qualifier of the plan, and others are kept as defined.
+func (s *state) funcIdent(b *sqlx.Builder, f *schema.Func) {
+	if f.Schema != nil && slices.Contains(f.Schema.Funcs, f) {
+		b.Func(f)
+		return
+	}
+	name := strconv.Quote(f.Name)
+	if f.Schema != nil && f.Schema.Name != "" {
+		name = strconv.Quote(f.Schema.Name) + "." + name
+	}
+	b.P(name)
+}
+
+// addSequence builds and executes the query for creating a sequence.
+func (s *state) addSequence(add *schema.AddObject, seq *Sequence) error {
+	b := s.Build("CREATE SEQUENCE")
+	if sqlx.Has(add.Extra, &schema.IfNotExists{}) {
+		b.P("IF NOT EXISTS")
+	}
+	b.P(s.seqIdent(seq))
+	if seq.Type != nil {
+		if changed, err := funcTypeChanged(seqType(&Sequence{}), seq.Type, ""); err != nil {
 			return err
+		} else if changed {
+			f, err := s.formatType(seq.Type)
+			if err != nil {
+				return fmt.Errorf("format type of sequence %q: %w", seq.Name, err)
+			}
+			b.P("AS", f)
 		}
-		fromT, err := FormatType(fromS.IntegerType())
+	}
+	if seq.Increment != 0 && seq.Increment != defaultSeqIncrement {
+		b.P("INCREMENT BY", strconv.FormatInt(seq.Increment, 10))
+	}
+	if seq.Min != nil {
+		b.P("MINVALUE", strconv.FormatInt(*seq.Min, 10))
+	}
+	if seq.Max != nil {
+		b.P("MAXVALUE", strconv.FormatInt(*seq.Max, 10))
+	}
+	if seq.Start != 0 {
+		b.P("START WITH", strconv.FormatInt(seq.Start, 10))
+	}
+	if seq.Cache > 1 {
+		b.P("CACHE", strconv.FormatInt(seq.Cache, 10))
+	}
+	if seq.Cycle {
+		b.P("CYCLE")
+	}
+	if seq.Owner.T != nil && seq.Owner.C != nil {
+		b.P("OWNED BY", s.seqOwnerIdent(seq))
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  add,
+		Comment: fmt.Sprintf("create sequence %q", seq.Name),
+		Reverse: s.Build("DROP SEQUENCE").P(s.seqIdent(seq)).String(),
+	})
+	if c := (schema.Comment{}); sqlx.Has(seq.Attrs, &c) && c.Text != "" {
+		s.append(s.sequenceComment(add, seq, c.Text, ""))
+	}
+	return nil
+}
+
+// dropSequence builds and executes the query for dropping a sequence.
+func (s *state) dropSequence(drop *schema.DropObject, seq *Sequence) error {
+	rs := &state{conn: s.conn, PlanOptions: s.PlanOptions}
+	if err := rs.addSequence(&schema.AddObject{O: seq}, seq); err != nil {
+		return fmt.Errorf("calculate reverse for drop sequence %q: %w", seq.Name, err)
+	}
+	b := s.Build("DROP SEQUENCE")
+	if sqlx.Has(drop.Extra, &schema.IfExists{}) {
+		b.P("IF EXISTS")
+	}
+	b.P(s.seqIdent(seq))
+	if sqlx.Has(drop.Extra, &Cascade{}) {
+		b.P("CASCADE")
+	}
+	s.append(&migrate.Change{
+		Cmd:     b.String(),
+		Source:  drop,
+		Comment: fmt.Sprintf("drop sequence %q", seq.Name),
+		Reverse: rs.stmts(),
+	})
+	return nil
+}
+
+// modifySequence alters the sequence options that were changed, as
+// PostgreSQL allows modifying sequences without recreating them.
+func (s *state) modifySequence(modify *schema.ModifyObject) error {
+	from, ok1 := modify.From.(*Sequence)
+	to, ok2 := modify.To.(*Sequence)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("unexpected sequence modification: (%T, %T)", modify.From, modify.To)
+	}
+	changed, err := seqChanged(from, to, s.schema)
+	if err != nil {
+		return err
+	}
+	if changed {
+		cmd, err := s.alterSequence(from, to)
 		if err != nil {
 			return err
 		}
-		// Underlying type was changed. e.g. serial to bigint.
-		if toT != fromT {
-			b.Comma().P("ALTER COLUMN").Ident(c.To.Name).P("TYPE", toT)
-		}
-	// Sequence was added.
-	case !fromHas && toHas:
-		create, drop, seq := createDropSeq(toS)
-		// Sequence should be created before it is used by the
-		// column DEFAULT value.
-		alter.before = append(alter.before, &migrate.Change{
-			Source:  c,
-			Comment: fmt.Sprintf("create sequence for serial column %q", c.To.Name),
-			Cmd:     create,
-			Reverse: drop,
-		})
-		b.P("SET DEFAULT", fmt.Sprintf("nextval('%s')", seq))
-		toT, err := FormatType(toS.IntegerType())
+		reverse, err := s.alterSequence(to, from)
 		if err != nil {
 			return err
 		}
-		fromT, err := FormatType(c.From.Type.Type)
+		s.append(&migrate.Change{
+			Cmd:     cmd,
+			Source:  modify,
+			Comment: fmt.Sprintf("modify sequence %q", to.Name),
+			Reverse: reverse,
+		})
+	}
+	if change := sqlx.CommentDiff(from.Attrs, to.Attrs); change != nil {
+		fromC, toC, err := commentChange(change)
 		if err != nil {
 			return err
 		}
-		// Underlying type was changed. e.g. integer to bigserial (bigint).
-		if toT != fromT {
-			b.Comma().P("ALTER COLUMN").Ident(c.To.Name).P("TYPE", toT)
-		}
-	// Serial type was changed. e.g. serial to bigserial.
-	case fromHas && toHas:
-		f, err := FormatType(toS.IntegerType())
+		s.append(s.sequenceComment(modify, to, toC, fromC))
+	}
+	return nil
+}
+
+// alterSequence returns the ALTER SEQUENCE statement for migrating
+// the options of the sequence from one state to the other.
+func (s *state) alterSequence(from, to *Sequence) (string, error) {
+	b := s.Build("ALTER SEQUENCE").P(s.seqIdent(to))
+	if changed, err := funcTypeChanged(seqType(from), seqType(to), s.schema); err != nil {
+		return "", err
+	} else if changed {
+		f, err := s.formatType(seqType(to))
 		if err != nil {
-			return err
+			return "", fmt.Errorf("format type of sequence %q: %w", to.Name, err)
 		}
-		b.P("TYPE", f)
+		b.P("AS", f)
+	}
+	if i := seqIncrement(to); seqIncrement(from) != i {
+		b.P("INCREMENT BY", strconv.FormatInt(i, 10))
+	}
+	min1, max1 := seqMinMax(from)
+	min2, max2 := seqMinMax(to)
+	switch {
+	case min1 == min2:
+	case to.Min == nil:
+		b.P("NO MINVALUE")
 	default:
-		var (
-			f   string
-			err error
-		)
-		if e, ok := c.To.Type.Type.(*schema.EnumType); ok {
-			f = s.enumIdent(e)
-		} else if f, err = FormatType(c.To.Type.Type); err != nil {
-			return err
+		b.P("MINVALUE", strconv.FormatInt(min2, 10))
+	}
+	switch {
+	case max1 == max2:
+	case to.Max == nil:
+		b.P("NO MAXVALUE")
+	default:
+		b.P("MAXVALUE", strconv.FormatInt(max2, 10))
+	}
+	if v := seqStart(to); seqStart(from) != v {
+		b.P("START WITH", strconv.FormatInt(v, 10))
+	}
+	if c := seqCache(to); seqCache(from) != c {
+		b.P("CACHE", strconv.FormatInt(c, 10))
+	}
+	switch {
+	case from.Cycle == to.Cycle:
+	case to.Cycle:
+		b.P("CYCLE")
+	default:
+		b.P("NO CYCLE")
+	}
+	switch {
+	case !seqOwnerChanged(from, to):
+	case to.Owner.C == nil:
+		b.P("OWNED BY NONE")
+	default:
+		b.P("OWNED BY", s.seqOwnerIdent(to))
+	}
+	return b.String(), nil
+}
+
+func (s *state) sequenceComment(src schema.Change, seq *Sequence, to, from string) *migrate.Change {
+	b := s.Build("COMMENT ON SEQUENCE").P(s.seqIdent(seq)).P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to sequence: %q", seq.Name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}
+}
+
+func (s *state) seqIdent(seq *Sequence) string {
+	return s.typeIdent(seq.Schema, seq.Name)
+}
+
+// seqOwnerIdent returns the qualified identifier of the column that owns the sequence.
+func (s *state) seqOwnerIdent(seq *Sequence) string {
+	return fmt.Sprintf("%s%q.%q", s.schemaPrefix(seq.Owner.T.Schema), seq.Owner.T.Name, seq.Owner.C.Name)
+}
+
+// detachSeqOwners breaks the cycle between sequences and the tables that own them
+// and use them in their column defaults. Such sequences are created without an
+// owner, and their ownership is set after the owner tables are created or modified.
+func detachSeqOwners(changes []schema.Change) []schema.Change {
+	var deferred []schema.Change
+	for i, c := range changes {
+		add, ok := c.(*schema.AddObject)
+		if !ok {
+			continue
 		}
-		b.P("TYPE", f)
+		seq, ok := add.O.(*Sequence)
+		if !ok || seq.Owner.T == nil || !seq.usedBy(seq.Owner.T, seq.Owner.T.Columns...) {
+			continue
+		}
+		if !slices.ContainsFunc(changes, func(c schema.Change) bool {
+			switch c := c.(type) {
+			case *schema.AddTable:
+				return sqlx.SameTable(c.T, seq.Owner.T)
+			case *schema.ModifyTable:
+				return sqlx.SameTable(c.T, seq.Owner.T)
+			}
+			return false
+		}) {
+			continue
+		}
+		detached := *seq
+		detached.Owner.T, detached.Owner.C = nil, nil
+		changes[i] = &schema.AddObject{O: &detached, Extra: add.Extra}
+		deferred = append(deferred, &schema.ModifyObject{From: &detached, To: seq})
 	}
-	if collate := (schema.Collation{}); sqlx.Has(c.To.Attrs, &collate) {
-		b.P("COLLATE").Ident(collate.V)
+	return append(changes, deferred...)
+}
+
+// recreateDependentViews drops and recreates the views that depend on tables whose columns
+// are dropped or change their types, as PostgreSQL rejects altering columns used by views.
+// Views that depend on the recreated views are recreated as well. Dependencies are taken
+// from both the current state (if attached to the change) and the desired state.
+func recreateDependentViews(changes []schema.Change) []schema.Change {
+	var (
+		recreate []*schema.View
+		desired  []*schema.Schema
+		affected = func(v *schema.View) bool {
+			return slices.ContainsFunc(v.Deps, func(o schema.Object) bool {
+				switch o := o.(type) {
+				case *schema.Table:
+					return slices.ContainsFunc(changes, func(c schema.Change) bool {
+						m, ok := c.(*schema.ModifyTable)
+						return ok && sqlx.SameTable(m.T, o) && slices.ContainsFunc(m.Changes, func(c schema.Change) bool {
+							switch c := c.(type) {
+							case *schema.DropColumn:
+								return true
+							case *schema.ModifyColumn:
+								return c.Change.Is(schema.ChangeType)
+							}
+							return false
+						})
+					})
+				case *schema.View:
+					return slices.ContainsFunc(recreate, func(v *schema.View) bool { return sqlx.SameView(v, o) })
+				}
+				return false
+			})
+		}
+	)
+	for _, c := range changes {
+		m, ok := c.(*schema.ModifyTable)
+		if !ok || m.T.Schema == nil {
+			continue
+		}
+		schemas := realmSchemas(m.T.Schema)
+		desired = append(desired, schemas...)
+		// Views of the current state come first, as they are the ones that exist
+		// in the database, and block the changes. i.e., these are to be dropped.
+		if cur := (CurrentTable{}); sqlx.Has(m.Extra, &cur) && cur.T != nil {
+			schemas = append(realmSchemas(cur.T.Schema), schemas...)
+		}
+		// Repeat until no new views are found, as views might depend on other views.
+		for found := true; found; {
+			found = false
+			for _, s := range schemas {
+				for _, v := range s.Views {
+					if !slices.ContainsFunc(recreate, func(r *schema.View) bool { return sqlx.SameView(r, v) }) && affected(v) {
+						recreate, found = append(recreate, v), true
+					}
+				}
+			}
+		}
 	}
-	if using := (ConvertUsing{}); sqlx.Has(c.Extra, &using) {
-		b.P("USING", using.X)
+	for _, v := range recreate {
+		i := slices.IndexFunc(changes, func(c schema.Change) bool {
+			switch c := c.(type) {
+			case *schema.AddView:
+				return sqlx.SameView(c.V, v)
+			case *schema.DropView:
+				return sqlx.SameView(c.V, v)
+			case *schema.ModifyView:
+				return sqlx.SameView(c.To, v)
+			}
+			return false
+		})
+		switch {
+		case i == -1:
+			// The view is recreated with its definition in the desired state.
+			to := v
+			for _, s := range desired {
+				if dv, ok := s.View(v.Name); ok && sqlx.SameView(dv, v) {
+					to = dv
+					break
+				}
+			}
+			changes = append(changes, &schema.DropView{V: v}, &schema.AddView{V: to})
+			// Triggers are dropped along with the view.
+			for _, t := range to.Triggers {
+				if !slices.ContainsFunc(changes, func(c schema.Change) bool {
+					switch c := c.(type) {
+					case *schema.AddTrigger:
+						return c.T.Name == t.Name && sqlx.SameView(c.T.View, to)
+					case *schema.ModifyTrigger:
+						return c.To.Name == t.Name && sqlx.SameView(c.To.View, to)
+					case *schema.DropTrigger:
+						return c.T.Name == t.Name && sqlx.SameView(c.T.View, to)
+					}
+					return false
+				}) {
+					changes = append(changes, &schema.AddTrigger{T: t})
+				}
+			}
+		default:
+			if m, ok := changes[i].(*schema.ModifyView); ok {
+				changes[i] = &schema.DropView{V: m.From}
+				changes = append(changes, &schema.AddView{V: m.To})
+			}
+		}
 	}
-	return nil
+	return changes
 }
 
-func (s *state) renameTable(c *schema.RenameTable) {
-	s.append(&migrate.Change{
-		Source:  c,
-		Comment: fmt.Sprintf("rename a table from %q to %q", c.From.Name, c.To.Name),
-		Cmd:     s.Build("ALTER TABLE").Table(c.From).P("RENAME TO").Table(c.To).String(),
-		Reverse: s.Build("ALTER TABLE").Table(c.To).P("RENAME TO").Table(c.From).String(),
-	})
+// realmSchemas returns the schemas of the realm the given schema belongs to.
+func realmSchemas(s *schema.Schema) []*schema.Schema {
+	switch {
+	case s == nil:
+		return nil
+	case s.Realm != nil:
+		return s.Realm.Schemas
+	default:
+		return []*schema.Schema{s}
+	}
+}
+
+// viewDef writes the view identifier, its columns and its definition to the builder.
+func (s *state) viewDef(b *sqlx.Builder, v *schema.View) *sqlx.Builder {
+	b.View(v)
+	if len(v.Columns) > 0 {
+		b.Wrap(func(b *sqlx.Builder) {
+			b.MapComma(v.Columns, func(i int, b *sqlx.Builder) {
+				b.Ident(v.Columns[i].Name)
+			})
+		})
+	}
+	b.P("AS", sqlx.TrimViewExtra(v.Def))
+	if c := viewCheckOption(v); !v.Materialized() && c.V != schema.ViewCheckOptionNone {
+		b.P("WITH", c.V, "CHECK OPTION")
+	}
+	return b
+}
+
+// viewColumnsChanged reports if the columns of the view were renamed, reordered or changed their types.
+func (s *state) viewColumnsChanged(from, to *schema.View) bool {
+	// Columns are unknown in case the view was not normalized.
+	if len(to.Columns) == 0 {
+		return false
+	}
+	return len(from.Columns) != len(to.Columns) || !s.viewReplaceable(from, to)
+}
+
+// viewReplaceable reports if the view can be replaced using the CREATE OR REPLACE
+// command. PostgreSQL requires the new definition to generate the same columns with
+// the same names and types in the same order, but allows adding new columns at the end.
+func (s *state) viewReplaceable(from, to *schema.View) bool {
+	if len(to.Columns) == 0 {
+		return true
+	}
+	if len(from.Columns) > len(to.Columns) {
+		return false
+	}
+	for i, c1 := range from.Columns {
+		c2 := to.Columns[i]
+		if c1.Name != c2.Name {
+			return false
+		}
+		if changed, err := typeChanged(c1, c2, s.schema); err != nil || changed {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *state) addViewComments(src schema.Change, v *schema.View) {
+	var c schema.Comment
+	if sqlx.Has(v.Attrs, &c) && c.Text != "" {
+		s.append(s.viewComment(src, v, c.Text, ""))
+	}
+	for i := range v.Columns {
+		if sqlx.Has(v.Columns[i].Attrs, &c) && c.Text != "" {
+			s.append(s.viewColumnComment(src, v, v.Columns[i], c.Text, ""))
+		}
+	}
+	for i := range v.Indexes {
+		if sqlx.Has(v.Indexes[i].Attrs, &c) && c.Text != "" {
+			s.append(s.indexComment(src, v.AsTable(), v.Indexes[i], c.Text, ""))
+		}
+	}
 }
 
 func (s *state) addComments(src schema.Change, t *schema.Table) {
@@ -860,6 +2781,27 @@ func (s *state) indexComment(src schema.Change, t *schema.Table, idx *schema.Ind
 	}
 }
 
+func (s *state) viewComment(src schema.Change, v *schema.View, to, from string) *migrate.Change {
+	b := s.Build("COMMENT ON", viewType(v)).View(v).P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to %s: %q", strings.ToLower(viewType(v)), v.Name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}
+}
+
+func (s *state) viewColumnComment(src schema.Change, v *schema.View, c *schema.Column, to, from string) *migrate.Change {
+	b := s.Build("COMMENT ON COLUMN").ViewResource(v, c)
+	b.P("IS")
+	return &migrate.Change{
+		Cmd:     b.Clone().P(quote(to)).String(),
+		Source:  src,
+		Comment: fmt.Sprintf("set comment to column: %q on %s: %q", c.Name, strings.ToLower(viewType(v)), v.Name),
+		Reverse: b.Clone().P(quote(from)).String(),
+	}
+}
+
 func (s *state) dropIndexes(src schema.Change, t *schema.Table, drops ...*schema.DropIndex) error {
 	adds := make([]*schema.AddIndex, len(drops))
 	for i, d := range drops {
@@ -891,7 +2833,7 @@ func (s *state) alterEnum(modify *schema.ModifyObject) error {
 		fromV[v] = i
 	}
 	toV := make(map[string]int, len(to.Values))
-	for i, v := range from.Values {
+	for i, v := range to.Values {
 		toV[v] = i
 	}
 	for v := range fromV {
@@ -1197,6 +3139,19 @@ func (s *state) append(c ...*migrate.Change) {
 	s.Changes = append(s.Changes, c...)
 }
 
+// stmts returns the statements of the planned changes. A single
+// string is returned in case there is only one statement.
+func (s *state) stmts() any {
+	cmd := make([]string, len(s.Changes))
+	for i, c := range s.Changes {
+		cmd[i] = c.Cmd
+	}
+	if len(cmd) == 1 {
+		return cmd[0]
+	}
+	return cmd
+}
+
 // Build instantiates a new builder and writes the given phrase to it.
 func (s *state) Build(phrases ...string) *sqlx.Builder {
 	return (*Driver).StmtBuilder(nil, s.PlanOptions).
@@ -1308,6 +3263,10 @@ func (s *state) compositeIdent(c *CompositeType) string {
 	return s.typeIdent(c.Schema, c.T)
 }
 
+func (s *state) rangeIdent(r *RangeType) string {
+	return s.typeIdent(r.Schema, r.T)
+}
+
 func (s *state) typeIdent(ns *schema.Schema, name string) string {
 	switch {
 	// In case the plan uses a specific schema qualifier.
@@ -1344,6 +3303,10 @@ func (s *state) formatType(t schema.Type) (string, error) {
 		return s.domainIdent(t), nil
 	case *CompositeType:
 		return s.compositeIdent(t), nil
+	case *RangeType:
+		if t.Schema != nil {
+			return s.rangeIdent(t), nil
+		}
 	case *ArrayType:
 		switch t := t.Type.(type) {
 		case *schema.EnumType:
@@ -1352,11 +3315,23 @@ func (s *state) formatType(t schema.Type) (string, error) {
 			return s.domainIdent(t) + "[]", nil
 		case *CompositeType:
 			return s.compositeIdent(t) + "[]", nil
+		case *RangeType:
+			if t.Schema != nil {
+				return s.rangeIdent(t) + "[]", nil
+			}
 		}
 	}
 	return FormatType(t)
 }
 
+// viewType returns the type of the view as used in SQL statements.
+func viewType(v *schema.View) string {
+	if v.Materialized() {
+		return "MATERIALIZED VIEW"
+	}
+	return "VIEW"
+}
+
 func pkName(t *schema.Table, pk *schema.Index) string {
 	if pk.Name != "" {
 		return pk.Name
@@ -1847,14 +1847,968 @@ func TestPlanChanges(t *testing.T) {
 				},
 			},
 		},
-		// Adding view is not supported in OSS.
 		{
 			changes: []schema.Change{
 				&schema.AddView{
-					V: schema.NewView("v1", "SELECT * FROM users"),
+					V: schema.NewView("v1", "SELECT id FROM users").
+						SetSchema(schema.New("public")).
+						AddColumns(schema.NewIntColumn("id", "integer").SetComment("user id")).
+						SetCheckOption(schema.ViewCheckOptionLocal).
+						SetComment("users view"),
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE VIEW "public"."v1" ("id") AS SELECT id FROM users WITH LOCAL CHECK OPTION`,
+						Reverse: `DROP VIEW "public"."v1"`,
+					},
+					{
+						Cmd:     `COMMENT ON VIEW "public"."v1" IS 'users view'`,
+						Reverse: `COMMENT ON VIEW "public"."v1" IS ''`,
+					},
+					{
+						Cmd:     `COMMENT ON COLUMN "public"."v1"."id" IS 'user id'`,
+						Reverse: `COMMENT ON COLUMN "public"."v1"."id" IS ''`,
+					},
+				},
+			},
+		},
+		{
+			changes: []schema.Change{
+				&schema.AddView{
+					V: func() *schema.View {
+						c := schema.NewIntColumn("id", "integer")
+						return schema.NewMaterializedView("m1", "SELECT id FROM users").
+							AddColumns(c).
+							AddIndexes(schema.NewUniqueIndex("m1_id").AddColumns(c))
+					}(),
+				},
+				&schema.DropView{
+					V:     schema.NewView("v1", "SELECT 1"),
+					Extra: []schema.Clause{&schema.IfExists{}, &Cascade{}},
+				},
+				&schema.RenameView{
+					From: schema.NewMaterializedView("m2", "SELECT 1"),
+					To:   schema.NewMaterializedView("m3", "SELECT 1"),
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `ALTER MATERIALIZED VIEW "m2" RENAME TO "m3"`,
+						Reverse: `ALTER MATERIALIZED VIEW "m3" RENAME TO "m2"`,
+					},
+					{
+						Cmd:     `CREATE MATERIALIZED VIEW "m1" ("id") AS SELECT id FROM users`,
+						Reverse: `DROP MATERIALIZED VIEW "m1"`,
+					},
+					{
+						Cmd:     `CREATE UNIQUE INDEX "m1_id" ON "m1" ("id")`,
+						Reverse: `DROP INDEX "m1_id"`,
+					},
+					{
+						Cmd:     `DROP VIEW IF EXISTS "v1" CASCADE`,
+						Reverse: `CREATE VIEW "v1" AS SELECT 1`,
+					},
+				},
+			},
+		},
+		// Views with compatible columns are replaced.
+		{
+			changes: []schema.Change{
+				&schema.ModifyView{
+					From: schema.NewView("v1", "SELECT id FROM users").
+						AddColumns(schema.NewIntColumn("id", "integer")),
+					To: schema.NewView("v1", "SELECT id, name FROM users").
+						AddColumns(schema.NewIntColumn("id", "integer"), schema.NewStringColumn("name", "text")).
+						SetComment("users view"),
+					Changes: []schema.Change{
+						&schema.AddAttr{A: &schema.Comment{Text: "users view"}},
+					},
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE OR REPLACE VIEW "v1" ("id", "name") AS SELECT id, name FROM users`,
+						Reverse: []string{`DROP VIEW "v1"`, `CREATE VIEW "v1" ("id") AS SELECT id FROM users`},
+					},
+					{
+						Cmd:     `COMMENT ON VIEW "v1" IS 'users view'`,
+						Reverse: `COMMENT ON VIEW "v1" IS ''`,
+					},
+				},
+			},
+		},
+		// Views with incompatible columns and materialized views are recreated.
+		{
+			changes: []schema.Change{
+				&schema.ModifyView{
+					From: schema.NewView("v1", "SELECT id FROM users").
+						AddColumns(schema.NewIntColumn("id", "integer")),
+					To: schema.NewView("v1", "SELECT id::text FROM users").
+						AddColumns(schema.NewStringColumn("id", "text")),
+				},
+				&schema.ModifyView{
+					From: schema.NewMaterializedView("m1", "SELECT 1"),
+					To:   schema.NewMaterializedView("m1", "SELECT 2"),
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `DROP VIEW "v1"`,
+						Reverse: `CREATE VIEW "v1" ("id") AS SELECT id FROM users`,
+					},
+					{
+						Cmd:     `CREATE VIEW "v1" ("id") AS SELECT id::text FROM users`,
+						Reverse: `DROP VIEW "v1"`,
+					},
+					{
+						Cmd:     `DROP MATERIALIZED VIEW "m1"`,
+						Reverse: `CREATE MATERIALIZED VIEW "m1" AS SELECT 1`,
+					},
+					{
+						Cmd:     `CREATE MATERIALIZED VIEW "m1" AS SELECT 2`,
+						Reverse: `DROP MATERIALIZED VIEW "m1"`,
+					},
+				},
+			},
+		},
+		// Changes to materialized view indexes do not recreate the view.
+		{
+			changes: []schema.Change{
+				func() schema.Change {
+					c := schema.NewIntColumn("id", "integer")
+					from := schema.NewMaterializedView("m1", "SELECT id FROM users").
+						AddColumns(c).
+						AddIndexes(schema.NewIndex("m1_id").AddColumns(c))
+					to := schema.NewMaterializedView("m1", "SELECT id FROM users").
+						AddColumns(c).
+						AddIndexes(schema.NewUniqueIndex("m1_uid").AddColumns(c))
+					return &schema.ModifyView{
+						From: from,
+						To:   to,
+						Changes: []schema.Change{
+							&schema.DropIndex{I: from.Indexes[0]},
+							&schema.AddIndex{I: to.Indexes[0]},
+						},
+					}
+				}(),
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `DROP INDEX "m1_id"`,
+						Reverse: `CREATE INDEX "m1_id" ON "m1" ("id")`,
+					},
+					{
+						Cmd:     `CREATE UNIQUE INDEX "m1_uid" ON "m1" ("id")`,
+						Reverse: `DROP INDEX "m1_uid"`,
+					},
+				},
+			},
+		},
+		// Views that depend on altered columns are dropped and recreated.
+		{
+			changes: func() []schema.Change {
+				from := schema.NewStringColumn("name", "varchar")
+				users := schema.NewTable("users").
+					AddColumns(schema.NewIntColumn("id", "integer"), schema.NewStringColumn("name", "text"))
+				v1 := schema.NewView("v1", "SELECT name FROM users").AddDeps(users)
+				v2 := schema.NewView("v2", "SELECT name FROM v1").AddDeps(v1)
+				v3 := schema.NewView("v3", "SELECT 1")
+				schema.New("public").AddTables(users).AddViews(v1, v2, v3)
+				return []schema.Change{
+					&schema.ModifyTable{
+						T: users,
+						Changes: []schema.Change{
+							&schema.ModifyColumn{From: from, To: users.Columns[1], Change: schema.ChangeType},
+						},
+					},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `DROP VIEW "public"."v2"`,
+						Reverse: `CREATE VIEW "public"."v2" AS SELECT name FROM v1`,
+					},
+					{
+						Cmd:     `DROP VIEW "public"."v1"`,
+						Reverse: `CREATE VIEW "public"."v1" AS SELECT name FROM users`,
+					},
+					{
+						Cmd:     `ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE text`,
+						Reverse: `ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE character varying`,
+					},
+					{
+						Cmd:     `CREATE VIEW "public"."v1" AS SELECT name FROM users`,
+						Reverse: `DROP VIEW "public"."v1"`,
+					},
+					{
+						Cmd:     `CREATE VIEW "public"."v2" AS SELECT name FROM v1`,
+						Reverse: `DROP VIEW "public"."v2"`,
+					},
+				},
+			},
+		},
+		{
+			changes: []schema.Change{
+				&schema.AddFunc{
+					F: &schema.Func{
+						Name:   "add",
+						Schema: schema.New("public"),
+						Args: []*schema.FuncArg{
+							{Name: "a", Type: &schema.IntegerType{T: "integer"}},
+							{Name: "b", Type: &schema.IntegerType{T: "integer"}, Default: &schema.RawExpr{X: "1"}},
+						},
+						Ret:   &schema.IntegerType{T: "integer"},
+						Lang:  FuncLangSQL,
+						Body:  "RETURN a + b",
+						Attrs: []schema.Attr{&FuncVolatility{V: FuncVolatilityImmutable}, &schema.Comment{Text: "sum"}},
+					},
+				},
+				&schema.AddProc{
+					P: &schema.Proc{
+						Name: "reset",
+						Args: []*schema.FuncArg{
+							{Name: "force", Type: &schema.BoolType{T: "boolean"}, Mode: schema.FuncArgModeInOut},
+						},
+						Lang:  FuncLangPLpgSQL,
+						Body:  "BEGIN DELETE FROM t; END;",
+						Attrs: []schema.Attr{&FuncSecurity{V: FuncSecurityDefiner}},
+					},
+				},
+				&schema.DropFunc{
+					F: &schema.Func{
+						Name: "ids",
+						Ret:  &UserDefinedType{T: "SETOF integer"},
+						Args: []*schema.FuncArg{{Name: "id", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeOut}},
+						Lang: FuncLangPLpgSQL,
+						Body: "BEGIN RETURN QUERY SELECT $$1$$; END;",
+					},
+					Extra: []schema.Clause{&schema.IfExists{}, &Cascade{}},
+				},
+				&schema.RenameProc{
+					From: &schema.Proc{Name: "p1", Args: []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: "integer"}}}},
+					To:   &schema.Proc{Name: "p2", Args: []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: "integer"}}}},
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE FUNCTION "public"."add" ("a" integer, "b" integer DEFAULT 1) RETURNS integer LANGUAGE SQL IMMUTABLE RETURN a + b`,
+						Reverse: `DROP FUNCTION "public"."add" (integer, integer)`,
+					},
+					{
+						Cmd:     `COMMENT ON FUNCTION "public"."add" (integer, integer) IS 'sum'`,
+						Reverse: `COMMENT ON FUNCTION "public"."add" (integer, integer) IS ''`,
+					},
+					{
+						Cmd:     `CREATE PROCEDURE "reset" (INOUT "force" boolean) LANGUAGE PLpgSQL SECURITY DEFINER AS $$BEGIN DELETE FROM t; END;$$`,
+						Reverse: `DROP PROCEDURE "reset" (boolean)`,
+					},
+					{
+						Cmd:     `ALTER PROCEDURE "p1" (integer) RENAME TO "p2"`,
+						Reverse: `ALTER PROCEDURE "p2" (integer) RENAME TO "p1"`,
+					},
+					{
+						Cmd:     `DROP FUNCTION IF EXISTS "ids" () CASCADE`,
+						Reverse: `CREATE FUNCTION "ids" (OUT "id" integer) RETURNS SETOF integer LANGUAGE PLpgSQL AS $_$BEGIN RETURN QUERY SELECT $$1$$; END;$_$`,
+					},
+				},
+			},
+		},
+		// Functions with compatible signatures are replaced.
+		{
+			changes: []schema.Change{
+				&schema.ModifyFunc{
+					From: &schema.Func{
+						Name: "f1",
+						Args: []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: "integer"}}},
+						Ret:  &schema.IntegerType{T: "integer"},
+						Lang: FuncLangSQL,
+						Body: "SELECT a",
+					},
+					To: &schema.Func{
+						Name:  "f1",
+						Args:  []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: "integer"}}},
+						Ret:   &schema.IntegerType{T: "integer"},
+						Lang:  FuncLangSQL,
+						Body:  "SELECT a + 1",
+						Attrs: []schema.Attr{&schema.Comment{Text: "next"}},
+					},
+					Changes: []schema.Change{
+						&schema.AddAttr{A: &schema.Comment{Text: "next"}},
+					},
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE OR REPLACE FUNCTION "f1" ("a" integer) RETURNS integer LANGUAGE SQL AS $$SELECT a + 1$$`,
+						Reverse: `CREATE OR REPLACE FUNCTION "f1" ("a" integer) RETURNS integer LANGUAGE SQL AS $$SELECT a$$`,
+					},
+					{
+						Cmd:     `COMMENT ON FUNCTION "f1" (integer) IS 'next'`,
+						Reverse: `COMMENT ON FUNCTION "f1" (integer) IS ''`,
+					},
+				},
+			},
+		},
+		// Functions with changed return type or arguments are recreated.
+		{
+			changes: []schema.Change{
+				&schema.ModifyFunc{
+					From: &schema.Func{
+						Name: "f1",
+						Args: []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: "integer"}}},
+						Ret:  &schema.IntegerType{T: "integer"},
+						Lang: FuncLangSQL,
+						Body: "SELECT a",
+					},
+					To: &schema.Func{
+						Name: "f1",
+						Args: []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: "integer"}}},
+						Ret:  &schema.IntegerType{T: "bigint"},
+						Lang: FuncLangSQL,
+						Body: "SELECT a",
+					},
+				},
+				&schema.ModifyProc{
+					From: &schema.Proc{
+						Name: "p1",
+						Args: []*schema.FuncArg{{Name: "a", Type: &schema.IntegerType{T: "integer"}}},
+						Lang: FuncLangSQL,
+						Body: "SELECT a",
+					},
+					To: &schema.Proc{
+						Name: "p1",
+						Args: []*schema.FuncArg{{Name: "b", Type: &schema.IntegerType{T: "integer"}}},
+						Lang: FuncLangSQL,
+						Body: "SELECT b",
+					},
+				},
+			},
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `DROP FUNCTION "f1" (integer)`,
+						Reverse: `CREATE FUNCTION "f1" ("a" integer) RETURNS integer LANGUAGE SQL AS $$SELECT a$$`,
+					},
+					{
+						Cmd:     `CREATE FUNCTION "f1" ("a" integer) RETURNS bigint LANGUAGE SQL AS $$SELECT a$$`,
+						Reverse: `DROP FUNCTION "f1" (integer)`,
+					},
+					{
+						Cmd:     `DROP PROCEDURE "p1" (integer)`,
+						Reverse: `CREATE PROCEDURE "p1" ("a" integer) LANGUAGE SQL AS $$SELECT a$$`,
+					},
+					{
+						Cmd:     `CREATE PROCEDURE "p1" ("b" integer) LANGUAGE SQL AS $$SELECT b$$`,
+						Reverse: `DROP PROCEDURE "p1" (integer)`,
+					},
+				},
+			},
+		},
+		// Triggers.
+		{
+			changes: func() []schema.Change {
+				var (
+					public = schema.New("public")
+					users  = schema.NewTable("users").SetSchema(public).AddColumns(schema.NewIntColumn("id", "int"), schema.NewStringColumn("name", "text"))
+					audit  = &schema.Func{Name: "audit", Schema: public}
+				)
+				public.AddFuncs(audit)
+				t1 := &schema.Trigger{
+					Name:       "users_audit",
+					Table:      users,
+					ActionTime: schema.TriggerTimeBefore,
+					Events:     []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventUpdateOf(users.Columns[1])},
+					For:        schema.TriggerForRow,
+					Attrs: []schema.Attr{
+						&TriggerWhen{X: "NEW.name IS NOT NULL"},
+						&TriggerFunc{F: audit, Args: []string{"users", "it's"}},
+						&schema.Comment{Text: "audit users"},
+					},
+				}
+				t2 := &schema.Trigger{
+					Name:       "users_truncate",
+					Table:      users,
+					ActionTime: schema.TriggerTimeAfter,
+					Events:     []schema.TriggerEvent{schema.TriggerEventTruncate},
+					For:        schema.TriggerForStmt,
+					Attrs: []schema.Attr{
+						&TriggerFunc{F: &schema.Func{Name: "log_truncate", Schema: schema.New("ext")}},
+					},
+				}
+				t3 := &schema.Trigger{
+					Name:       "users_delete",
+					Table:      users,
+					ActionTime: schema.TriggerTimeAfter,
+					Events:     []schema.TriggerEvent{schema.TriggerEventDelete},
+					Attrs: []schema.Attr{
+						&TriggerFunc{F: audit},
+					},
+				}
+				t3r := &schema.Trigger{Name: "users_remove", Table: users, ActionTime: t3.ActionTime, Events: t3.Events, Attrs: t3.Attrs}
+				t4 := &schema.Trigger{Name: "users_comment", Table: users, ActionTime: t3.ActionTime, Events: t3.Events, Attrs: []schema.Attr{&TriggerFunc{F: audit}, &schema.Comment{Text: "old"}}}
+				t4c := &schema.Trigger{Name: "users_comment", Table: users, ActionTime: t3.ActionTime, Events: t3.Events, Attrs: []schema.Attr{&TriggerFunc{F: audit}, &schema.Comment{Text: "new"}}}
+				t5 := &schema.Trigger{Name: "users_modify", Table: users, ActionTime: t3.ActionTime, Events: t3.Events, Attrs: []schema.Attr{&TriggerFunc{F: audit}}}
+				t5c := &schema.Trigger{Name: "users_modify", Table: users, ActionTime: schema.TriggerTimeBefore, Events: t3.Events, For: schema.TriggerForRow, Attrs: []schema.Attr{&TriggerFunc{F: audit}}}
+				return []schema.Change{
+					&schema.AddTrigger{T: t1},
+					&schema.DropTrigger{T: t2, Extra: []schema.Clause{&schema.IfExists{}, &Cascade{}}},
+					&schema.RenameTrigger{From: t3, To: t3r},
+					&schema.ModifyTrigger{From: t4, To: t4c, Changes: []schema.Change{&schema.ModifyAttr{From: &schema.Comment{Text: "old"}, To: &schema.Comment{Text: "new"}}}},
+					&schema.ModifyTrigger{From: t5, To: t5c},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE TRIGGER "users_audit" BEFORE INSERT OR UPDATE OF "name" ON "public"."users" FOR EACH ROW WHEN (NEW.name IS NOT NULL) EXECUTE FUNCTION "public"."audit"('users', 'it''s')`,
+						Reverse: `DROP TRIGGER "users_audit" ON "public"."users"`,
+					},
+					{
+						Cmd:     `COMMENT ON TRIGGER "users_audit" ON "public"."users" IS 'audit users'`,
+						Reverse: `COMMENT ON TRIGGER "users_audit" ON "public"."users" IS ''`,
+					},
+					{
+						Cmd:     `DROP TRIGGER IF EXISTS "users_truncate" ON "public"."users" CASCADE`,
+						Reverse: `CREATE TRIGGER "users_truncate" AFTER TRUNCATE ON "public"."users" FOR EACH STATEMENT EXECUTE FUNCTION "ext"."log_truncate"()`,
+					},
+					{
+						Cmd:     `ALTER TRIGGER "users_delete" ON "public"."users" RENAME TO "users_remove"`,
+						Reverse: `ALTER TRIGGER "users_remove" ON "public"."users" RENAME TO "users_delete"`,
+					},
+					{
+						Cmd:     `COMMENT ON TRIGGER "users_comment" ON "public"."users" IS 'new'`,
+						Reverse: `COMMENT ON TRIGGER "users_comment" ON "public"."users" IS 'old'`,
+					},
+					{
+						Cmd:     `DROP TRIGGER "users_modify" ON "public"."users"`,
+						Reverse: `CREATE TRIGGER "users_modify" AFTER DELETE ON "public"."users" FOR EACH STATEMENT EXECUTE FUNCTION "public"."audit"()`,
+					},
+					{
+						Cmd:     `CREATE TRIGGER "users_modify" BEFORE DELETE ON "public"."users" FOR EACH ROW EXECUTE FUNCTION "public"."audit"()`,
+						Reverse: `DROP TRIGGER "users_modify" ON "public"."users"`,
+					},
+				},
+			},
+		},
+		// Row-level security and policies.
+		{
+			changes: func() []schema.Change {
+				users := schema.NewTable("users").
+					SetSchema(schema.New("public")).
+					AddColumns(schema.NewIntColumn("tenant_id", "int"))
+				users.AddAttrs(
+					&RowSecurity{Enabled: true, Enforced: true},
+					&Policy{Name: "tenant", Table: users, Using: "tenant_id = current_setting('app.tenant')::int"},
+					&Policy{Name: "admins", Table: users, As: PolicyAsRestrictive, For: PolicyForUpdate, To: []string{"admin", "CURRENT_USER"}, Check: "(tenant_id > 0)"},
+				)
+				return []schema.Change{&schema.AddTable{T: users}}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE TABLE "public"."users" ("tenant_id" integer NOT NULL)`,
+						Reverse: `DROP TABLE "public"."users"`,
+					},
+					{
+						Cmd:     `ALTER TABLE "public"."users" ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY`,
+						Reverse: `ALTER TABLE "public"."users" DISABLE ROW LEVEL SECURITY, NO FORCE ROW LEVEL SECURITY`,
+					},
+					{
+						Cmd:     `CREATE POLICY "tenant" ON "public"."users" USING (tenant_id = current_setting('app.tenant')::int)`,
+						Reverse: `DROP POLICY "tenant" ON "public"."users"`,
+					},
+					{
+						Cmd:     `CREATE POLICY "admins" ON "public"."users" AS RESTRICTIVE FOR UPDATE TO "admin", CURRENT_USER WITH CHECK (tenant_id > 0)`,
+						Reverse: `DROP POLICY "admins" ON "public"."users"`,
+					},
+				},
+			},
+		},
+		{
+			changes: func() []schema.Change {
+				users := schema.NewTable("users").
+					SetSchema(schema.New("public")).
+					AddColumns(schema.NewIntColumn("tenant_id", "int"))
+				var (
+					p1  = &Policy{Name: "p1", Table: users, Using: "(tenant_id = 1)"}
+					p1c = &Policy{Name: "p1", Table: users, To: []string{"app"}, Using: "(tenant_id = 2)"}
+					p2  = &Policy{Name: "p2", Table: users, For: PolicyForSelect, Using: "(tenant_id = 1)"}
+					p2c = &Policy{Name: "p2", Table: users, For: PolicyForInsert, Check: "(tenant_id = 1)"}
+					p3  = &Policy{Name: "p3", Table: users, Using: "(owner = CURRENT_USER)"}
+					p4  = &Policy{Name: "p4", Table: users, To: []string{"admin"}}
+				)
+				return []schema.Change{
+					&schema.ModifyTable{
+						T: users,
+						Changes: []schema.Change{
+							&schema.ModifyAttr{From: &RowSecurity{Enabled: true, Enforced: true}, To: &RowSecurity{Enabled: true}},
+							&schema.DropColumn{C: schema.NewStringColumn("owner", "text")},
+							&schema.ModifyAttr{From: p1, To: p1c},
+							&schema.ModifyAttr{From: p2, To: p2c},
+							&schema.DropAttr{A: p3},
+							&schema.AddAttr{A: p4},
+						},
+					},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `DROP POLICY "p2" ON "public"."users"`,
+						Reverse: `CREATE POLICY "p2" ON "public"."users" FOR SELECT USING (tenant_id = 1)`,
+					},
+					{
+						Cmd:     `DROP POLICY "p3" ON "public"."users"`,
+						Reverse: `CREATE POLICY "p3" ON "public"."users" USING (owner = CURRENT_USER)`,
+					},
+					{
+						Cmd:     `ALTER TABLE "public"."users" NO FORCE ROW LEVEL SECURITY, DROP COLUMN "owner"`,
+						Reverse: `ALTER TABLE "public"."users" ADD COLUMN "owner" text NOT NULL, FORCE ROW LEVEL SECURITY`,
+					},
+					{
+						Cmd:     `ALTER POLICY "p1" ON "public"."users" TO "app" USING (tenant_id = 2)`,
+						Reverse: `ALTER POLICY "p1" ON "public"."users" TO PUBLIC USING (tenant_id = 1)`,
+					},
+					{
+						Cmd:     `CREATE POLICY "p2" ON "public"."users" FOR INSERT WITH CHECK (tenant_id = 1)`,
+						Reverse: `DROP POLICY "p2" ON "public"."users"`,
+					},
+					{
+						Cmd:     `CREATE POLICY "p4" ON "public"."users" TO "admin"`,
+						Reverse: `DROP POLICY "p4" ON "public"."users"`,
+					},
+				},
+			},
+		},
+		// Extensions.
+		{
+			changes: func() []schema.Change {
+				var (
+					public = schema.New("public")
+					ext    = schema.New("extensions")
+				)
+				return []schema.Change{
+					&schema.AddObject{O: &Extension{Name: "hstore", Schema: public, Version: "1.8"}},
+					&schema.AddObject{O: &Extension{Name: "pgcrypto"}},
+					&schema.DropObject{O: &Extension{Name: "citext", Schema: public, Version: "1.6"}},
+					&schema.ModifyObject{
+						From: &Extension{Name: "postgis", Schema: public, Version: "3.4.1"},
+						To:   &Extension{Name: "postgis", Schema: ext, Version: "3.4.2"},
+					},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE EXTENSION "hstore" WITH SCHEMA "public" VERSION '1.8'`,
+						Reverse: `DROP EXTENSION "hstore"`,
+					},
+					{
+						Cmd:     `CREATE EXTENSION "pgcrypto"`,
+						Reverse: `DROP EXTENSION "pgcrypto"`,
+					},
+					{
+						Cmd:     `ALTER EXTENSION "postgis" UPDATE TO '3.4.2'`,
+						Reverse: `ALTER EXTENSION "postgis" UPDATE TO '3.4.1'`,
+					},
+					{
+						Cmd:     `ALTER EXTENSION "postgis" SET SCHEMA "extensions"`,
+						Reverse: `ALTER EXTENSION "postgis" SET SCHEMA "public"`,
+					},
+					{
+						Cmd:     `DROP EXTENSION "citext"`,
+						Reverse: `CREATE EXTENSION "citext" WITH SCHEMA "public" VERSION '1.6'`,
+					},
+				},
+			},
+		},
+		// Event triggers.
+		{
+			changes: func() []schema.Change {
+				var (
+					public = schema.New("public")
+					logDDL = &schema.Func{Name: "log_ddl", Schema: public}
+				)
+				public.AddFuncs(logDDL)
+				e1 := &EventTrigger{Name: "ddl_log", Event: "ddl_command_end", Tags: []string{"CREATE TABLE", "ALTER TABLE"}, F: logDDL, Attrs: []schema.Attr{&schema.Comment{Text: "log ddl"}}}
+				e2 := &EventTrigger{Name: "no_drops", Event: "sql_drop", F: &schema.Func{Name: "no_drops", Schema: schema.New("public")}}
+				e3 := &EventTrigger{Name: "ddl_start", Event: "ddl_command_start", F: logDDL}
+				e3c := &EventTrigger{Name: "ddl_start", Event: "ddl_command_start", F: logDDL, Attrs: []schema.Attr{&schema.Comment{Text: "start"}}}
+				e4 := &EventTrigger{Name: "rewrite", Event: "table_rewrite", F: logDDL}
+				e4c := &EventTrigger{Name: "rewrite", Event: "table_rewrite", Tags: []string{"ALTER TABLE"}, F: logDDL}
+				return []schema.Change{
+					&schema.AddObject{O: e1},
+					&schema.DropObject{O: e2},
+					&schema.ModifyObject{From: e3, To: e3c},
+					&schema.ModifyObject{From: e4, To: e4c},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE EVENT TRIGGER "ddl_log" ON ddl_command_end WHEN TAG IN ('CREATE TABLE', 'ALTER TABLE') EXECUTE FUNCTION "public"."log_ddl"()`,
+						Reverse: `DROP EVENT TRIGGER "ddl_log"`,
+					},
+					{
+						Cmd:     `COMMENT ON EVENT TRIGGER "ddl_log" IS 'log ddl'`,
+						Reverse: `COMMENT ON EVENT TRIGGER "ddl_log" IS ''`,
+					},
+					{
+						Cmd:     `COMMENT ON EVENT TRIGGER "ddl_start" IS 'start'`,
+						Reverse: `COMMENT ON EVENT TRIGGER "ddl_start" IS ''`,
+					},
+					{
+						Cmd:     `DROP EVENT TRIGGER "rewrite"`,
+						Reverse: `CREATE EVENT TRIGGER "rewrite" ON table_rewrite EXECUTE FUNCTION "public"."log_ddl"()`,
+					},
+					{
+						Cmd:     `CREATE EVENT TRIGGER "rewrite" ON table_rewrite WHEN TAG IN ('ALTER TABLE') EXECUTE FUNCTION "public"."log_ddl"()`,
+						Reverse: `DROP EVENT TRIGGER "rewrite"`,
+					},
+					{
+						Cmd:     `DROP EVENT TRIGGER "no_drops"`,
+						Reverse: `CREATE EVENT TRIGGER "no_drops" ON sql_drop EXECUTE FUNCTION "public"."no_drops"()`,
+					},
+				},
+			},
+		},
+		// Domains.
+		{
+			changes: func() []schema.Change {
+				public := schema.New("public")
+				email := &DomainType{
+					T:      "email",
+					Schema: public,
+					Type:   &schema.StringType{T: TypeCharVar, Size: 255},
+					Checks: []*schema.Check{{Name: "email_check", Expr: "VALUE ~~ '%@%'"}},
+					Attrs:  []schema.Attr{&schema.Comment{Text: "emails"}},
+				}
+				users := schema.NewTable("users").
+					SetSchema(public).
+					AddColumns(&schema.Column{Name: "email", Type: &schema.ColumnType{Type: email}})
+				amount := &DomainType{T: "amount", Schema: public, Type: &schema.DecimalType{T: TypeNumeric, Precision: 12, Scale: 2}, Null: true}
+				amount2 := &DomainType{
+					T:       "amount",
+					Schema:  public,
+					Type:    &schema.DecimalType{T: TypeNumeric, Precision: 12, Scale: 2},
+					Default: &schema.Literal{V: "0"},
+					Checks:  []*schema.Check{{Name: "non_negative", Expr: "VALUE >= 0"}},
+				}
+				code := &DomainType{T: "code", Schema: public, Type: &schema.StringType{T: TypeText}, Null: true, Checks: []*schema.Check{{Name: "code_check", Expr: "length(VALUE) = 3"}}}
+				code2 := &DomainType{T: "code", Schema: public, Type: &schema.StringType{T: TypeText}, Null: true, Checks: []*schema.Check{{Name: "code_check", Expr: "length(VALUE) = 4"}}, Attrs: []schema.Attr{&schema.Comment{Text: "codes"}}}
+				unused := &DomainType{T: "unused", Schema: public, Type: &schema.IntegerType{T: TypeInteger}, Null: true}
+				return []schema.Change{
+					&schema.AddTable{T: users},
+					&schema.AddObject{O: email},
+					&schema.ModifyObject{From: amount, To: amount2},
+					&schema.ModifyObject{From: code, To: code2},
+					&schema.DropObject{O: unused},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE DOMAIN "public"."email" AS character varying(255) NOT NULL CONSTRAINT "email_check" CHECK (VALUE ~~ '%@%')`,
+						Reverse: `DROP DOMAIN "public"."email"`,
+					},
+					{
+						Cmd:     `COMMENT ON DOMAIN "public"."email" IS 'emails'`,
+						Reverse: `COMMENT ON DOMAIN "public"."email" IS ''`,
+					},
+					{
+						Cmd:     `CREATE TABLE "public"."users" ("email" "public"."email" NOT NULL)`,
+						Reverse: `DROP TABLE "public"."users"`,
+					},
+					{
+						Cmd:     `ALTER DOMAIN "public"."amount" SET DEFAULT 0`,
+						Reverse: `ALTER DOMAIN "public"."amount" DROP DEFAULT`,
+					},
+					{
+						Cmd:     `ALTER DOMAIN "public"."amount" SET NOT NULL`,
+						Reverse: `ALTER DOMAIN "public"."amount" DROP NOT NULL`,
+					},
+					{
+						Cmd:     `ALTER DOMAIN "public"."amount" ADD CONSTRAINT "non_negative" CHECK (VALUE >= 0)`,
+						Reverse: `ALTER DOMAIN "public"."amount" DROP CONSTRAINT "non_negative"`,
+					},
+					{
+						Cmd:     `ALTER DOMAIN "public"."code" DROP CONSTRAINT "code_check"`,
+						Reverse: `ALTER DOMAIN "public"."code" ADD CONSTRAINT "code_check" CHECK (length(VALUE) = 3)`,
+					},
+					{
+						Cmd:     `ALTER DOMAIN "public"."code" ADD CONSTRAINT "code_check" CHECK (length(VALUE) = 4)`,
+						Reverse: `ALTER DOMAIN "public"."code" DROP CONSTRAINT "code_check"`,
+					},
+					{
+						Cmd:     `COMMENT ON DOMAIN "public"."code" IS 'codes'`,
+						Reverse: `COMMENT ON DOMAIN "public"."code" IS ''`,
+					},
+					{
+						Cmd:     `DROP DOMAIN "public"."unused"`,
+						Reverse: `CREATE DOMAIN "public"."unused" AS integer`,
+					},
+				},
+			},
+		},
+		// Composite, range and enum types.
+		{
+			changes: func() []schema.Change {
+				public := schema.New("public")
+				fr := &RangeType{T: "floatrange", Schema: public, Subtype: &schema.FloatType{T: TypeFloat8}, Attrs: []schema.Attr{&schema.Comment{Text: "float ranges"}}}
+				address := &CompositeType{
+					T:      "address",
+					Schema: public,
+					Fields: []*schema.Column{
+						{Name: "street", Type: &schema.ColumnType{Type: &schema.StringType{T: TypeText}}, Attrs: []schema.Attr{&schema.Collation{V: "C"}}},
+						{Name: "bounds", Type: &schema.ColumnType{Type: fr}},
+					},
+				}
+				users := schema.NewTable("users").
+					SetSchema(public).
+					AddColumns(&schema.Column{Name: "home", Type: &schema.ColumnType{Type: address}})
+				point := &CompositeType{T: "point", Schema: public, Fields: []*schema.Column{
+					{Name: "x", Type: &schema.ColumnType{Type: &schema.IntegerType{T: TypeInteger}}},
+					{Name: "y", Type: &schema.ColumnType{Type: &schema.IntegerType{T: TypeInteger}}},
+				}}
+				point2 := &CompositeType{T: "point", Schema: public, Fields: []*schema.Column{
+					{Name: "x", Type: &schema.ColumnType{Type: &schema.IntegerType{T: TypeBigInt}}},
+					{Name: "z", Type: &schema.ColumnType{Type: &schema.IntegerType{T: TypeInteger}}},
+				}}
+				legacy := &CompositeType{T: "legacy", Schema: public, Fields: []*schema.Column{
+					{Name: "v", Type: &schema.ColumnType{Type: &schema.StringType{T: TypeText}}},
+				}}
+				state := &schema.EnumType{T: "state", Schema: public, Values: []string{"on", "off"}}
+				state2 := &schema.EnumType{T: "state", Schema: public, Values: []string{"on", "unknown", "off"}}
+				orders := schema.NewTable("orders").SetSchema(public)
+				return []schema.Change{
+					&schema.AddTable{T: users},
+					&schema.AddObject{O: address},
+					&schema.AddObject{O: fr},
+					&schema.ModifyObject{From: point, To: point2},
+					&schema.DropObject{O: legacy},
+					&schema.ModifyTable{
+						T: orders,
+						Changes: []schema.Change{
+							&schema.AddColumn{C: &schema.Column{Name: "state", Type: &schema.ColumnType{Type: state2}, Default: &schema.Literal{V: "'unknown'"}}},
+							&schema.ModifyColumn{
+								From:   &schema.Column{Name: "loc", Type: &schema.ColumnType{Type: legacy}},
+								To:     &schema.Column{Name: "loc", Type: &schema.ColumnType{Type: &schema.StringType{T: TypeText}}},
+								Change: schema.ChangeType,
+							},
+						},
+					},
+					&schema.ModifyObject{From: state, To: state2},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    false,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE TYPE "public"."floatrange" AS RANGE (SUBTYPE = double precision)`,
+						Reverse: `DROP TYPE "public"."floatrange"`,
+					},
+					{
+						Cmd:     `COMMENT ON TYPE "public"."floatrange" IS 'float ranges'`,
+						Reverse: `COMMENT ON TYPE "public"."floatrange" IS ''`,
+					},
+					{
+						Cmd:     `CREATE TYPE "public"."address" AS ("street" text COLLATE "C", "bounds" "public"."floatrange")`,
+						Reverse: `DROP TYPE "public"."address"`,
+					},
+					{
+						Cmd:     `CREATE TABLE "public"."users" ("home" "public"."address" NOT NULL)`,
+						Reverse: `DROP TABLE "public"."users"`,
+					},
+					{
+						Cmd:     `ALTER TYPE "public"."point" ALTER ATTRIBUTE "x" TYPE bigint, DROP ATTRIBUTE "y", ADD ATTRIBUTE "z" integer`,
+						Reverse: `ALTER TYPE "public"."point" ALTER ATTRIBUTE "x" TYPE integer, ADD ATTRIBUTE "y" integer, DROP ATTRIBUTE "z"`,
+					},
+					{
+						Cmd: `ALTER TYPE "public"."state" ADD VALUE 'unknown' AFTER 'on'`,
+					},
+					{
+						Cmd:     `ALTER TABLE "public"."orders" ADD COLUMN "state" "public"."state" NOT NULL DEFAULT 'unknown', ALTER COLUMN "loc" TYPE text`,
+						Reverse: `ALTER TABLE "public"."orders" ALTER COLUMN "loc" TYPE "public"."legacy", DROP COLUMN "state"`,
+					},
+					{
+						Cmd:     `DROP TYPE "public"."legacy"`,
+						Reverse: `CREATE TYPE "public"."legacy" AS ("v" text)`,
+					},
+				},
+			},
+		},
+		// Aggregates.
+		{
+			changes: func() []schema.Change {
+				public := schema.New("public")
+				floatT := &schema.FloatType{T: TypeFloat8}
+				state := &schema.Func{
+					Name:   "sum_sq_state",
+					Schema: public,
+					Args:   []*schema.FuncArg{{Name: "s", Type: floatT}, {Name: "v", Type: floatT}},
+					Ret:    floatT,
+					Lang:   "sql",
+					Body:   "RETURN s + v * v",
+				}
+				public.AddFuncs(state)
+				norm := &Aggregate{
+					Name:      "l2_norm",
+					Schema:    public,
+					Args:      []*schema.FuncArg{{Type: floatT}},
+					StateFunc: state,
+					StateType: floatT,
+					FinalFunc: &schema.Func{Name: "sqrt", Schema: schema.New("pg_catalog")},
+					InitCond:  "0",
+					Parallel:  AggregateParallelSafe,
+					Attrs:     []schema.Attr{&schema.Comment{Text: "vector norm"}},
+				}
+				legacyF := &schema.Func{Name: "legacy_state", Schema: public, Args: []*schema.FuncArg{{Name: "s", Type: floatT}}, Ret: floatT, Lang: "sql", Body: "RETURN s"}
+				legacy := &Aggregate{Name: "legacy", Schema: public, Args: []*schema.FuncArg{{Type: floatT}}, StateFunc: legacyF, StateType: floatT}
+				total := &Aggregate{Name: "total", Schema: public, StateFunc: &schema.Func{Name: "int8inc", Schema: schema.New("pg_catalog")}, StateType: &schema.IntegerType{T: TypeBigInt}, InitCond: "0"}
+				total2 := &Aggregate{Name: "total", Schema: public, StateFunc: total.StateFunc, StateType: total.StateType, InitCond: "0", Parallel: AggregateParallelSafe}
+				return []schema.Change{
+					&schema.AddObject{O: norm},
+					&schema.AddFunc{F: state},
+					&schema.DropFunc{F: legacyF},
+					&schema.DropObject{O: legacy},
+					&schema.ModifyObject{From: total, To: total2},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE FUNCTION "public"."sum_sq_state" ("s" double precision, "v" double precision) RETURNS double precision LANGUAGE sql RETURN s + v * v`,
+						Reverse: `DROP FUNCTION "public"."sum_sq_state" (double precision, double precision)`,
+					},
+					{
+						Cmd:     `CREATE AGGREGATE "public"."l2_norm" (double precision) (SFUNC = "public"."sum_sq_state", STYPE = double precision, FINALFUNC = "pg_catalog"."sqrt", INITCOND = '0', PARALLEL = SAFE)`,
+						Reverse: `DROP AGGREGATE "public"."l2_norm" (double precision)`,
+					},
+					{
+						Cmd:     `COMMENT ON AGGREGATE "public"."l2_norm" (double precision) IS 'vector norm'`,
+						Reverse: `COMMENT ON AGGREGATE "public"."l2_norm" (double precision) IS ''`,
+					},
+					{
+						Cmd:     `DROP AGGREGATE "public"."total" (*)`,
+						Reverse: `CREATE AGGREGATE "public"."total" (*) (SFUNC = "pg_catalog"."int8inc", STYPE = bigint, INITCOND = '0')`,
+					},
+					{
+						Cmd:     `CREATE AGGREGATE "public"."total" (*) (SFUNC = "pg_catalog"."int8inc", STYPE = bigint, INITCOND = '0', PARALLEL = SAFE)`,
+						Reverse: `DROP AGGREGATE "public"."total" (*)`,
+					},
+					{
+						Cmd:     `DROP AGGREGATE "public"."legacy" (double precision)`,
+						Reverse: `CREATE AGGREGATE "public"."legacy" (double precision) (SFUNC = "public"."legacy_state", STYPE = double precision)`,
+					},
+					{
+						Cmd:     `DROP FUNCTION "public"."legacy_state" (double precision)`,
+						Reverse: `CREATE FUNCTION "public"."legacy_state" ("s" double precision) RETURNS double precision LANGUAGE sql RETURN s`,
+					},
+				},
+			},
+		},
+		// Sequences.
+		{
+			changes: func() []schema.Change {
+				public := schema.New("public")
+				users := schema.NewTable("users").
+					SetSchema(public).
+					AddColumns(schema.NewIntColumn("id", "integer").SetDefault(&schema.RawExpr{X: "nextval('users_id'::regclass)"}))
+				minV, maxV, max2 := int64(-50), int64(1000), int64(2000)
+				s1 := &Sequence{Name: "users_id", Schema: public, Type: &schema.IntegerType{T: TypeInteger}}
+				s1.Owner.T, s1.Owner.C = users, users.Columns[0]
+				s2 := &Sequence{Name: "counter", Schema: public, Start: 100, Increment: -2, Min: &minV, Max: &maxV, Cache: 10, Cycle: true, Attrs: []schema.Attr{&schema.Comment{Text: "counter"}}}
+				s3 := &Sequence{Name: "unused", Schema: public}
+				s4 := &Sequence{Name: "orders", Schema: public, Type: &schema.IntegerType{T: TypeBigInt}, Start: 1, Increment: 1, Cache: 1}
+				s4c := &Sequence{Name: "orders", Schema: public, Type: &schema.IntegerType{T: TypeInteger}, Increment: 5, Max: &max2, Cycle: true, Attrs: []schema.Attr{&schema.Comment{Text: "orders"}}}
+				return []schema.Change{
+					&schema.AddObject{O: s1},
+					&schema.AddTable{T: users},
+					&schema.AddObject{O: s2},
+					&schema.ModifyObject{From: s4, To: s4c},
+					&schema.DropObject{O: s3, Extra: []schema.Clause{&schema.IfExists{}}},
+				}
+			}(),
+			wantPlan: &migrate.Plan{
+				Reversible:    true,
+				Transactional: true,
+				Changes: []*migrate.Change{
+					{
+						Cmd:     `CREATE SEQUENCE "public"."users_id" AS integer`,
+						Reverse: `DROP SEQUENCE "public"."users_id"`,
+					},
+					{
+						Cmd:     `CREATE TABLE "public"."users" ("id" integer NOT NULL DEFAULT nextval('users_id'::regclass))`,
+						Reverse: `DROP TABLE "public"."users"`,
+					},
+					{
+						Cmd:     `CREATE SEQUENCE "public"."counter" INCREMENT BY -2 MINVALUE -50 MAXVALUE 1000 START WITH 100 CACHE 10 CYCLE`,
+						Reverse: `DROP SEQUENCE "public"."counter"`,
+					},
+					{
+						Cmd:     `COMMENT ON SEQUENCE "public"."counter" IS 'counter'`,
+						Reverse: `COMMENT ON SEQUENCE "public"."counter" IS ''`,
+					},
+					{
+						Cmd:     `ALTER SEQUENCE "public"."orders" AS integer INCREMENT BY 5 MAXVALUE 2000 CYCLE`,
+						Reverse: `ALTER SEQUENCE "public"."orders" AS bigint INCREMENT BY 1 NO MAXVALUE NO CYCLE`,
+					},
+					{
+						Cmd:     `COMMENT ON SEQUENCE "public"."orders" IS 'orders'`,
+						Reverse: `COMMENT ON SEQUENCE "public"."orders" IS ''`,
+					},
+					{
+						Cmd:     `ALTER SEQUENCE "public"."users_id" OWNED BY "public"."users"."id"`,
+						Reverse: `ALTER SEQUENCE "public"."users_id" OWNED BY NONE`,
+					},
+					{
+						Cmd:     `DROP SEQUENCE IF EXISTS "public"."unused"`,
+						Reverse: `CREATE SEQUENCE "public"."unused"`,
+					},
 				},
 			},
-			wantErr: true,
 		},
 	}
 	for i, tt := range tests {
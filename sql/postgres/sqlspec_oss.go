@@ -7,8 +7,10 @@
 package postgres
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -31,6 +33,7 @@ type (
 		Enums         []*enum             `spec:"enum"`
 		Domains       []*domain           `spec:"domain"`
 		Composites    []*composite        `spec:"composite"`
+		Ranges        []*rangeType        `spec:"range"`
 		Sequences     []*sqlspec.Sequence `spec:"sequence"`
 		Funcs         []*sqlspec.Func     `spec:"function"`
 		Procs         []*sqlspec.Func     `spec:"procedure"`
@@ -80,11 +83,20 @@ type (
 		schemahcl.DefaultExtension
 	}
 
+	// rangeType holds a specification for a range type.
+	rangeType struct {
+		Name      string          `spec:",name"`
+		Qualifier string          `spec:",qualifier"`
+		Schema    *schemahcl.Ref  `spec:"schema"`
+		Subtype   *schemahcl.Type `spec:"subtype"`
+		schemahcl.DefaultExtension
+	}
+
 	// extension holds a specification for a postgres extension.
 	// Note, extension names are unique within a realm (database).
 	extension struct {
 		Name string `spec:",name"`
-		// Schema, version and comment are conditionally
+		// Schema and version are conditionally
 		// added to the extension definition.
 		schemahcl.DefaultExtension
 	}
@@ -93,8 +105,8 @@ type (
 	// Note, event trigger names are unique within a realm (database).
 	eventTrigger struct {
 		Name string `spec:",name"`
-		// Schema, version and comment are conditionally
-		// added to the extension definition.
+		// The event, tags, executed function and comment
+		// are added to the event trigger definition.
 		schemahcl.DefaultExtension
 	}
 
@@ -128,6 +140,7 @@ func (d *doc) merge(d1 *doc) {
 	d.Tables = append(d.Tables, d1.Tables...)
 	d.Domains = append(d.Domains, d1.Domains...)
 	d.Composites = append(d.Composites, d1.Composites...)
+	d.Ranges = append(d.Ranges, d1.Ranges...)
 	d.Schemas = append(d.Schemas, d1.Schemas...)
 	d.Aggregates = append(d.Aggregates, d1.Aggregates...)
 	d.Sequences = append(d.Sequences, d1.Sequences...)
@@ -186,6 +199,18 @@ func (c *composite) SetQualifier(q string) { c.Qualifier = q }
 // SchemaRef returns the schema reference for the composite.
 func (c *composite) SchemaRef() *schemahcl.Ref { return c.Schema }
 
+// Label returns the defaults label used for the range resource.
+func (r *rangeType) Label() string { return r.Name }
+
+// QualifierLabel returns the qualifier label used for the range resource, if any.
+func (r *rangeType) QualifierLabel() string { return r.Qualifier }
+
+// SetQualifier sets the qualifier label used for the range resource.
+func (r *rangeType) SetQualifier(q string) { r.Qualifier = q }
+
+// SchemaRef returns the schema reference for the range.
+func (r *rangeType) SchemaRef() *schemahcl.Ref { return r.Schema }
+
 // Label returns the defaults label used for the aggregate resource.
 func (a *aggregate) Label() string { return a.Name }
 
@@ -203,6 +228,7 @@ func init() {
 	schemahcl.Register("domain", &domain{})
 	schemahcl.Register("policy", &policy{})
 	schemahcl.Register("composite", &composite{})
+	schemahcl.Register("range", &rangeType{})
 	schemahcl.Register("aggregate", &aggregate{})
 	schemahcl.Register("extension", &extension{})
 	schemahcl.Register("event_trigger", &eventTrigger{})
@@ -232,6 +258,15 @@ func (c *Codec) EvalOptions(p *hclparse.Parser, v any, opts *schemahcl.EvalOptio
 		if err := convertTypes(&d, v); err != nil {
 			return err
 		}
+		if err := convertDomains(d.Tables, d.Domains, v); err != nil {
+			return err
+		}
+		if err := convertRanges(d.Tables, d.Ranges, v); err != nil {
+			return err
+		}
+		if err := convertComposites(d.Tables, d.Composites, v); err != nil {
+			return err
+		}
 		if err := convertAggregate(&d, v); err != nil {
 			return err
 		}
@@ -265,6 +300,15 @@ func (c *Codec) EvalOptions(p *hclparse.Parser, v any, opts *schemahcl.EvalOptio
 		if err := convertTypes(&d, r); err != nil {
 			return err
 		}
+		if err := convertDomains(d.Tables, d.Domains, r); err != nil {
+			return err
+		}
+		if err := convertRanges(d.Tables, d.Ranges, r); err != nil {
+			return err
+		}
+		if err := convertComposites(d.Tables, d.Composites, r); err != nil {
+			return err
+		}
 		if err := convertAggregate(&d, r); err != nil {
 			return err
 		}
@@ -337,6 +381,9 @@ func (c *Codec) MarshalSpec(v any) ([]byte, error) {
 		if err := specutil.QualifyObjects(d.Composites); err != nil {
 			return nil, err
 		}
+		if err := specutil.QualifyObjects(d.Ranges); err != nil {
+			return nil, err
+		}
 		if err := specutil.QualifyObjects(d.Sequences); err != nil {
 			return nil, err
 		}
@@ -364,7 +411,30 @@ var (
 			schemahcl.WithTypes("table.column.type", TypeRegistry.Specs()),
 			schemahcl.WithTypes("view.column.type", TypeRegistry.Specs()),
 			schemahcl.WithTypes("materialized.column.type", TypeRegistry.Specs()),
+			schemahcl.WithTypes("sequence.type", TypeRegistry.Specs()),
+			schemahcl.WithTypes("domain.type", TypeRegistry.Specs()),
+			schemahcl.WithTypes("range.subtype", TypeRegistry.Specs()),
+			schemahcl.WithTypes("composite.field.type", TypeRegistry.Specs()),
+			schemahcl.WithTypes("aggregate.arg.type", TypeRegistry.Specs()),
+			schemahcl.WithTypes("aggregate.state_type", TypeRegistry.Specs()),
 			schemahcl.WithScopedEnums("view.check_option", schema.ViewCheckOptionLocal, schema.ViewCheckOptionCascaded),
+			schemahcl.WithScopedEnums("trigger.for", schema.TriggerForRow, schema.TriggerForStmt),
+			schemahcl.WithScopedEnums("policy.as", PolicyAsPermissive, PolicyAsRestrictive),
+			schemahcl.WithScopedEnums("policy.for", PolicyForAll, PolicyForSelect, PolicyForInsert, PolicyForUpdate, PolicyForDelete),
+			schemahcl.WithScopedEnums("policy.to", "PUBLIC", "CURRENT_USER", "CURRENT_ROLE", "SESSION_USER"),
+			schemahcl.WithScopedEnums("event_trigger.on", "ddl_command_start", "ddl_command_end", "table_rewrite", "sql_drop", "login"),
+			schemahcl.WithTypes("function.arg.type", TypeRegistry.Specs()),
+			schemahcl.WithTypes("function.return", TypeRegistry.Specs()),
+			schemahcl.WithTypes("procedure.arg.type", TypeRegistry.Specs()),
+			schemahcl.WithScopedEnums("function.lang", FuncLangSQL, FuncLangPLpgSQL),
+			schemahcl.WithScopedEnums("procedure.lang", FuncLangSQL, FuncLangPLpgSQL),
+			schemahcl.WithScopedEnums("function.arg.mode", schema.FuncArgModeIn, schema.FuncArgModeOut, schema.FuncArgModeInOut, schema.FuncArgModeVariadic),
+			schemahcl.WithScopedEnums("procedure.arg.mode", schema.FuncArgModeIn, schema.FuncArgModeOut, schema.FuncArgModeInOut, schema.FuncArgModeVariadic),
+			schemahcl.WithScopedEnums("function.volatility", FuncVolatilityImmutable, FuncVolatilityStable, FuncVolatilityVolatile),
+			schemahcl.WithScopedEnums("function.security", FuncSecurityInvoker, FuncSecurityDefiner),
+			schemahcl.WithScopedEnums("aggregate.arg.mode", schema.FuncArgModeIn, schema.FuncArgModeVariadic),
+			schemahcl.WithScopedEnums("aggregate.parallel", AggregateParallelSafe, AggregateParallelRestricted, AggregateParallelUnsafe),
+			schemahcl.WithScopedEnums("procedure.security", FuncSecurityInvoker, FuncSecurityDefiner),
 			schemahcl.WithScopedEnums("table.index.type", IndexTypeBTree, IndexTypeBRIN, IndexTypeHash, IndexTypeGIN, IndexTypeGiST, "GiST", IndexTypeSPGiST, "SPGiST"),
 			schemahcl.WithScopedEnums("table.partition.type", PartitionTypeRange, PartitionTypeList, PartitionTypeHash),
 			schemahcl.WithScopedEnums("table.column.identity.generated", GeneratedTypeAlways, GeneratedTypeByDefault),
@@ -411,6 +481,23 @@ func convertTable(spec *sqlspec.Table, parent *schema.Schema) (*schema.Table, er
 	return t, nil
 }
 
+// convertTableAttrs converts the row-level security configuration of the table.
+func convertTableAttrs(spec *sqlspec.Table, t *schema.Table) error {
+	r, ok := spec.Extra.Resource("row_security")
+	if !ok {
+		return nil
+	}
+	var v struct {
+		Enabled  bool `spec:"enabled"`
+		Enforced bool `spec:"enforced"`
+	}
+	if err := r.As(&v); err != nil {
+		return fmt.Errorf("parsing %s.row_security: %w", t.Name, err)
+	}
+	t.AddAttrs(&RowSecurity{Enabled: v.Enabled, Enforced: v.Enforced})
+	return nil
+}
+
 // convertView converts a sqlspec.View to a schema.View.
 func convertView(spec *sqlspec.View, parent *schema.Schema) (*schema.View, error) {
 	v, err := specutil.View(
@@ -433,6 +520,64 @@ func convertView(spec *sqlspec.View, parent *schema.Schema) (*schema.View, error
 	return v, nil
 }
 
+// convertFunc converts a sqlspec.Func to a schema.Func.
+func convertFunc(spec *sqlspec.Func, parent *schema.Schema) (*schema.Func, error) {
+	f, err := specutil.Func(spec, parent, convertColumnType)
+	if err != nil {
+		return nil, err
+	}
+	if err := convertFuncAttrs(spec, &f.Attrs, true); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// convertProc converts a sqlspec.Func to a schema.Proc.
+func convertProc(spec *sqlspec.Func, parent *schema.Schema) (*schema.Proc, error) {
+	p, err := specutil.Proc(spec, parent, convertColumnType)
+	if err != nil {
+		return nil, err
+	}
+	if err := convertFuncAttrs(spec, &p.Attrs, false); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// convertFuncAttrs converts the PostgreSQL specific attributes of functions and procedures.
+func convertFuncAttrs(spec *sqlspec.Func, attrs *[]schema.Attr, isFunc bool) error {
+	if a, ok := spec.Extra.Attr("volatility"); ok {
+		if !isFunc {
+			return fmt.Errorf("postgres: volatility is not supported for procedure %q", spec.Name)
+		}
+		v, err := a.String()
+		if err != nil {
+			return fmt.Errorf("postgres: unexpected volatility for function %q: %w", spec.Name, err)
+		}
+		switch v = strings.ToUpper(v); v {
+		case FuncVolatilityVolatile:
+		case FuncVolatilityImmutable, FuncVolatilityStable:
+			*attrs = append(*attrs, &FuncVolatility{V: v})
+		default:
+			return fmt.Errorf("postgres: unexpected volatility %q for function %q", v, spec.Name)
+		}
+	}
+	if a, ok := spec.Extra.Attr("security"); ok {
+		v, err := a.String()
+		if err != nil {
+			return fmt.Errorf("postgres: unexpected security for %q: %w", spec.Name, err)
+		}
+		switch v = strings.ToUpper(v); v {
+		case FuncSecurityInvoker:
+		case FuncSecurityDefiner:
+			*attrs = append(*attrs, &FuncSecurity{V: v})
+		default:
+			return fmt.Errorf("postgres: unexpected security %q for %q", v, spec.Name)
+		}
+	}
+	return nil
+}
+
 // convertUnique converts the unique constraints into indexes.
 func convertUnique(spec schemahcl.Resource, t *schema.Table) error {
 	rs := spec.Resources("unique")
@@ -772,6 +917,7 @@ func schemaSpec(s *schema.Schema) (*doc, []*schema.Trigger, error) {
 		Enums:        make([]*enum, 0, len(s.Objects)),
 		Domains:      make([]*domain, 0, len(s.Objects)),
 		Composites:   make([]*composite, 0, len(s.Objects)),
+		Ranges:       make([]*rangeType, 0, len(s.Objects)),
 	}
 	if err := objectSpec(d, spec, s); err != nil {
 		return nil, nil, err
@@ -820,6 +966,41 @@ func tableSpec(t *schema.Table) (*sqlspec.Table, error) {
 	return spec, nil
 }
 
+// tableAttrsSpec converts the row-level security configuration of the table to its spec.
+func tableAttrsSpec(t *schema.Table, spec *sqlspec.Table) {
+	if r := rowSecurity(t); r.Enabled || r.Enforced {
+		rs := &schemahcl.Resource{
+			Type:  "row_security",
+			Attrs: []*schemahcl.Attr{schemahcl.BoolAttr("enabled", r.Enabled)},
+		}
+		if r.Enforced {
+			rs.Attrs = append(rs.Attrs, schemahcl.BoolAttr("enforced", true))
+		}
+		spec.Extra.Children = append(spec.Extra.Children, rs)
+	}
+}
+
+// policySpec converts the row-level security policy of the table to its spec.
+func policySpec(t *schema.Table, p *Policy) *policy {
+	spec := &policy{Name: p.Name, On: specutil.TableSpecRef(t)}
+	if as := policyAs(p); as != PolicyAsPermissive {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, specutil.VarAttr("as", as))
+	}
+	if cmd := policyFor(p); cmd != PolicyForAll {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, specutil.VarAttr("for", cmd))
+	}
+	if roles := policyRoles(p); len(roles) > 1 || roles[0] != "PUBLIC" {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringsAttr("to", p.To...))
+	}
+	if p.Using != "" {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("using", p.Using))
+	}
+	if p.Check != "" {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("check", p.Check))
+	}
+	return spec
+}
+
 // viewSpec converts from a concrete PostgreSQL schema.View to a sqlspec.View.
 func viewSpec(view *schema.View) (*sqlspec.View, error) {
 	spec, err := specutil.FromView(
@@ -835,6 +1016,973 @@ func viewSpec(view *schema.View) (*sqlspec.View, error) {
 	return spec, nil
 }
 
+// funcSpec converts from a concrete PostgreSQL schema.Func to a sqlspec.Func.
+func funcSpec(f *schema.Func) (*sqlspec.Func, error) {
+	spec, err := specutil.FromFunc(f, columnTypeSpec)
+	if err != nil {
+		return nil, err
+	}
+	var attrs []*schemahcl.Attr
+	if v := (FuncVolatility{}); sqlx.Has(f.Attrs, &v) && strings.ToUpper(v.V) != FuncVolatilityVolatile {
+		attrs = append(attrs, specutil.VarAttr("volatility", strings.ToUpper(v.V)))
+	}
+	funcAttrsSpec(spec, f.Lang, f.Attrs, attrs...)
+	return spec, nil
+}
+
+// procSpec converts from a concrete PostgreSQL schema.Proc to a sqlspec.Func.
+func procSpec(p *schema.Proc) (*sqlspec.Func, error) {
+	spec, err := specutil.FromProc(p, columnTypeSpec)
+	if err != nil {
+		return nil, err
+	}
+	funcAttrsSpec(spec, p.Lang, p.Attrs)
+	return spec, nil
+}
+
+// funcAttrsSpec sets the language and the security attributes of functions and procedures.
+// The given attributes and the security attribute are placed before the definition.
+func funcAttrsSpec(spec *sqlspec.Func, lang string, attrs []schema.Attr, extra ...*schemahcl.Attr) {
+	switch {
+	case strings.EqualFold(lang, FuncLangSQL):
+		spec.Lang = schemahcl.RefValue(FuncLangSQL)
+	case strings.EqualFold(lang, FuncLangPLpgSQL):
+		spec.Lang = schemahcl.RefValue(FuncLangPLpgSQL)
+	}
+	if s := (FuncSecurity{}); sqlx.Has(attrs, &s) && strings.ToUpper(s.V) != FuncSecurityInvoker {
+		extra = append(extra, specutil.VarAttr("security", strings.ToUpper(s.V)))
+	}
+	i := slices.IndexFunc(spec.Extra.Attrs, func(a *schemahcl.Attr) bool { return a.K == "as" })
+	if i == -1 {
+		i = len(spec.Extra.Attrs)
+	}
+	spec.Extra.Attrs = slices.Insert(spec.Extra.Attrs, i, extra...)
+}
+
+// triggersSpec converts from concrete PostgreSQL triggers to sqlspec.Trigger.
+func triggersSpec(ts []*schema.Trigger, d *doc) error {
+	for _, t := range ts {
+		spec := &sqlspec.Trigger{Name: t.Name}
+		switch {
+		case t.Table != nil:
+			spec.On = specutil.TableSpecRef(t.Table)
+		case t.View != nil:
+			spec.On = specutil.ViewSpecRef(t.View)
+		default:
+			return fmt.Errorf("trigger %q is not attached to a table or a view", t.Name)
+		}
+		on, err := spec.On.Path()
+		if err != nil {
+			return err
+		}
+		timing := &schemahcl.Resource{
+			Type: strings.ToLower(specutil.Var(string(t.ActionTime))),
+		}
+		for _, e := range t.Events {
+			switch e.Name {
+			case schema.TriggerEventUpdateOf().Name:
+				refs := make([]*schemahcl.Ref, 0, len(e.Columns))
+				for _, c := range e.Columns {
+					refs = append(refs, schemahcl.BuildRef(append(on, schemahcl.PathIndex{T: "column", V: []string{c.Name}})))
+				}
+				timing.Attrs = append(timing.Attrs, schemahcl.RefsAttr("update_of", refs...))
+			default:
+				timing.Attrs = append(timing.Attrs, schemahcl.BoolAttr(strings.ToLower(e.Name), true))
+			}
+		}
+		spec.Extra.Children = append(spec.Extra.Children, timing)
+		if t.For == schema.TriggerForRow {
+			spec.Extra.Attrs = append(spec.Extra.Attrs, specutil.VarAttr("for", string(t.For)))
+		}
+		if w := (TriggerWhen{}); sqlx.Has(t.Attrs, &w) {
+			spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("when", w.X))
+		}
+		if f := (TriggerFunc{}); sqlx.Has(t.Attrs, &f) {
+			spec.Extra.Children = append(spec.Extra.Children, execSpec(f.F, f.Args))
+		}
+		if c := (schema.Comment{}); sqlx.Has(t.Attrs, &c) && c.Text != "" {
+			spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("comment", c.Text))
+		}
+		d.Triggers = append(d.Triggers, spec)
+	}
+	return nil
+}
+
+// realmObjectsSpec converts the realm-level objects (e.g., event triggers) to specs.
+func realmObjectsSpec(d *doc, r *schema.Realm) error {
+	for _, o := range r.Objects {
+		if e, ok := o.(*Extension); ok {
+			d.Extensions = append(d.Extensions, extensionSpec(e))
+		}
+		e, ok := o.(*EventTrigger)
+		if !ok {
+			continue
+		}
+		spec := &eventTrigger{Name: e.Name}
+		spec.Extra.Attrs = append(spec.Extra.Attrs, specutil.VarAttr("on", e.Event))
+		if len(e.Tags) > 0 {
+			spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringsAttr("tags", e.Tags...))
+		}
+		spec.Extra.Attrs = append(spec.Extra.Attrs, funcAttr("execute", e.F))
+		if c := (schema.Comment{}); sqlx.Has(e.Attrs, &c) && c.Text != "" {
+			spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("comment", c.Text))
+		}
+		d.EventTriggers = append(d.EventTriggers, spec)
+	}
+	return nil
+}
+
+// extensionSpec returns the spec of an extension.
+func extensionSpec(e *Extension) *extension {
+	spec := &extension{Name: e.Name}
+	if e.Schema != nil {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.RefAttr("schema", specutil.SchemaRef(e.Schema.Name)))
+	}
+	if e.Version != "" {
+		spec.Extra.Attrs = append(spec.Extra.Attrs, schemahcl.StringAttr("version", e.Version))
+	}
+	return spec
+}
+
+// execSpec returns the spec of a function execution, with its arguments.
+func execSpec(f *schema.Func, args []string) *schemahcl.Resource {
+	r := &schemahcl.Resource{
+		Type:  "execute",
+		Attrs: []*schemahcl.Attr{funcAttr("function", f)},
+	}
+	if len(args) > 0 {
+		r.Attrs = append(r.Attrs, schemahcl.StringsAttr("args", args...))
+	}
+	return r
+}
+
+// funcAttr returns an attribute that references the given function. Functions
+// that are not part of the realm (e.g., builtins) are written as strings.
+func funcAttr(k string, f *schema.Func) *schemahcl.Attr {
+	if f.Schema != nil && slices.Contains(f.Schema.Funcs, f) {
+		return schemahcl.RefAttr(k, specutil.FuncSpecRef(f))
+	}
+	if f.Schema != nil && f.Schema.Name != "" {
+		return schemahcl.StringAttr(k, f.Schema.Name+"."+f.Name)
+	}
+	return schemahcl.StringAttr(k, f.Name)
+}
+
+// convertTriggers converts the trigger specs to schema triggers
+// and attaches them to their tables or views.
+func convertTriggers(r *schema.Realm, triggers []*sqlspec.Trigger) error {
+	for _, spec := range triggers {
+		if spec.On == nil {
+			return fmt.Errorf("missing 'on' definition for trigger %q", spec.Name)
+		}
+		o, err := specutil.ObjectByRef(r, spec.On)
+		if err != nil {
+			return fmt.Errorf("find table or view of trigger %q: %w", spec.Name, err)
+		}
+		t := &schema.Trigger{Name: spec.Name, For: schema.TriggerForStmt}
+		var columns interface {
+			Column(string) (*schema.Column, bool)
+		}
+		switch o := o.(type) {
+		case *schema.Table:
+			t.Table, columns = o, o
+			o.Triggers = append(o.Triggers, t)
+		case *schema.View:
+			t.View, columns = o, o
+			o.Triggers = append(o.Triggers, t)
+		default:
+			return fmt.Errorf("unexpected trigger %q target: %T", spec.Name, o)
+		}
+		for _, at := range []schema.TriggerTime{schema.TriggerTimeBefore, schema.TriggerTimeAfter, schema.TriggerTimeInstead} {
+			timing, ok := spec.Extra.Resource(strings.ToLower(specutil.Var(string(at))))
+			if !ok {
+				continue
+			}
+			if t.ActionTime != "" {
+				return fmt.Errorf("multiple action times defined for trigger %q", spec.Name)
+			}
+			t.ActionTime = at
+			if t.Events, err = triggerEvents(timing, columns); err != nil {
+				return fmt.Errorf("convert events of trigger %q: %w", spec.Name, err)
+			}
+		}
+		if t.ActionTime == "" {
+			return fmt.Errorf("missing action time (before, after or instead_of) for trigger %q", spec.Name)
+		}
+		if a, ok := spec.Extra.Attr("for"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute trigger.%s.for: %w", spec.Name, err)
+			}
+			t.For = schema.TriggerFor(strings.ToUpper(v))
+		}
+		if a, ok := spec.Extra.Attr("when"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute trigger.%s.when: %w", spec.Name, err)
+			}
+			t.Attrs = append(t.Attrs, &TriggerWhen{X: v})
+		}
+		exec, ok := spec.Extra.Resource("execute")
+		if !ok {
+			return fmt.Errorf("missing execute block for trigger %q", spec.Name)
+		}
+		f, err := convertExec(r, exec)
+		if err != nil {
+			return fmt.Errorf("convert execute block of trigger %q: %w", spec.Name, err)
+		}
+		if slices.Contains(f.F.Schema.Funcs, f.F) {
+			t.Deps = append(t.Deps, f.F)
+		}
+		t.Attrs = append(t.Attrs, f)
+		if a, ok := spec.Extra.Attr("comment"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute trigger.%s.comment: %w", spec.Name, err)
+			}
+			t.Attrs = append(t.Attrs, &schema.Comment{Text: v})
+		}
+		schemahcl.AppendPos(&t.Attrs, spec.Range)
+	}
+	return nil
+}
+
+// triggerEvents converts the events defined in the trigger action time block.
+func triggerEvents(r *schemahcl.Resource, columns interface {
+	Column(string) (*schema.Column, bool)
+}) ([]schema.TriggerEvent, error) {
+	var events []schema.TriggerEvent
+	for _, e := range []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventUpdate, schema.TriggerEventDelete, schema.TriggerEventTruncate} {
+		a, ok := r.Attr(strings.ToLower(e.Name))
+		if !ok {
+			continue
+		}
+		if b, err := a.Bool(); err != nil {
+			return nil, err
+		} else if b {
+			events = append(events, e)
+		}
+	}
+	if a, ok := r.Attr("update_of"); ok {
+		refs, err := a.Refs()
+		if err != nil {
+			return nil, err
+		}
+		cols := make([]*schema.Column, 0, len(refs))
+		for _, ref := range refs {
+			c, err := specutil.ColumnByRef(columns, ref)
+			if err != nil {
+				return nil, err
+			}
+			cols = append(cols, c)
+		}
+		events = append(events, schema.TriggerEventUpdateOf(cols...))
+	}
+	if len(events) == 0 {
+		return nil, fmt.Errorf("missing trigger events in %s block", r.Type)
+	}
+	return events, nil
+}
+
+// convertExec converts an execute block to a TriggerFunc.
+func convertExec(r *schema.Realm, exec *schemahcl.Resource) (*TriggerFunc, error) {
+	a, ok := exec.Attr("function")
+	if !ok {
+		return nil, errors.New("missing function attribute")
+	}
+	f, err := convertFuncAttr(r, a)
+	if err != nil {
+		return nil, err
+	}
+	tf := &TriggerFunc{F: f}
+	if a, ok := exec.Attr("args"); ok {
+		if tf.Args, err = a.Strings(); err != nil {
+			return nil, fmt.Errorf("expect list of strings for attribute args: %w", err)
+		}
+	}
+	return tf, nil
+}
+
+// convertFuncAttr converts an attribute that references a function. The function can be
+// defined in the realm (a reference), or a string for functions that are not managed by it.
+func convertFuncAttr(r *schema.Realm, a *schemahcl.Attr) (*schema.Func, error) {
+	if !a.IsRef() {
+		v, err := a.String()
+		if err != nil {
+			return nil, fmt.Errorf("expect function reference or string for attribute %s: %w", a.K, err)
+		}
+		f := &schema.Func{Name: v, Schema: &schema.Schema{}}
+		if i := strings.LastIndexByte(v, '.'); i != -1 {
+			f.Name, f.Schema.Name = v[i+1:], v[:i]
+		}
+		return f, nil
+	}
+	ref, ok := a.V.EncapsulatedValue().(*schemahcl.Ref)
+	if !ok {
+		return nil, fmt.Errorf("expect function reference for attribute %s", a.K)
+	}
+	o, err := specutil.ObjectByRef(r, ref)
+	if err != nil {
+		return nil, err
+	}
+	f, ok := o.(*schema.Func)
+	if !ok {
+		return nil, fmt.Errorf("expect function reference for attribute %s, got %T", a.K, o)
+	}
+	return f, nil
+}
+
+// convertEventTriggers converts the event trigger specs to realm objects.
+func convertEventTriggers(evs []*eventTrigger, r *schema.Realm) error {
+	for _, spec := range evs {
+		e := &EventTrigger{Name: spec.Name}
+		a, ok := spec.Extra.Attr("on")
+		if !ok {
+			return fmt.Errorf("missing 'on' definition for event trigger %q", spec.Name)
+		}
+		var err error
+		if e.Event, err = a.String(); err != nil {
+			return fmt.Errorf("expect string value for attribute event_trigger.%s.on: %w", spec.Name, err)
+		}
+		if a, ok := spec.Extra.Attr("tags"); ok {
+			if e.Tags, err = a.Strings(); err != nil {
+				return fmt.Errorf("expect list of strings for attribute event_trigger.%s.tags: %w", spec.Name, err)
+			}
+		}
+		if a, ok = spec.Extra.Attr("execute"); !ok {
+			return fmt.Errorf("missing 'execute' definition for event trigger %q", spec.Name)
+		}
+		if e.F, err = convertFuncAttr(r, a); err != nil {
+			return fmt.Errorf("convert function of event trigger %q: %w", spec.Name, err)
+		}
+		if a, ok := spec.Extra.Attr("comment"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute event_trigger.%s.comment: %w", spec.Name, err)
+			}
+			e.Attrs = append(e.Attrs, &schema.Comment{Text: v})
+		}
+		r.AddObjects(e)
+	}
+	return nil
+}
+
+// convertPolicies converts the policy specs to row-level security
+// policies and attaches them to their tables.
+func convertPolicies(_ []*sqlspec.Table, ps []*policy, r *schema.Realm) error {
+	for _, spec := range ps {
+		if spec.On == nil {
+			return fmt.Errorf("missing 'on' definition for policy %q", spec.Name)
+		}
+		o, err := specutil.ObjectByRef(r, spec.On)
+		if err != nil {
+			return fmt.Errorf("find table of policy %q: %w", spec.Name, err)
+		}
+		t, ok := o.(*schema.Table)
+		if !ok {
+			return fmt.Errorf("policy %q must be defined on a table, got: %T", spec.Name, o)
+		}
+		p := &Policy{Name: spec.Name, Table: t}
+		for _, f := range []struct {
+			k string
+			v *string
+		}{{"as", &p.As}, {"for", &p.For}, {"using", &p.Using}, {"check", &p.Check}} {
+			a, ok := spec.Extra.Attr(f.k)
+			if !ok {
+				continue
+			}
+			if *f.v, err = a.String(); err != nil {
+				return fmt.Errorf("expect string value for attribute policy.%s.%s: %w", spec.Name, f.k, err)
+			}
+		}
+		if a, ok := spec.Extra.Attr("to"); ok {
+			if p.To, err = a.Strings(); err != nil {
+				return fmt.Errorf("expect list of strings for attribute policy.%s.to: %w", spec.Name, err)
+			}
+		}
+		t.AddAttrs(p)
+	}
+	return nil
+}
+
+// convertExtensions converts the extension specs to schema extensions and adds them to the realm.
+func convertExtensions(exs []*extension, r *schema.Realm) error {
+	for _, spec := range exs {
+		e := &Extension{Name: spec.Name}
+		if a, ok := spec.Extra.Attr("schema"); ok {
+			ref, err := a.Ref()
+			if err != nil {
+				return fmt.Errorf("expect schema reference for attribute extension.%s.schema: %w", spec.Name, err)
+			}
+			ns, err := specutil.SchemaName(&schemahcl.Ref{V: ref})
+			if err != nil {
+				return fmt.Errorf("extract schema name from extension reference: %w", err)
+			}
+			if e.Schema, ok = r.Schema(ns); !ok {
+				return fmt.Errorf("schema %q defined on extension %q was not found in realm", ns, spec.Name)
+			}
+		}
+		if a, ok := spec.Extra.Attr("version"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute extension.%s.version: %w", spec.Name, err)
+			}
+			e.Version = v
+		}
+		r.AddObjects(e)
+	}
+	return nil
+}
+
+// convertDomains converts the domain specs to schema domains, adds them to
+// their schemas and sets them on the columns that reference them.
+func convertDomains(tables []*sqlspec.Table, domains []*domain, r *schema.Realm) error {
+	var (
+		ds    = make([]*DomainType, 0, len(domains))
+		types = make([]*schemahcl.Type, 0, len(domains))
+	)
+	for _, spec := range domains {
+		ns, err := specutil.SchemaName(spec.Schema)
+		if err != nil {
+			return fmt.Errorf("extract schema name from domain reference: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("schema %q defined on domain %q was not found in realm", ns, spec.Name)
+		}
+		if spec.Type == nil {
+			return fmt.Errorf("missing base type for domain %q", spec.Name)
+		}
+		d := &DomainType{T: spec.Name, Schema: s, Null: spec.Null}
+		if d.Default, err = specutil.Default(spec.Default); err != nil {
+			return fmt.Errorf("convert default value of domain %q: %w", spec.Name, err)
+		}
+		for _, c := range spec.Checks {
+			ck, err := specutil.Check(c)
+			if err != nil {
+				return fmt.Errorf("convert check %q of domain %q: %w", c.Name, spec.Name, err)
+			}
+			d.Checks = append(d.Checks, ck)
+		}
+		if a, ok := spec.Extra.Attr("comment"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute domain.%s.comment: %w", spec.Name, err)
+			}
+			d.Attrs = append(d.Attrs, &schema.Comment{Text: v})
+		}
+		s.AddObjects(d)
+		ds, types = append(ds, d), append(types, spec.Type)
+	}
+	// Base types are converted after all domains were added,
+	// as a domain can be defined on top of another domain.
+	for i, d := range ds {
+		switch t := types[i]; {
+		case t.IsRefTo("domain"), t.IsRefTo("enum"):
+			o, err := typeByRef(r, t)
+			if err != nil {
+				return fmt.Errorf("find base type of domain %q: %w", d.T, err)
+			}
+			d.Type = o
+		default:
+			typ, err := TypeRegistry.Type(t, nil)
+			if err != nil {
+				return fmt.Errorf("convert base type of domain %q: %w", d.T, err)
+			}
+			d.Type = typ
+		}
+	}
+	return columnTypeRefs(tables, r, "domain")
+}
+
+// columnTypeRefs sets the user-defined types referenced by the
+// table columns, limited to the given type (block) names.
+func columnTypeRefs(tables []*sqlspec.Table, r *schema.Realm, types ...string) error {
+	for _, t := range tables {
+		for _, c := range t.Columns {
+			if c.Type == nil || !slices.ContainsFunc(types, c.Type.IsRefTo) {
+				continue
+			}
+			typ, err := typeByRef(r, c.Type)
+			if err != nil {
+				return fmt.Errorf("find type of column %q: %w", c.Name, err)
+			}
+			ns, err := specutil.SchemaName(t.Schema)
+			if err != nil {
+				return fmt.Errorf("extract schema name from table reference: %w", err)
+			}
+			s, ok := r.Schema(ns)
+			if !ok {
+				return fmt.Errorf("schema %q not found in realm for table %q", ns, t.Name)
+			}
+			tt, ok := s.Table(t.Name)
+			if !ok {
+				return fmt.Errorf("table %q not found in schema %q", t.Name, s.Name)
+			}
+			cc, ok := tt.Column(c.Name)
+			if !ok {
+				return fmt.Errorf("column %q not found in table %q", c.Name, t.Name)
+			}
+			cc.Type.Type = typ
+		}
+	}
+	return nil
+}
+
+// convertRanges converts the range specs to schema range types, adds them
+// to their schemas and sets them on the columns that reference them.
+func convertRanges(tables []*sqlspec.Table, ranges []*rangeType, r *schema.Realm) error {
+	for _, spec := range ranges {
+		ns, err := specutil.SchemaName(spec.Schema)
+		if err != nil {
+			return fmt.Errorf("extract schema name from range reference: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("schema %q defined on range %q was not found in realm", ns, spec.Name)
+		}
+		if spec.Subtype == nil {
+			return fmt.Errorf("missing subtype for range %q", spec.Name)
+		}
+		rt := &RangeType{T: spec.Name, Schema: s}
+		if rt.Subtype, err = userType(r, spec.Subtype); err != nil {
+			return fmt.Errorf("convert subtype of range %q: %w", spec.Name, err)
+		}
+		if a, ok := spec.Extra.Attr("comment"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute range.%s.comment: %w", spec.Name, err)
+			}
+			rt.Attrs = append(rt.Attrs, &schema.Comment{Text: v})
+		}
+		s.AddObjects(rt)
+	}
+	return columnTypeRefs(tables, r, "range")
+}
+
+// convertComposites converts the composite specs to schema composite types, adds
+// them to their schemas and sets them on the columns that reference them.
+func convertComposites(tables []*sqlspec.Table, composites []*composite, r *schema.Realm) error {
+	var cs []*CompositeType
+	for _, spec := range composites {
+		ns, err := specutil.SchemaName(spec.Schema)
+		if err != nil {
+			return fmt.Errorf("extract schema name from composite reference: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("schema %q defined on composite %q was not found in realm", ns, spec.Name)
+		}
+		c := &CompositeType{T: spec.Name, Schema: s}
+		if a, ok := spec.Extra.Attr("comment"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute composite.%s.comment: %w", spec.Name, err)
+			}
+			c.Attrs = append(c.Attrs, &schema.Comment{Text: v})
+		}
+		s.AddObjects(c)
+		cs = append(cs, c)
+	}
+	// Field types are converted after all composites were added,
+	// as a field can be defined using another composite type.
+	for i, c := range cs {
+		for _, f := range composites[i].Fields {
+			if f.Type == nil {
+				return fmt.Errorf("missing type for field %q of composite %q", f.Name, c.T)
+			}
+			t, err := userType(r, f.Type)
+			if err != nil {
+				return fmt.Errorf("convert type of field %q of composite %q: %w", f.Name, c.T, err)
+			}
+			fc := &schema.Column{Name: f.Name, Type: &schema.ColumnType{Type: t, Null: true}}
+			if a, ok := f.Extra.Attr("collate"); ok {
+				v, err := a.String()
+				if err != nil {
+					return fmt.Errorf("expect string value for attribute composite.%s.field.%s.collate: %w", c.T, f.Name, err)
+				}
+				fc.SetCollation(v)
+			}
+			c.Fields = append(c.Fields, fc)
+		}
+	}
+	return columnTypeRefs(tables, r, "composite")
+}
+
+// userType converts the given spec type into a schema type. References to
+// user-defined types (e.g., enums or domains) are resolved from the realm.
+func userType(r *schema.Realm, t *schemahcl.Type) (schema.Type, error) {
+	if t.IsRef {
+		return typeByRef(r, t)
+	}
+	return TypeRegistry.Type(t, nil)
+}
+
+// typeByRef returns the user-defined type (e.g., enum or domain) referenced by the given
+// type. Unqualified references are searched in all schemas of the realm.
+func typeByRef(r *schema.Realm, t *schemahcl.Type) (schema.Type, error) {
+	path, err := (&schemahcl.Ref{V: t.T}).Path()
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, fmt.Errorf("empty type reference %q", t.T)
+	}
+	typ := path[0].T
+	q, n, err := specutil.RefName(&schemahcl.Ref{V: t.T}, typ)
+	if err != nil {
+		return nil, err
+	}
+	var matches []schema.Type
+	for _, s := range r.Schemas {
+		if q != "" && s.Name != q {
+			continue
+		}
+		for _, o := range s.Objects {
+			switch o := o.(type) {
+			case *schema.EnumType:
+				if typ == "enum" && o.T == n {
+					matches = append(matches, o)
+				}
+			case *DomainType:
+				if typ == "domain" && o.T == n {
+					matches = append(matches, o)
+				}
+			case *CompositeType:
+				if typ == "composite" && o.T == n {
+					matches = append(matches, o)
+				}
+			case *RangeType:
+				if typ == "range" && o.T == n {
+					matches = append(matches, o)
+				}
+			}
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		return nil, fmt.Errorf("referenced %s %q not found", typ, n)
+	default:
+		return nil, fmt.Errorf("multiple reference %ss found for %q", typ, n)
+	}
+}
+
+// domainSpec converts a schema domain to its spec.
+func domainSpec(spec *specutil.SchemaSpec, d *DomainType) (*domain, error) {
+	c, err := columnTypeSpec(d.Type)
+	if err != nil {
+		return nil, fmt.Errorf("convert base type of domain %q: %w", d.T, err)
+	}
+	s := &domain{
+		Name:   d.T,
+		Schema: specutil.SchemaRef(spec.Schema.Name),
+		Type:   c.Type,
+		Null:   d.Null,
+	}
+	if s.Default, err = specutil.ColumnDefault(domainColumn(d)); err != nil {
+		return nil, fmt.Errorf("convert default value of domain %q: %w", d.T, err)
+	}
+	for _, c := range d.Checks {
+		s.Checks = append(s.Checks, specutil.FromCheck(c))
+	}
+	if c := (schema.Comment{}); sqlx.Has(d.Attrs, &c) && c.Text != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("comment", c.Text))
+	}
+	return s, nil
+}
+
+// rangeSpec converts a schema range type to its spec.
+func rangeSpec(spec *specutil.SchemaSpec, r *RangeType) (*rangeType, error) {
+	c, err := columnTypeSpec(r.Subtype)
+	if err != nil {
+		return nil, fmt.Errorf("convert subtype of range %q: %w", r.T, err)
+	}
+	s := &rangeType{
+		Name:    r.T,
+		Schema:  specutil.SchemaRef(spec.Schema.Name),
+		Subtype: c.Type,
+	}
+	if c := (schema.Comment{}); sqlx.Has(r.Attrs, &c) && c.Text != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("comment", c.Text))
+	}
+	return s, nil
+}
+
+// compositeSpec converts a schema composite type to its spec.
+func compositeSpec(spec *specutil.SchemaSpec, c *CompositeType) (*composite, error) {
+	s := &composite{
+		Name:   c.T,
+		Schema: specutil.SchemaRef(spec.Schema.Name),
+	}
+	for _, f := range c.Fields {
+		t, err := columnTypeSpec(f.Type.Type)
+		if err != nil {
+			return nil, fmt.Errorf("convert type of field %q of composite %q: %w", f.Name, c.T, err)
+		}
+		fs := &compositeField{Name: f.Name, Type: t.Type}
+		if collate := (schema.Collation{}); sqlx.Has(f.Attrs, &collate) && collate.V != "" {
+			fs.Extra.Attrs = append(fs.Extra.Attrs, schemahcl.StringAttr("collate", collate.V))
+		}
+		s.Fields = append(s.Fields, fs)
+	}
+	if cm := (schema.Comment{}); sqlx.Has(c.Attrs, &cm) && cm.Text != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("comment", cm.Text))
+	}
+	return s, nil
+}
+
+// convertAggregate converts the aggregate specs to schema aggregates and adds them
+// to their schemas. Support functions are resolved from the realm, or kept as
+// references by their names in case they are not managed by it (e.g., builtins).
+func convertAggregate(d *doc, r *schema.Realm) error {
+	for _, spec := range d.Aggregates {
+		ns, err := specutil.SchemaName(spec.Schema)
+		if err != nil {
+			return fmt.Errorf("extract schema name from aggregate reference: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("schema %q defined on aggregate %q was not found in realm", ns, spec.Name)
+		}
+		a := &Aggregate{Name: spec.Name, Schema: s}
+		for _, arg := range spec.Args {
+			if arg.Type == nil {
+				return fmt.Errorf("missing type for argument %q of aggregate %q", arg.Name, spec.Name)
+			}
+			t, err := userType(r, arg.Type)
+			if err != nil {
+				return fmt.Errorf("convert type of argument %q of aggregate %q: %w", arg.Name, spec.Name, err)
+			}
+			fa := &schema.FuncArg{Name: arg.Name, Type: t, Mode: schema.FuncArgModeIn}
+			if m, ok := arg.Extra.Attr("mode"); ok {
+				v, err := m.String()
+				if err != nil {
+					return fmt.Errorf("expect string value for attribute aggregate.%s.arg.%s.mode: %w", spec.Name, arg.Name, err)
+				}
+				fa.Mode = schema.FuncArgMode(strings.ToUpper(v))
+			}
+			a.Args = append(a.Args, fa)
+		}
+		st, ok := spec.Extra.Attr("state_type")
+		if !ok {
+			return fmt.Errorf("missing 'state_type' definition for aggregate %q", spec.Name)
+		}
+		t, err := st.Type()
+		if err != nil {
+			return fmt.Errorf("expect type definition for attribute aggregate.%s.state_type: %w", spec.Name, err)
+		}
+		if a.StateType, err = userType(r, t); err != nil {
+			return fmt.Errorf("convert state type of aggregate %q: %w", spec.Name, err)
+		}
+		sf, ok := spec.Extra.Attr("state_func")
+		if !ok {
+			return fmt.Errorf("missing 'state_func' definition for aggregate %q", spec.Name)
+		}
+		if a.StateFunc, err = convertFuncAttr(r, sf); err != nil {
+			return fmt.Errorf("convert state function of aggregate %q: %w", spec.Name, err)
+		}
+		if ff, ok := spec.Extra.Attr("final_func"); ok {
+			if a.FinalFunc, err = convertFuncAttr(r, ff); err != nil {
+				return fmt.Errorf("convert final function of aggregate %q: %w", spec.Name, err)
+			}
+		}
+		if ic, ok := spec.Extra.Attr("initial_condition"); ok {
+			if a.InitCond, err = ic.String(); err != nil {
+				return fmt.Errorf("expect string value for attribute aggregate.%s.initial_condition: %w", spec.Name, err)
+			}
+		}
+		if p, ok := spec.Extra.Attr("parallel"); ok {
+			v, err := p.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute aggregate.%s.parallel: %w", spec.Name, err)
+			}
+			switch v = strings.ToUpper(v); v {
+			case AggregateParallelUnsafe:
+			case AggregateParallelSafe, AggregateParallelRestricted:
+				a.Parallel = v
+			default:
+				return fmt.Errorf("unexpected parallel mode %q for aggregate %q", v, spec.Name)
+			}
+		}
+		if c, ok := spec.Extra.Attr("comment"); ok {
+			v, err := c.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute aggregate.%s.comment: %w", spec.Name, err)
+			}
+			a.Attrs = append(a.Attrs, &schema.Comment{Text: v})
+		}
+		s.AddObjects(a)
+	}
+	return nil
+}
+
+// aggregateSpec converts a schema aggregate to its spec.
+func aggregateSpec(spec *specutil.SchemaSpec, a *Aggregate) (*aggregate, error) {
+	s := &aggregate{
+		Name:   a.Name,
+		Schema: specutil.SchemaRef(spec.Schema.Name),
+	}
+	for _, arg := range a.Args {
+		t, err := columnTypeSpec(arg.Type)
+		if err != nil {
+			return nil, fmt.Errorf("convert type of argument %q of aggregate %q: %w", arg.Name, a.Name, err)
+		}
+		as := &sqlspec.FuncArg{Name: arg.Name, Type: t.Type}
+		if arg.Mode != "" && arg.Mode != schema.FuncArgModeIn {
+			as.Extra.Attrs = append(as.Extra.Attrs, specutil.VarAttr("mode", string(arg.Mode)))
+		}
+		s.Args = append(s.Args, as)
+	}
+	t, err := columnTypeSpec(a.StateType)
+	if err != nil {
+		return nil, fmt.Errorf("convert state type of aggregate %q: %w", a.Name, err)
+	}
+	s.Extra.Attrs = append(s.Extra.Attrs, specutil.TypeAttr("state_type", t.Type), funcAttr("state_func", a.StateFunc))
+	if a.FinalFunc != nil {
+		s.Extra.Attrs = append(s.Extra.Attrs, funcAttr("final_func", a.FinalFunc))
+	}
+	if a.InitCond != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("initial_condition", a.InitCond))
+	}
+	if p := aggregateParallel(a); p != AggregateParallelUnsafe {
+		s.Extra.Attrs = append(s.Extra.Attrs, specutil.VarAttr("parallel", p))
+	}
+	if c := (schema.Comment{}); sqlx.Has(a.Attrs, &c) && c.Text != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("comment", c.Text))
+	}
+	return s, nil
+}
+
+// convertSequences converts the sequence specs to schema sequences
+// and adds them to their schemas.
+func convertSequences(_ []*sqlspec.Table, seqs []*sqlspec.Sequence, r *schema.Realm) error {
+	for _, spec := range seqs {
+		ns, err := specutil.SchemaName(spec.Schema)
+		if err != nil {
+			return fmt.Errorf("extract schema name from sequence reference: %w", err)
+		}
+		s, ok := r.Schema(ns)
+		if !ok {
+			return fmt.Errorf("schema %q defined on sequence %q was not found in realm", ns, spec.Name)
+		}
+		seq := &Sequence{Name: spec.Name, Schema: s}
+		if a, ok := spec.Extra.Attr("type"); ok {
+			t, err := a.Type()
+			if err != nil {
+				return fmt.Errorf("expect type for attribute sequence.%s.type: %w", spec.Name, err)
+			}
+			if seq.Type, err = TypeRegistry.Type(t, nil); err != nil {
+				return fmt.Errorf("convert type of sequence %q: %w", spec.Name, err)
+			}
+		}
+		for k, v := range map[string]*int64{"start": &seq.Start, "increment": &seq.Increment, "cache": &seq.Cache} {
+			if a, ok := spec.Extra.Attr(k); ok {
+				if *v, err = a.Int64(); err != nil {
+					return fmt.Errorf("expect integer value for attribute sequence.%s.%s: %w", spec.Name, k, err)
+				}
+			}
+		}
+		for k, v := range map[string]**int64{"min_value": &seq.Min, "max_value": &seq.Max} {
+			if a, ok := spec.Extra.Attr(k); ok {
+				i, err := a.Int64()
+				if err != nil {
+					return fmt.Errorf("expect integer value for attribute sequence.%s.%s: %w", spec.Name, k, err)
+				}
+				*v = &i
+			}
+		}
+		if a, ok := spec.Extra.Attr("cycle"); ok {
+			if seq.Cycle, err = a.Bool(); err != nil {
+				return fmt.Errorf("expect bool value for attribute sequence.%s.cycle: %w", spec.Name, err)
+			}
+		}
+		if a, ok := spec.Extra.Attr("owner"); ok {
+			ref, err := a.Ref()
+			if err != nil {
+				return fmt.Errorf("expect reference for attribute sequence.%s.owner: %w", spec.Name, err)
+			}
+			if seq.Owner.T, seq.Owner.C, err = seqOwner(r, &schemahcl.Ref{V: ref}); err != nil {
+				return fmt.Errorf("find owner of sequence %q: %w", spec.Name, err)
+			}
+		}
+		if a, ok := spec.Extra.Attr("comment"); ok {
+			v, err := a.String()
+			if err != nil {
+				return fmt.Errorf("expect string value for attribute sequence.%s.comment: %w", spec.Name, err)
+			}
+			seq.Attrs = append(seq.Attrs, &schema.Comment{Text: v})
+		}
+		s.AddObjects(seq)
+	}
+	return nil
+}
+
+// seqOwner returns the table and column referenced by the owner attribute of a sequence.
+func seqOwner(r *schema.Realm, ref *schemahcl.Ref) (*schema.Table, *schema.Column, error) {
+	o, err := specutil.ObjectByRef(r, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	t, ok := o.(*schema.Table)
+	if !ok {
+		return nil, nil, fmt.Errorf("expect owner to be a table column, got: %T", o)
+	}
+	c, err := specutil.ColumnByRef(t, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t, c, nil
+}
+
+// sequenceSpec converts a schema sequence to its spec. Options
+// that are set to their default values are omitted.
+func sequenceSpec(spec *specutil.SchemaSpec, seq *Sequence) (*sqlspec.Sequence, error) {
+	s := &sqlspec.Sequence{
+		Name:   seq.Name,
+		Schema: specutil.SchemaRef(spec.Schema.Name),
+	}
+	if seq.Type != nil {
+		t, err := TypeRegistry.Convert(seq.Type)
+		if err != nil {
+			return nil, fmt.Errorf("convert type of sequence %q: %w", seq.Name, err)
+		}
+		if !strings.EqualFold(t.T, TypeBigInt) && !strings.EqualFold(t.T, TypeInt8) {
+			s.Extra.Attrs = append(s.Extra.Attrs, &schemahcl.Attr{K: "type", V: schemahcl.TypeValue(t)})
+		}
+	}
+	if seq.Start != 0 && seq.Start != seqStart(&Sequence{Type: seq.Type, Increment: seq.Increment, Min: seq.Min, Max: seq.Max}) {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.Int64Attr("start", seq.Start))
+	}
+	if seq.Increment != 0 && seq.Increment != defaultSeqIncrement {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.Int64Attr("increment", seq.Increment))
+	}
+	if seq.Min != nil {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.Int64Attr("min_value", *seq.Min))
+	}
+	if seq.Max != nil {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.Int64Attr("max_value", *seq.Max))
+	}
+	if seq.Cache > 1 {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.Int64Attr("cache", seq.Cache))
+	}
+	if seq.Cycle {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.BoolAttr("cycle", true))
+	}
+	if seq.Owner.T != nil && seq.Owner.C != nil {
+		on, err := specutil.TableSpecRef(seq.Owner.T).Path()
+		if err != nil {
+			return nil, err
+		}
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.RefAttr("owner", schemahcl.BuildRef(append(on, schemahcl.PathIndex{T: "column", V: []string{seq.Owner.C.Name}}))))
+	}
+	if c := (schema.Comment{}); sqlx.Has(seq.Attrs, &c) && c.Text != "" {
+		s.Extra.Attrs = append(s.Extra.Attrs, schemahcl.StringAttr("comment", c.Text))
+	}
+	return s, nil
+}
+
 func pkSpec(idx *schema.Index) (*sqlspec.PrimaryKey, error) {
 	spec, err := specutil.FromPrimaryKey(idx)
 	if err != nil {
@@ -964,6 +2112,20 @@ func columnTypeSpec(t schema.Type) (*sqlspec.Column, error) {
 				IsRef: true,
 				T:     specutil.ObjectRef(o.Schema, o).V},
 		}, nil
+	case *RangeType:
+		// Built-in range types are converted by the registry.
+		if o.Schema == nil {
+			st, err := TypeRegistry.Convert(o)
+			if err != nil {
+				return nil, err
+			}
+			return &sqlspec.Column{Type: st}, nil
+		}
+		return &sqlspec.Column{
+			Type: &schemahcl.Type{
+				IsRef: true,
+				T:     specutil.ObjectRef(o.Schema, o).V},
+		}, nil
 	default:
 		st, err := TypeRegistry.Convert(t)
 		if err != nil {
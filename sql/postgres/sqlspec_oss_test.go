@@ -531,6 +531,991 @@ schema "other" {}
 	require.EqualValues(t, r, got)
 }
 
+func TestMarshalFuncs(t *testing.T) {
+	s := schema.New("public").
+		AddTables(
+			schema.NewTable("t1").
+				AddColumns(
+					schema.NewIntColumn("id", "int"),
+				),
+		)
+	s.AddFuncs(
+		&schema.Func{
+			Name: "add",
+			Args: []*schema.FuncArg{
+				{Name: "a", Type: &schema.IntegerType{T: "integer"}},
+				{Name: "b", Type: &schema.IntegerType{T: "integer"}, Default: &schema.RawExpr{X: "1"}},
+			},
+			Ret:   &schema.IntegerType{T: "integer"},
+			Lang:  "sql",
+			Body:  "RETURN a + b",
+			Attrs: []schema.Attr{&FuncVolatility{V: FuncVolatilityImmutable}, &schema.Comment{Text: "sum"}},
+		},
+		(&schema.Func{
+			Name:  "ids",
+			Args:  []*schema.FuncArg{{Name: "id", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeOut}},
+			Ret:   &UserDefinedType{T: "SETOF integer"},
+			Lang:  "plpgsql",
+			Body:  "BEGIN\n  RETURN QUERY SELECT id FROM t1;\nEND;",
+			Attrs: []schema.Attr{&FuncVolatility{V: FuncVolatilityVolatile}, &FuncSecurity{V: FuncSecurityDefiner}},
+		}).AddDeps(s.Tables[0]),
+	)
+	s.AddProcs(
+		&schema.Proc{
+			Name: "reset",
+			Args: []*schema.FuncArg{{Name: "force", Type: &schema.BoolType{T: "boolean"}, Mode: schema.FuncArgModeInOut}},
+			Lang: "plpgsql",
+			Body: "BEGIN END;",
+		},
+	)
+	buf, err := MarshalHCL(s)
+	require.NoError(t, err)
+	require.Equal(t, `table "t1" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = int
+  }
+}
+function "add" {
+  schema     = schema.public
+  lang       = SQL
+  return     = integer
+  volatility = IMMUTABLE
+  as         = "RETURN a + b"
+  comment    = "sum"
+  arg "a" {
+    type = integer
+  }
+  arg "b" {
+    type    = integer
+    default = sql("1")
+  }
+}
+function "ids" {
+  schema     = schema.public
+  lang       = PLpgSQL
+  return     = sql("SETOF integer")
+  security   = DEFINER
+  as         = <<-SQL
+  BEGIN
+    RETURN QUERY SELECT id FROM t1;
+  END;
+  SQL
+  depends_on = [table.t1]
+  arg "id" {
+    type = integer
+    mode = OUT
+  }
+}
+procedure "reset" {
+  schema = schema.public
+  lang   = PLpgSQL
+  as     = "BEGIN END;"
+  arg "force" {
+    type = boolean
+    mode = INOUT
+  }
+}
+schema "public" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalFuncs(t *testing.T) {
+	f := `table "t1" {
+  schema = schema.public
+  column "id" {
+    type = int
+  }
+}
+function "add" {
+  schema     = schema.public
+  lang       = SQL
+  arg "a" {
+    type = integer
+  }
+  arg "b" {
+    type    = integer
+    default = 1
+  }
+  return     = integer
+  volatility = IMMUTABLE
+  as         = "RETURN a + b"
+  comment    = "sum"
+}
+function "ids" {
+  schema     = schema.public
+  lang       = PLpgSQL
+  arg "id" {
+    type = integer
+    mode = OUT
+  }
+  return     = sql("SETOF integer")
+  security   = DEFINER
+  as         = "BEGIN RETURN QUERY SELECT id FROM t1; END;"
+  depends_on = [table.t1]
+}
+procedure "reset" {
+  schema = schema.public
+  lang   = "plv8"
+  arg "force" {
+    type = boolean
+    mode = INOUT
+  }
+  as = "return;"
+}
+schema "public" {}
+`
+	var (
+		got    schema.Realm
+		public = schema.New("public").
+			AddTables(
+				schema.NewTable("t1").
+					AddColumns(
+						schema.NewIntColumn("id", "int"),
+					),
+			)
+	)
+	public.AddFuncs(
+		&schema.Func{
+			Name: "add",
+			Args: []*schema.FuncArg{
+				{Name: "a", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeIn},
+				{Name: "b", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeIn, Default: &schema.Literal{V: "1"}},
+			},
+			Ret:   &schema.IntegerType{T: "integer"},
+			Lang:  FuncLangSQL,
+			Body:  "RETURN a + b",
+			Attrs: []schema.Attr{&schema.Comment{Text: "sum"}, &FuncVolatility{V: FuncVolatilityImmutable}},
+		},
+		(&schema.Func{
+			Name:  "ids",
+			Args:  []*schema.FuncArg{{Name: "id", Type: &schema.IntegerType{T: "integer"}, Mode: schema.FuncArgModeOut}},
+			Ret:   &UserDefinedType{T: "SETOF integer"},
+			Lang:  FuncLangPLpgSQL,
+			Body:  "BEGIN RETURN QUERY SELECT id FROM t1; END;",
+			Attrs: []schema.Attr{&FuncSecurity{V: FuncSecurityDefiner}},
+		}).AddDeps(public.Tables[0]),
+	)
+	public.AddProcs(
+		&schema.Proc{
+			Name: "reset",
+			Args: []*schema.FuncArg{{Name: "force", Type: &schema.BoolType{T: "boolean"}, Mode: schema.FuncArgModeInOut}},
+			Lang: "plv8",
+			Body: "return;",
+		},
+	)
+	require.NoError(t, EvalHCLBytes([]byte(f), &got, nil))
+	require.EqualValues(t, *schema.NewRealm(public), got)
+}
+
+func TestMarshalTriggers(t *testing.T) {
+	var (
+		public = schema.New("public")
+		t1     = schema.NewTable("t1").
+			AddColumns(
+				schema.NewIntColumn("id", "int"),
+				schema.NewStringColumn("name", "text"),
+			)
+		f1 = &schema.Func{Name: "audit", Ret: &PseudoType{T: "trigger"}, Lang: FuncLangPLpgSQL, Body: "BEGIN RETURN NEW; END;"}
+		f2 = &schema.Func{Name: "log_ddl", Ret: &PseudoType{T: "event_trigger"}, Lang: FuncLangPLpgSQL, Body: "BEGIN END;"}
+	)
+	public.AddTables(t1).AddFuncs(f1, f2)
+	t1.Triggers = []*schema.Trigger{
+		{
+			Name:       "t1_audit",
+			Table:      t1,
+			ActionTime: schema.TriggerTimeBefore,
+			Events:     []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventUpdateOf(t1.Columns[1])},
+			For:        schema.TriggerForRow,
+			Attrs: []schema.Attr{
+				&TriggerWhen{X: "NEW.name IS NOT NULL"},
+				&TriggerFunc{F: f1, Args: []string{"t1"}},
+				&schema.Comment{Text: "audit t1"},
+			},
+		},
+		{
+			Name:       "t1_update",
+			Table:      t1,
+			ActionTime: schema.TriggerTimeAfter,
+			Events:     []schema.TriggerEvent{schema.TriggerEventTruncate},
+			For:        schema.TriggerForStmt,
+			Attrs: []schema.Attr{
+				&TriggerFunc{F: &schema.Func{Name: "suppress_redundant_updates_trigger", Schema: schema.New("pg_catalog")}},
+			},
+		},
+	}
+	r := schema.NewRealm(public)
+	r.AddObjects(&EventTrigger{Name: "ddl", Event: "ddl_command_end", Tags: []string{"CREATE TABLE"}, F: f2, Attrs: []schema.Attr{&schema.Comment{Text: "log ddl"}}})
+	buf, err := MarshalHCL(r)
+	require.NoError(t, err)
+	require.Equal(t, `table "t1" {
+  schema = schema.public
+  column "id" {
+    null = false
+    type = int
+  }
+  column "name" {
+    null = false
+    type = text
+  }
+}
+function "audit" {
+  schema = schema.public
+  lang   = PLpgSQL
+  return = trigger
+  as     = "BEGIN RETURN NEW; END;"
+}
+function "log_ddl" {
+  schema = schema.public
+  lang   = PLpgSQL
+  return = event_trigger
+  as     = "BEGIN END;"
+}
+trigger "t1_audit" {
+  on      = table.t1
+  for     = ROW
+  when    = "NEW.name IS NOT NULL"
+  comment = "audit t1"
+  before {
+    insert    = true
+    update_of = [table.t1.column.name]
+  }
+  execute {
+    function = function.audit
+    args     = ["t1"]
+  }
+}
+trigger "t1_update" {
+  on = table.t1
+  after {
+    truncate = true
+  }
+  execute {
+    function = "pg_catalog.suppress_redundant_updates_trigger"
+  }
+}
+event_trigger "ddl" {
+  on      = ddl_command_end
+  tags    = ["CREATE TABLE"]
+  execute = function.log_ddl
+  comment = "log ddl"
+}
+schema "public" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalTriggers(t *testing.T) {
+	f := `
+schema "public" {}
+table "t1" {
+  schema = schema.public
+  column "id" {
+    type = int
+  }
+  column "name" {
+    type = text
+  }
+}
+view "v1" {
+  schema = schema.public
+  column "id" {
+    type = int
+  }
+  as = "SELECT id FROM t1"
+}
+function "audit" {
+  schema = schema.public
+  lang   = PLpgSQL
+  return = trigger
+  as     = "BEGIN RETURN NEW; END;"
+}
+function "log_ddl" {
+  schema = schema.public
+  lang   = PLpgSQL
+  return = event_trigger
+  as     = "BEGIN END;"
+}
+trigger "t1_audit" {
+  on   = table.t1
+  for  = ROW
+  when = "NEW.name IS NOT NULL"
+  before {
+    insert    = true
+    update_of = [table.t1.column.name]
+  }
+  execute {
+    function = function.audit
+    args     = ["t1"]
+  }
+  comment = "audit t1"
+}
+trigger "v1_insert" {
+  on  = view.v1
+  for = ROW
+  instead_of {
+    insert = true
+    delete = true
+  }
+  execute {
+    function = "ext.v1_insert"
+  }
+}
+event_trigger "ddl" {
+  on      = ddl_command_end
+  tags    = ["CREATE TABLE"]
+  execute = function.log_ddl
+}
+`
+	var r schema.Realm
+	require.NoError(t, EvalHCLBytes([]byte(f), &r, nil))
+	public := r.Schemas[0]
+	t1, v1 := public.Tables[0], public.Views[0]
+	require.Len(t, t1.Triggers, 1)
+	tr := t1.Triggers[0]
+	require.Equal(t, "t1_audit", tr.Name)
+	require.Equal(t, t1, tr.Table)
+	require.Equal(t, schema.TriggerTimeBefore, tr.ActionTime)
+	require.Equal(t, schema.TriggerForRow, tr.For)
+	require.Equal(t, []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventUpdateOf(t1.Columns[1])}, tr.Events)
+	require.Equal(t, &TriggerWhen{X: "NEW.name IS NOT NULL"}, tr.Attrs[0])
+	require.Equal(t, &TriggerFunc{F: public.Funcs[0], Args: []string{"t1"}}, tr.Attrs[1])
+	require.Equal(t, &schema.Comment{Text: "audit t1"}, tr.Attrs[2])
+	require.Equal(t, []schema.Object{public.Funcs[0]}, tr.Deps)
+
+	require.Len(t, v1.Triggers, 1)
+	tr = v1.Triggers[0]
+	require.Equal(t, v1, tr.View)
+	require.Equal(t, schema.TriggerTimeInstead, tr.ActionTime)
+	require.Equal(t, []schema.TriggerEvent{schema.TriggerEventInsert, schema.TriggerEventDelete}, tr.Events)
+	require.Equal(t, &TriggerFunc{F: &schema.Func{Name: "v1_insert", Schema: &schema.Schema{Name: "ext"}}}, tr.Attrs[0])
+	require.Empty(t, tr.Deps)
+
+	require.Len(t, r.Objects, 1)
+	require.Equal(t, &EventTrigger{Name: "ddl", Event: "ddl_command_end", Tags: []string{"CREATE TABLE"}, F: public.Funcs[1]}, r.Objects[0])
+
+	err := EvalHCLBytes([]byte(`
+schema "public" {}
+table "t1" {
+  schema = schema.public
+  column "id" {
+    type = int
+  }
+}
+trigger "t1_audit" {
+  on = table.t1
+  execute {
+    function = "audit"
+  }
+}
+`), &schema.Realm{}, nil)
+	require.EqualError(t, err, `specutil: failed converting to *schema.Realm: missing action time (before, after or instead_of) for trigger "t1_audit"`)
+}
+
+func TestMarshalPolicies(t *testing.T) {
+	public := schema.New("public")
+	users := schema.NewTable("users").AddColumns(schema.NewIntColumn("tenant_id", "int"))
+	public.AddTables(users)
+	users.AddAttrs(
+		&RowSecurity{Enabled: true, Enforced: true},
+		&Policy{Name: "tenant", Table: users, As: PolicyAsPermissive, For: PolicyForAll, To: []string{"PUBLIC"}, Using: "(tenant_id = 1)"},
+		&Policy{Name: "admins", Table: users, As: PolicyAsRestrictive, For: PolicyForUpdate, To: []string{"admin", "CURRENT_USER"}, Check: "(tenant_id > 0)"},
+	)
+	buf, err := MarshalHCL(public)
+	require.NoError(t, err)
+	require.Equal(t, `table "users" {
+  schema = schema.public
+  column "tenant_id" {
+    null = false
+    type = int
+  }
+  row_security {
+    enabled  = true
+    enforced = true
+  }
+}
+policy "tenant" {
+  on    = table.users
+  using = "(tenant_id = 1)"
+}
+policy "admins" {
+  on    = table.users
+  as    = RESTRICTIVE
+  for   = UPDATE
+  to    = ["admin", "CURRENT_USER"]
+  check = "(tenant_id > 0)"
+}
+schema "public" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalPolicies(t *testing.T) {
+	var s schema.Schema
+	require.NoError(t, EvalHCLBytes([]byte(`
+schema "public" {}
+table "users" {
+  schema = schema.public
+  column "tenant_id" {
+    type = int
+  }
+  row_security {
+    enabled = true
+  }
+}
+table "logs" {
+  schema = schema.public
+  column "id" {
+    type = int
+  }
+}
+policy "tenant" {
+  on    = table.users
+  using = "(tenant_id = 1)"
+}
+policy "admins" {
+  on    = table.users
+  as    = RESTRICTIVE
+  for   = UPDATE
+  to    = [CURRENT_USER, "admin"]
+  check = "(tenant_id > 0)"
+}
+`), &s, nil))
+	users, logs := s.Tables[0], s.Tables[1]
+	require.Equal(t, []schema.Attr{
+		&RowSecurity{Enabled: true},
+		&Policy{Name: "tenant", Table: users, Using: "(tenant_id = 1)"},
+		&Policy{Name: "admins", Table: users, As: PolicyAsRestrictive, For: PolicyForUpdate, To: []string{"CURRENT_USER", "admin"}, Check: "(tenant_id > 0)"},
+	}, users.Attrs)
+	require.Empty(t, logs.Attrs)
+
+	err := EvalHCLBytes([]byte(`
+schema "public" {}
+policy "tenant" {
+  on    = table.users
+  using = "(tenant_id = 1)"
+}
+`), &schema.Schema{}, nil)
+	require.Error(t, err)
+}
+
+func TestMarshalExtensions(t *testing.T) {
+	r := schema.NewRealm(schema.New("public"), schema.New("extensions"))
+	r.AddObjects(
+		&Extension{Name: "hstore", Schema: r.Schemas[0], Version: "1.8", Attrs: []schema.Attr{&OID{V: 16384}}},
+		&Extension{Name: "postgis", Schema: r.Schemas[1], Version: "3.4.2"},
+	)
+	buf, err := MarshalHCL(r)
+	require.NoError(t, err)
+	require.Equal(t, `extension "hstore" {
+  schema  = schema.public
+  version = "1.8"
+}
+extension "postgis" {
+  schema  = schema.extensions
+  version = "3.4.2"
+}
+schema "public" {
+}
+schema "extensions" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalExtensions(t *testing.T) {
+	var r schema.Realm
+	require.NoError(t, EvalHCLBytes([]byte(`
+schema "public" {}
+schema "extensions" {}
+extension "hstore" {
+  schema  = schema.public
+  version = "1.8"
+}
+extension "postgis" {
+  schema = schema.extensions
+}
+extension "pgcrypto" {}
+`), &r, nil))
+	require.Equal(t, []schema.Object{
+		&Extension{Name: "hstore", Schema: r.Schemas[0], Version: "1.8"},
+		&Extension{Name: "postgis", Schema: r.Schemas[1]},
+		&Extension{Name: "pgcrypto"},
+	}, r.Objects)
+
+	// Extensions are skipped in schema scope.
+	var s schema.Schema
+	require.NoError(t, EvalHCLBytes([]byte(`
+schema "public" {}
+extension "hstore" {
+  schema = schema.public
+}
+`), &s, nil))
+	require.Empty(t, s.Objects)
+
+	err := EvalHCLBytes([]byte(`
+schema "public" {}
+extension "hstore" {
+  version = 1
+}
+`), &schema.Realm{}, nil)
+	require.Error(t, err)
+}
+
+func TestMarshalDomains(t *testing.T) {
+	var (
+		public = schema.New("public")
+		email  = &DomainType{
+			T:      "email",
+			Type:   &schema.StringType{T: TypeCharVar, Size: 255},
+			Checks: []*schema.Check{{Name: "email_check", Expr: "VALUE ~~ '%@%'"}},
+			Attrs:  []schema.Attr{&schema.Comment{Text: "emails"}},
+		}
+		amount = &DomainType{
+			T:       "amount",
+			Type:    &schema.DecimalType{T: TypeNumeric, Precision: 12, Scale: 2},
+			Null:    true,
+			Default: &schema.Literal{V: "0"},
+		}
+		users = schema.NewTable("users").
+			AddColumns(&schema.Column{Name: "email", Type: &schema.ColumnType{Type: email}})
+	)
+	public.AddObjects(email, amount).AddTables(users)
+	buf, err := MarshalHCL(public)
+	require.NoError(t, err)
+	require.Equal(t, `table "users" {
+  schema = schema.public
+  column "email" {
+    null = false
+    type = domain.email
+  }
+}
+domain "email" {
+  schema  = schema.public
+  type    = character_varying(255)
+  null    = false
+  comment = "emails"
+  check "email_check" {
+    expr = "VALUE ~~ '%@%'"
+  }
+}
+domain "amount" {
+  schema  = schema.public
+  type    = numeric(12,2)
+  null    = true
+  default = 0
+}
+schema "public" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalDomains(t *testing.T) {
+	f := `
+schema "public" {}
+domain "email" {
+  schema = schema.public
+  type   = character_varying(255)
+  check "email_check" {
+    expr = "VALUE ~~ '%@%'"
+  }
+  comment = "emails"
+}
+domain "work_email" {
+  schema = schema.public
+  type   = domain.email
+  null   = true
+}
+domain "amount" {
+  schema  = schema.public
+  type    = numeric(12,2)
+  null    = true
+  default = 0
+}
+table "users" {
+  schema = schema.public
+  column "email" {
+    type = domain.work_email
+  }
+  column "balance" {
+    type = domain.amount
+  }
+}
+`
+	var s schema.Schema
+	require.NoError(t, EvalHCLBytes([]byte(f), &s, nil))
+	require.Len(t, s.Objects, 3)
+	email := &DomainType{
+		T:      "email",
+		Schema: &s,
+		Type:   &schema.StringType{T: TypeCharVar, Size: 255},
+		Checks: []*schema.Check{{Name: "email_check", Expr: "VALUE ~~ '%@%'"}},
+		Attrs:  []schema.Attr{&schema.Comment{Text: "emails"}},
+	}
+	require.Equal(t, email, s.Objects[0])
+	require.Equal(t, &DomainType{T: "work_email", Schema: &s, Type: s.Objects[0].(*DomainType), Null: true}, s.Objects[1])
+	require.Equal(t, &DomainType{
+		T:       "amount",
+		Schema:  &s,
+		Type:    &schema.DecimalType{T: TypeNumeric, Precision: 12, Scale: 2},
+		Null:    true,
+		Default: &schema.Literal{V: "0"},
+	}, s.Objects[2])
+	users := s.Tables[0]
+	require.True(t, users.Columns[0].Type.Type == s.Objects[1].(*DomainType))
+	require.True(t, users.Columns[1].Type.Type == s.Objects[2].(*DomainType))
+
+	err := EvalHCLBytes([]byte(`
+schema "public" {}
+table "users" {
+  schema = schema.public
+  column "email" {
+    type = domain.email
+  }
+}
+`), &schema.Realm{}, nil)
+	require.Error(t, err)
+}
+
+func TestMarshalCompositesAndRanges(t *testing.T) {
+	var (
+		public = schema.New("public")
+		status = &schema.EnumType{T: "status", Schema: public, Values: []string{"active", "inactive"}}
+		fr     = &RangeType{
+			T:       "floatrange",
+			Schema:  public,
+			Subtype: &schema.FloatType{T: TypeFloat8},
+			Attrs:   []schema.Attr{&schema.Comment{Text: "float ranges"}},
+		}
+		address = &CompositeType{
+			T:      "address",
+			Schema: public,
+			Fields: []*schema.Column{
+				{Name: "street", Type: &schema.ColumnType{Type: &schema.StringType{T: TypeText}}, Attrs: []schema.Attr{&schema.Collation{V: "C"}}},
+				{Name: "zip", Type: &schema.ColumnType{Type: &schema.IntegerType{T: TypeInteger}}},
+				{Name: "status", Type: &schema.ColumnType{Type: status}},
+			},
+		}
+		users = schema.NewTable("users").
+			AddColumns(
+				&schema.Column{Name: "home", Type: &schema.ColumnType{Type: address}},
+				&schema.Column{Name: "weights", Type: &schema.ColumnType{Type: fr}},
+			)
+	)
+	public.AddObjects(status, address, fr).AddTables(users)
+	buf, err := MarshalHCL(public)
+	require.NoError(t, err)
+	require.Equal(t, `table "users" {
+  schema = schema.public
+  column "home" {
+    null = false
+    type = composite.address
+  }
+  column "weights" {
+    null = false
+    type = range.floatrange
+  }
+}
+enum "status" {
+  schema = schema.public
+  values = ["active", "inactive"]
+}
+composite "address" {
+  schema = schema.public
+  field "street" {
+    type    = text
+    collate = "C"
+  }
+  field "zip" {
+    type = integer
+  }
+  field "status" {
+    type = enum.status
+  }
+}
+range "floatrange" {
+  schema  = schema.public
+  subtype = float8
+  comment = "float ranges"
+}
+schema "public" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalCompositesAndRanges(t *testing.T) {
+	f := `
+schema "public" {}
+enum "status" {
+  schema = schema.public
+  values = ["active", "inactive"]
+}
+range "floatrange" {
+  schema  = schema.public
+  subtype = float8
+  comment = "float ranges"
+}
+composite "address" {
+  schema = schema.public
+  field "street" {
+    type    = text
+    collate = "C"
+  }
+  field "status" {
+    type = enum.status
+  }
+  field "bounds" {
+    type = range.floatrange
+  }
+  comment = "addresses"
+}
+table "users" {
+  schema = schema.public
+  column "home" {
+    type = composite.address
+  }
+  column "weights" {
+    type = range.floatrange
+  }
+}
+`
+	var s schema.Schema
+	require.NoError(t, EvalHCLBytes([]byte(f), &s, nil))
+	require.Len(t, s.Objects, 3)
+	status := s.Objects[0].(*schema.EnumType)
+	fr := &RangeType{
+		T:       "floatrange",
+		Schema:  &s,
+		Subtype: &schema.FloatType{T: TypeFloat8, Precision: 53},
+		Attrs:   []schema.Attr{&schema.Comment{Text: "float ranges"}},
+	}
+	require.Equal(t, fr, s.Objects[1])
+	require.Equal(t, &CompositeType{
+		T:      "address",
+		Schema: &s,
+		Fields: []*schema.Column{
+			{Name: "street", Type: &schema.ColumnType{Type: &schema.StringType{T: TypeText}, Null: true}, Attrs: []schema.Attr{&schema.Collation{V: "C"}}},
+			{Name: "status", Type: &schema.ColumnType{Type: status, Null: true}},
+			{Name: "bounds", Type: &schema.ColumnType{Type: s.Objects[1].(*RangeType), Null: true}},
+		},
+		Attrs: []schema.Attr{&schema.Comment{Text: "addresses"}},
+	}, s.Objects[2])
+	users := s.Tables[0]
+	require.True(t, users.Columns[0].Type.Type == s.Objects[2].(*CompositeType))
+	require.True(t, users.Columns[1].Type.Type == s.Objects[1].(*RangeType))
+}
+
+func TestMarshalAggregates(t *testing.T) {
+	var (
+		public = schema.New("public")
+		floatT = &schema.FloatType{T: TypeFloat8}
+		state  = &schema.Func{
+			Name: "sum_sq_state",
+			Args: []*schema.FuncArg{{Name: "s", Type: floatT}, {Name: "v", Type: floatT}},
+			Ret:  floatT,
+			Lang: "sql",
+			Body: "RETURN s + v * v",
+		}
+	)
+	public.AddFuncs(state).AddObjects(
+		&Aggregate{
+			Name:      "l2_norm",
+			Args:      []*schema.FuncArg{{Type: floatT}},
+			StateFunc: state,
+			StateType: floatT,
+			FinalFunc: &schema.Func{Name: "sqrt", Schema: schema.New("pg_catalog")},
+			InitCond:  "0",
+			Parallel:  AggregateParallelSafe,
+			Attrs:     []schema.Attr{&schema.Comment{Text: "vector norm"}},
+		},
+		&Aggregate{
+			Name:      "total",
+			StateFunc: &schema.Func{Name: "int8inc", Schema: schema.New("pg_catalog")},
+			StateType: &schema.IntegerType{T: TypeBigInt},
+			InitCond:  "0",
+		},
+	)
+	buf, err := MarshalHCL(public)
+	require.NoError(t, err)
+	require.Equal(t, `function "sum_sq_state" {
+  schema = schema.public
+  lang   = SQL
+  return = float8
+  as     = "RETURN s + v * v"
+  arg "s" {
+    type = float8
+  }
+  arg "v" {
+    type = float8
+  }
+}
+aggregate "l2_norm" {
+  schema            = schema.public
+  state_type        = float8
+  state_func        = function.sum_sq_state
+  final_func        = "pg_catalog.sqrt"
+  initial_condition = "0"
+  parallel          = SAFE
+  comment           = "vector norm"
+  arg {
+    type = float8
+  }
+}
+aggregate "total" {
+  schema            = schema.public
+  state_type        = bigint
+  state_func        = "pg_catalog.int8inc"
+  initial_condition = "0"
+}
+schema "public" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalAggregates(t *testing.T) {
+	f := `
+schema "public" {}
+function "sum_sq_state" {
+  schema = schema.public
+  lang   = SQL
+  arg "s" {
+    type = float8
+  }
+  arg "v" {
+    type = float8
+  }
+  return = float8
+  as     = "RETURN s + v * v"
+}
+aggregate "l2_norm" {
+  schema = schema.public
+  arg {
+    type = float8
+  }
+  state_type        = float8
+  state_func        = function.sum_sq_state
+  final_func        = "pg_catalog.sqrt"
+  initial_condition = "0"
+  parallel          = SAFE
+  comment           = "vector norm"
+}
+aggregate "total" {
+  schema     = schema.public
+  state_type = bigint
+  state_func = "pg_catalog.int8inc"
+}
+`
+	var s schema.Schema
+	require.NoError(t, EvalHCLBytes([]byte(f), &s, nil))
+	require.Len(t, s.Objects, 2)
+	require.Equal(t, &Aggregate{
+		Name:      "l2_norm",
+		Schema:    &s,
+		Args:      []*schema.FuncArg{{Type: &schema.FloatType{T: TypeFloat8, Precision: 53}, Mode: schema.FuncArgModeIn}},
+		StateFunc: s.Funcs[0],
+		StateType: &schema.FloatType{T: TypeFloat8, Precision: 53},
+		FinalFunc: &schema.Func{Name: "sqrt", Schema: &schema.Schema{Name: "pg_catalog"}},
+		InitCond:  "0",
+		Parallel:  AggregateParallelSafe,
+		Attrs:     []schema.Attr{&schema.Comment{Text: "vector norm"}},
+	}, s.Objects[0])
+	require.Equal(t, &Aggregate{
+		Name:      "total",
+		Schema:    &s,
+		StateFunc: &schema.Func{Name: "int8inc", Schema: &schema.Schema{Name: "pg_catalog"}},
+		StateType: &schema.IntegerType{T: TypeBigInt},
+	}, s.Objects[1])
+}
+
+func TestMarshalSequences(t *testing.T) {
+	var (
+		minV, maxV = int64(-50), int64(1000)
+		public     = schema.New("public")
+		users      = schema.NewTable("users").
+				AddColumns(schema.NewIntColumn("id", "integer").SetDefault(&schema.RawExpr{X: "nextval('users_id'::regclass)"}))
+		s1 = &Sequence{Name: "users_id", Type: &schema.IntegerType{T: TypeInteger}, Start: 1, Increment: 1, Cache: 1}
+		s2 = &Sequence{Name: "counter", Type: &schema.IntegerType{T: TypeBigInt}, Start: 100, Increment: -2, Min: &minV, Max: &maxV, Cache: 10, Cycle: true, Attrs: []schema.Attr{&schema.Comment{Text: "counter"}}}
+	)
+	s1.Owner.T, s1.Owner.C = users, users.Columns[0]
+	public.AddTables(users).AddObjects(s1, s2)
+	buf, err := MarshalHCL(public)
+	require.NoError(t, err)
+	require.Equal(t, `table "users" {
+  schema = schema.public
+  column "id" {
+    null    = false
+    type    = integer
+    default = sql("nextval('users_id'::regclass)")
+  }
+}
+sequence "users_id" {
+  schema = schema.public
+  type   = integer
+  owner  = table.users.column.id
+}
+sequence "counter" {
+  schema    = schema.public
+  start     = 100
+  increment = -2
+  min_value = -50
+  max_value = 1000
+  cache     = 10
+  cycle     = true
+  comment   = "counter"
+}
+schema "public" {
+}
+`, string(buf))
+}
+
+func TestUnmarshalSequences(t *testing.T) {
+	f := `
+schema "public" {}
+table "users" {
+  schema = schema.public
+  column "id" {
+    type    = integer
+    default = sql("nextval('users_id'::regclass)")
+  }
+}
+sequence "users_id" {
+  schema = schema.public
+  type   = integer
+  owner  = table.users.column.id
+}
+sequence "counter" {
+  schema    = schema.public
+  start     = 100
+  increment = -2
+  min_value = -50
+  max_value = 1000
+  cache     = 10
+  cycle     = true
+  comment   = "counter"
+}
+`
+	var s schema.Schema
+	require.NoError(t, EvalHCLBytes([]byte(f), &s, nil))
+	users := s.Tables[0]
+	s1 := &Sequence{Name: "users_id", Schema: &s, Type: &schema.IntegerType{T: TypeInteger}}
+	s1.Owner.T, s1.Owner.C = users, users.Columns[0]
+	minV, maxV := int64(-50), int64(1000)
+	require.Equal(t, []schema.Object{
+		s1,
+		&Sequence{Name: "counter", Schema: &s, Start: 100, Increment: -2, Min: &minV, Max: &maxV, Cache: 10, Cycle: true, Attrs: []schema.Attr{&schema.Comment{Text: "counter"}}},
+	}, s.Objects)
+
+	err := EvalHCLBytes([]byte(`
+schema "public" {}
+sequence "s" {
+  schema = schema.public
+  owner  = table.users.column.id
+}
+`), &schema.Realm{}, nil)
+	require.Error(t, err)
+}
+
 func TestUnmarshalSpec_IndexType(t *testing.T) {
 	f := `
 schema "s" {}
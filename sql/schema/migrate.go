@@ -80,6 +80,7 @@ type (
 	ModifyTable struct {
 		T       *Table
 		Changes []Change
+		Extra   []Clause // Extra clauses and options.
 	}
 
 	// RenameTable describes a table rename change.